@@ -1,23 +1,64 @@
+// Log the accounts of a config file in and save their sessions:
+//
+//	go run ./cmd/auth -config accounts.yaml
+//
+// The accounts with a saved session are skipped unless -force is set, -check only validates the file.
 package main
 
 import (
+	"flag"
 	"fmt"
+	"os"
 
-	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/config"
 )
 
 func main() {
-	mgr := auth.Core{}
+	path := flag.String("config", "accounts.yaml", "config file of the accounts")
+	name := flag.String("account", "", "log only this account in")
+	force := flag.Bool("force", false, "log in even with a saved session")
+	check := flag.Bool("check", false, "validate the config and exit")
+	flag.Parse()
 
-	mgr.Init(
-		auth.LoginInfo{
-			UserName: "user",
-			Password: "password",
-		})
-	mgr.SetHttpParam(5000, "http://127.0.0.1:1234")
-
-	err := mgr.Login()
+	if *check {
+		cfg, err := config.Load(*path)
+		if err != nil {
+			fail(err)
+		}
+		fmt.Printf("%d accounts\n", len(cfg.Accounts))
+		return
+	}
+	manager, err := config.NewManager(*path, nil)
 	if err != nil {
-		fmt.Println(err.Error())
+		fail(err)
+	}
+	failed := false
+	for _, bot := range manager.Bots() {
+		if *name != "" && bot.Name != *name {
+			continue
+		}
+		if bot.Auth.SessionID() != "" && !*force {
+			fmt.Printf("%s: saved session kept\n", bot.Name)
+			continue
+		}
+		if err := bot.Auth.Login(); err != nil {
+			fmt.Printf("%s: %s\n", bot.Name, err.Error())
+			failed = true
+			continue
+		}
+		if err := bot.SaveSession(); err != nil {
+			fmt.Printf("%s: %s\n", bot.Name, err.Error())
+			failed = true
+			continue
+		}
+		fmt.Printf("%s: logged in\n", bot.Name)
 	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err.Error())
+	os.Exit(1)
 }
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/steamtest"
+)
+
+var fakeLogin = auth.LoginInfo{UserName: "fixture_bot", Password: "fixture_password", SharedSecret: steamtest.TestSecret, IdentitySecret: steamtest.TestSecret}
+
+// An account with a few items, a market history and the market data of the sampled item
+func newFakeServer(appID, hashName string) *steamtest.Server {
+	app, _ := strconv.ParseUint(appID, 10, 32)
+	server := steamtest.NewServer()
+	sampled := &steamtest.Item{AppID: uint32(app), ContextID: 2, ClassID: 926978479, Amount: 1, Name: hashName, MarketHashName: hashName, Tradable: true, Marketable: true}
+	other := &steamtest.Item{AppID: uint32(app), ContextID: 2, ClassID: 310776560, Amount: 1, Name: "AK-47 | Redline (Field-Tested)", MarketHashName: "AK-47 | Redline (Field-Tested)", Tradable: true, Marketable: true}
+	steamID := server.AddAccount(steamtest.Account{
+		UserName: fakeLogin.UserName, Password: fakeLogin.Password, SharedSecret: steamtest.TestSecret, IdentitySecret: steamtest.TestSecret,
+		Wallet: 123456, Inventory: []*steamtest.Item{sampled, other},
+	})
+	now := time.Now()
+	history := []steamtest.PricePoint{}
+	for day := 30; day > 0; day-- {
+		history = append(history, steamtest.PricePoint{Time: now.AddDate(0, 0, -day), Price: 0.35 + float64(day%5)/100, Volume: 1000 + day})
+	}
+	server.AddMarketItem(steamtest.MarketItem{
+		AppID: uint32(app), HashName: hashName, LowestPrice: 38, MedianPrice: 36, Volume: 12345,
+		BuyOrders:    []steamtest.OrderLevel{{Price: 35, Quantity: 1520}, {Price: 34, Quantity: 8410}, {Price: 33, Quantity: 2304}},
+		SellOrders:   []steamtest.OrderLevel{{Price: 38, Quantity: 640}, {Price: 39, Quantity: 1182}, {Price: 1250, Quantity: 3}},
+		PriceHistory: history,
+	})
+	server.AddHistory(steamID, steamtest.HistoryEntry{Item: sampled, Price: 1234, Time: now.AddDate(0, 0, -3), Purchased: true})
+	server.AddHistory(steamID, steamtest.HistoryEntry{Item: other, Price: 123456, Time: now.AddDate(0, 0, -2)})
+	server.AddHistory(steamID, steamtest.HistoryEntry{Item: sampled, Price: 38, Time: now.AddDate(0, 0, -1), Purchased: true})
+	return server
+}
+
+// Send the requests for the steam hosts to the fake server, so the fixture holds the real URLs
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := req.Clone(req.Context())
+	next.URL.Scheme = t.target.Scheme
+	next.URL.Host = t.target.Host
+	next.Host = ""
+	return http.DefaultTransport.RoundTrip(next)
+}
+
+// A Core logged in to server, then switched to the production endpoints served by it
+func newFakeCore(server *steamtest.Server) (*auth.Core, error) {
+	core := server.NewCore(fakeLogin)
+	if err := core.Login(); err != nil {
+		return nil, err
+	}
+	target, _ := url.Parse(server.URL())
+	core.SetEndpoints(common.Endpoints{})
+	core.SetTransport(&redirectTransport{target: target})
+	core.ApplyCookie()
+	return core, nil
+}
@@ -0,0 +1,123 @@
+// Refresh the regression fixtures of the scraping code with a real account:
+//
+//	STEAM_USER=user STEAM_PASSWORD=password STEAM_SHARED_SECRET=secret go run ./cmd/fixture
+//
+// STEAM_COOKIE may hold the output of auth.Core.CookieString to skip the login,
+// STEAM_PROXY sets the http proxy. The exchanges are sanitized before they are written
+// to testdata/fixtures/steam.json; review the diff before committing it.
+//
+// -fake records the steamtest server instead to testdata/fixtures/fake_smoke.json, it needs
+// no account. That smoke fixture holds our own markup, it cannot catch a change of steam.
+//
+// Both fixtures are replayed by the tests of pkg/replay, which check the parsed results.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/inventory"
+	"github.com/umichan0621/steam/pkg/market"
+	"github.com/umichan0621/steam/pkg/replay"
+)
+
+func main() {
+	out := flag.String("out", "", "fixture file to write, testdata/fixtures/steam.json or fake_smoke.json with -fake")
+	appID := flag.String("appid", "730", "app ID of the sampled item")
+	contextID := flag.String("contextid", "2", "context ID of the inventory")
+	hashName := flag.String("hashname", "Chroma 2 Case", "market hash name of the sampled item")
+	fake := flag.Bool("fake", false, "record the steamtest server instead of steam")
+	flag.Parse()
+	if *out == "" {
+		*out = "testdata/fixtures/steam.json"
+		if *fake {
+			*out = "testdata/fixtures/fake_smoke.json"
+		}
+	}
+
+	mgr := &auth.Core{}
+	mgr.Init(
+		auth.LoginInfo{
+			UserName:     os.Getenv("STEAM_USER"),
+			Password:     os.Getenv("STEAM_PASSWORD"),
+			SharedSecret: os.Getenv("STEAM_SHARED_SECRET"),
+		})
+	err := mgr.SetHttpParam(10000, os.Getenv("STEAM_PROXY"))
+	if err != nil {
+		fail(err)
+	}
+	if *fake {
+		server := newFakeServer(*appID, *hashName)
+		defer server.Close()
+		mgr, err = newFakeCore(server)
+		if err != nil {
+			fail(err)
+		}
+	} else if cookie := os.Getenv("STEAM_COOKIE"); cookie != "" {
+		err = mgr.SetCookie(cookie)
+		if err != nil {
+			fail(err)
+		}
+		mgr.ApplyCookie()
+	} else {
+		// The login exchanges are never recorded
+		err = mgr.Login()
+		if err != nil {
+			fail(err)
+		}
+	}
+
+	recorder, err := replay.NewRecorder(*out, replay.ModeRecord, mgr.HttpClient().Transport)
+	if err != nil {
+		fail(err)
+	}
+	if userName := mgr.UserName(); userName != "" {
+		recorder.SetReplace(map[string]string{userName: "USERNAME"})
+	}
+	mgr.SetTransport(recorder)
+
+	marketCore := market.Core{}
+	marketCore.Init()
+	walletInfo, err := inventory.WalletBalance(mgr)
+	report("wallet", walletInfo, err)
+	if err == nil {
+		marketCore.SetCurrency(walletInfo.WalletBalance.Currency)
+		marketCore.SetCountry(walletInfo.WalletCountry)
+	}
+	items := []inventory.InventoryItem{}
+	_, _, err = inventory.AllItems(mgr, *appID, *contextID, "", 50, &items)
+	report("inventory", len(items), err)
+	itemNameID, err := market.ItemNameID(mgr, *appID, *hashName)
+	report("item name ID", itemNameID, err)
+	if err == nil {
+		orderGraph, err := market.ItemOrderGraph(mgr, marketCore.Country(), marketCore.Currency(), *appID, itemNameID)
+		report("order graph", orderGraph, err)
+	}
+	priceList, err := marketCore.PriceHistory(mgr, *appID, *hashName, 30)
+	report("price history", len(priceList), err)
+	overview, err := marketCore.PriceOverview(mgr, *appID, marketCore.Country(), marketCore.Currency(), *hashName)
+	report("price overview", overview, err)
+	orderList, err := market.HistoryOrder(mgr, *appID, *contextID, 0, 20)
+	report("market history", len(orderList), err)
+
+	err = recorder.Save()
+	if err != nil {
+		fail(err)
+	}
+	fmt.Printf("fixture written to %s\n", *out)
+}
+
+func report(name string, result any, err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %s\n", name, err.Error())
+		return
+	}
+	fmt.Printf("%s: %v\n", name, result)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
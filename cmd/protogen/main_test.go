@@ -0,0 +1,17 @@
+package main
+
+import "testing"
+
+// Same as go run ./cmd/protogen -check, from the directory of the package
+func TestGeneratedUpToDate(t *testing.T) {
+	drift, err := update("../../proto", "../../pkg/proto", "github.com/umichan0621/steam/pkg/proto;proto", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range drift {
+		t.Error(file)
+	}
+	if len(drift) != 0 {
+		t.Fatal("pkg/proto differs from the definitions, run go run ./cmd/protogen")
+	}
+}
@@ -0,0 +1,683 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// The subset of the proto2 and proto3 language used by the steam definitions:
+// messages, enums, oneofs, services, defaults and the deprecated and packed options.
+// Custom options are parsed and dropped, maps, groups and extensions are refused
+
+var scalarTypes = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"double":   descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"float":    descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	"int64":    descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"uint64":   descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"int32":    descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"fixed64":  descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+	"fixed32":  descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+	"bool":     descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"string":   descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"bytes":    descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+	"uint32":   descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"sfixed32": descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+	"sfixed64": descriptorpb.FieldDescriptorProto_TYPE_SFIXED64,
+	"sint32":   descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+	"sint64":   descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+}
+
+type token struct {
+	text  string
+	str   bool // quoted string, text is unquoted
+	line  int
+	empty bool // end of file
+}
+
+type parser struct {
+	name   string
+	tokens []token
+	pos    int
+	file   *descriptorpb.FileDescriptorProto
+	refs   []*typeRef
+}
+
+// A field or method type resolved once every file is parsed
+type typeRef struct {
+	scope string // full name of the enclosing message or package, without the leading dot
+	name  string
+	file  string
+	line  int
+	set   func(fullName string, enum bool)
+}
+
+func parseFile(name, src string) (*descriptorpb.FileDescriptorProto, []*typeRef, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s:%w", name, err)
+	}
+	p := &parser{name: name, tokens: tokens, file: &descriptorpb.FileDescriptorProto{Name: proto.String(name)}}
+	if err := p.parseFile(); err != nil {
+		return nil, nil, err
+	}
+	return p.file, p.refs, nil
+}
+
+func tokenize(src string) ([]token, error) {
+	tokens := []token{}
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("%d: unterminated comment", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(src) && src[j] != c {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("%d: unterminated string", line)
+			}
+			text, err := strconv.Unquote(`"` + strings.ReplaceAll(src[i+1:j], `"`, `\"`) + `"`)
+			if err != nil {
+				return nil, fmt.Errorf("%d: %w", line, err)
+			}
+			tokens = append(tokens, token{text: text, str: true, line: line})
+			i = j + 1
+		case isIdent(rune(c)) || c == '.' || c == '-' || c == '+':
+			j := i + 1
+			for j < len(src) && (isIdent(rune(src[j])) || src[j] == '.' ||
+				((src[j] == '-' || src[j] == '+') && (src[j-1] == 'e' || src[j-1] == 'E') && unicode.IsDigit(rune(src[i])))) {
+				j++
+			}
+			tokens = append(tokens, token{text: src[i:j], line: line})
+			i = j
+		case strings.ContainsRune("{}[]()<>=;,", rune(c)):
+			tokens = append(tokens, token{text: string(c), line: line})
+			i++
+		default:
+			return nil, fmt.Errorf("%d: unexpected character %q", line, c)
+		}
+	}
+	return append(tokens, token{empty: true, line: line}), nil
+}
+
+func isIdent(r rune) bool { return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) }
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if !tok.empty {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%s:%d: %s", p.name, p.peek().line, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) expect(text string) error {
+	if tok := p.peek(); tok.str || tok.text != text {
+		return p.errorf("expected %q, got %q", text, tok.text)
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) ident() (string, error) {
+	tok := p.peek()
+	if tok.str || tok.empty || !isIdent(rune(tok.text[0])) && tok.text[0] != '.' {
+		return "", p.errorf("expected an identifier, got %q", tok.text)
+	}
+	return p.next().text, nil
+}
+
+func (p *parser) number() (int32, error) {
+	tok := p.next()
+	value, err := strconv.ParseInt(tok.text, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%s:%d: invalid number %q", p.name, tok.line, tok.text)
+	}
+	return int32(value), nil
+}
+
+func (p *parser) parseFile() error {
+	pkg := ""
+	for !p.peek().empty {
+		switch p.peek().text {
+		case "syntax":
+			p.next()
+			if err := p.expect("="); err != nil {
+				return err
+			}
+			syntax := p.next()
+			if syntax.text != "proto2" && syntax.text != "proto3" {
+				return p.errorf("unsupported syntax %q", syntax.text)
+			}
+			if syntax.text == "proto3" {
+				p.file.Syntax = proto.String("proto3")
+			}
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "package":
+			p.next()
+			name, err := p.ident()
+			if err != nil {
+				return err
+			}
+			pkg = name
+			p.file.Package = proto.String(name)
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "import":
+			p.next()
+			if tok := p.peek(); tok.text == "public" || tok.text == "weak" {
+				return p.errorf("%s imports are not supported", tok.text)
+			}
+			if tok := p.next(); !tok.str {
+				return p.errorf("expected the imported file")
+			} else {
+				p.file.Dependency = append(p.file.Dependency, tok.text)
+			}
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "option":
+			if err := p.fileOption(); err != nil {
+				return err
+			}
+		case "message":
+			msg, err := p.message(pkg)
+			if err != nil {
+				return err
+			}
+			p.file.MessageType = append(p.file.MessageType, msg)
+		case "enum":
+			enum, err := p.enum()
+			if err != nil {
+				return err
+			}
+			p.file.EnumType = append(p.file.EnumType, enum)
+		case "service":
+			service, err := p.service(pkg)
+			if err != nil {
+				return err
+			}
+			p.file.Service = append(p.file.Service, service)
+		case ";":
+			p.next()
+		default:
+			return p.errorf("unexpected %q", p.peek().text)
+		}
+	}
+	return nil
+}
+
+func (p *parser) fileOption() error {
+	p.next()
+	name, value, err := p.option()
+	if err != nil {
+		return err
+	}
+	if p.file.Options == nil {
+		p.file.Options = &descriptorpb.FileOptions{}
+	}
+	switch name {
+	case "go_package":
+		p.file.Options.GoPackage = proto.String(value.text)
+	case "cc_generic_services":
+		p.file.Options.CcGenericServices = proto.Bool(value.text == "true")
+	default:
+		if !strings.HasPrefix(name, "(") {
+			return p.errorf("unsupported file option %q", name)
+		}
+	}
+	return p.expect(";")
+}
+
+// name = value, the name of a custom option keeps its parentheses
+func (p *parser) option() (string, token, error) {
+	name := ""
+	if p.peek().text == "(" {
+		p.next()
+		ext, err := p.ident()
+		if err != nil {
+			return "", token{}, err
+		}
+		if err := p.expect(")"); err != nil {
+			return "", token{}, err
+		}
+		name = "(" + ext + ")"
+		if tok := p.peek(); !tok.str && strings.HasPrefix(tok.text, ".") {
+			name += p.next().text
+		}
+	} else {
+		var err error
+		if name, err = p.ident(); err != nil {
+			return "", token{}, err
+		}
+	}
+	if err := p.expect("="); err != nil {
+		return "", token{}, err
+	}
+	if p.peek().text == "{" {
+		return "", token{}, p.errorf("aggregate option values are not supported")
+	}
+	return name, p.next(), nil
+}
+
+func (p *parser) message(scope string) (*descriptorpb.DescriptorProto, error) {
+	p.next()
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(name)}
+	fullName := join(scope, name)
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for p.peek().text != "}" {
+		switch tok := p.peek(); {
+		case tok.empty:
+			return nil, p.errorf("unterminated message %s", name)
+		case tok.text == "message":
+			nested, err := p.message(fullName)
+			if err != nil {
+				return nil, err
+			}
+			msg.NestedType = append(msg.NestedType, nested)
+		case tok.text == "enum":
+			enum, err := p.enum()
+			if err != nil {
+				return nil, err
+			}
+			msg.EnumType = append(msg.EnumType, enum)
+		case tok.text == "oneof":
+			p.next()
+			oneofName, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			index := int32(len(msg.OneofDecl))
+			msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String(oneofName)})
+			if err := p.expect("{"); err != nil {
+				return nil, err
+			}
+			for p.peek().text != "}" {
+				if p.peek().empty {
+					return nil, p.errorf("unterminated oneof %s", oneofName)
+				}
+				field, err := p.field(fullName, true)
+				if err != nil {
+					return nil, err
+				}
+				field.OneofIndex = proto.Int32(index)
+				msg.Field = append(msg.Field, field)
+			}
+			p.next()
+		case tok.text == "option", tok.text == "reserved", tok.text == "extensions":
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		case tok.text == "map", tok.text == "group", tok.text == "extend":
+			return nil, p.errorf("%s is not supported", tok.text)
+		case tok.text == ";":
+			p.next()
+		default:
+			field, err := p.field(fullName, false)
+			if err != nil {
+				return nil, err
+			}
+			msg.Field = append(msg.Field, field)
+		}
+	}
+	p.next()
+	return msg, nil
+}
+
+func (p *parser) field(scope string, inOneof bool) (*descriptorpb.FieldDescriptorProto, error) {
+	field := &descriptorpb.FieldDescriptorProto{Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()}
+	proto3 := p.file.GetSyntax() == "proto3"
+	switch label := p.peek().text; {
+	case inOneof:
+	case label == "optional":
+		p.next()
+		if proto3 {
+			return nil, p.errorf("proto3 optional is not supported")
+		}
+	case label == "required":
+		p.next()
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_REQUIRED.Enum()
+	case label == "repeated":
+		p.next()
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	case !proto3:
+		return nil, p.errorf("missing the label of a proto2 field")
+	}
+	typeName, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	field.Name = proto.String(name)
+	field.JsonName = proto.String(jsonName(name))
+	if err := p.expect("="); err != nil {
+		return nil, err
+	}
+	number, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+	field.Number = proto.Int32(number)
+	if scalar, ok := scalarTypes[typeName]; ok {
+		field.Type = scalar.Enum()
+	} else {
+		line := p.peek().line
+		p.refs = append(p.refs, &typeRef{scope: scope, name: typeName, file: p.name, line: line, set: func(fullName string, enum bool) {
+			field.TypeName = proto.String("." + fullName)
+			field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			if enum {
+				field.Type = descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum()
+			}
+		}})
+	}
+	if p.peek().text == "[" {
+		p.next()
+		for {
+			optName, value, err := p.option()
+			if err != nil {
+				return nil, err
+			}
+			switch optName {
+			case "default":
+				field.DefaultValue = proto.String(value.text)
+			case "deprecated":
+				if field.Options == nil {
+					field.Options = &descriptorpb.FieldOptions{}
+				}
+				field.Options.Deprecated = proto.Bool(value.text == "true")
+			case "packed":
+				if field.Options == nil {
+					field.Options = &descriptorpb.FieldOptions{}
+				}
+				field.Options.Packed = proto.Bool(value.text == "true")
+			default:
+				if !strings.HasPrefix(optName, "(") {
+					return nil, p.errorf("unsupported field option %q", optName)
+				}
+			}
+			if p.peek().text != "," {
+				break
+			}
+			p.next()
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+	}
+	return field, p.expect(";")
+}
+
+func (p *parser) enum() (*descriptorpb.EnumDescriptorProto, error) {
+	p.next()
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	enum := &descriptorpb.EnumDescriptorProto{Name: proto.String(name)}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for p.peek().text != "}" {
+		switch tok := p.peek(); {
+		case tok.empty:
+			return nil, p.errorf("unterminated enum %s", name)
+		case tok.text == "option", tok.text == "reserved":
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		case tok.text == ";":
+			p.next()
+		default:
+			valueName, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			number, err := p.number()
+			if err != nil {
+				return nil, err
+			}
+			if p.peek().text == "[" {
+				if err := p.skipBrackets(); err != nil {
+					return nil, err
+				}
+			}
+			enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{Name: proto.String(valueName), Number: proto.Int32(number)})
+			if err := p.expect(";"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	p.next()
+	return enum, nil
+}
+
+func (p *parser) service(scope string) (*descriptorpb.ServiceDescriptorProto, error) {
+	p.next()
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	service := &descriptorpb.ServiceDescriptorProto{Name: proto.String(name)}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for p.peek().text != "}" {
+		switch tok := p.peek(); {
+		case tok.empty:
+			return nil, p.errorf("unterminated service %s", name)
+		case tok.text == "option":
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		case tok.text == ";":
+			p.next()
+		case tok.text == "rpc":
+			p.next()
+			methodName, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			method := &descriptorpb.MethodDescriptorProto{Name: proto.String(methodName)}
+			for i, set := range []func(string){
+				func(fullName string) { method.InputType = proto.String("." + fullName) },
+				func(fullName string) { method.OutputType = proto.String("." + fullName) },
+			} {
+				if i == 1 {
+					if err := p.expect("returns"); err != nil {
+						return nil, err
+					}
+				}
+				if err := p.expect("("); err != nil {
+					return nil, err
+				}
+				if p.peek().text == "stream" {
+					return nil, p.errorf("streaming methods are not supported")
+				}
+				line := p.peek().line
+				typeName, err := p.ident()
+				if err != nil {
+					return nil, err
+				}
+				p.refs = append(p.refs, &typeRef{scope: scope, name: typeName, file: p.name, line: line, set: func(fullName string, enum bool) {
+					set(fullName)
+				}})
+				if err := p.expect(")"); err != nil {
+					return nil, err
+				}
+			}
+			if p.peek().text == "{" {
+				if err := p.skipBlock(); err != nil {
+					return nil, err
+				}
+			} else if err := p.expect(";"); err != nil {
+				return nil, err
+			}
+			service.Method = append(service.Method, method)
+		default:
+			return nil, p.errorf("unexpected %q in service %s", tok.text, name)
+		}
+	}
+	p.next()
+	return service, nil
+}
+
+func (p *parser) skipStatement() error {
+	for tok := p.next(); tok.text != ";" || tok.str; tok = p.next() {
+		if tok.empty {
+			return p.errorf("unterminated statement")
+		}
+	}
+	return nil
+}
+
+func (p *parser) skipBrackets() error {
+	for tok := p.next(); tok.text != "]" || tok.str; tok = p.next() {
+		if tok.empty {
+			return p.errorf("unterminated options")
+		}
+	}
+	return nil
+}
+
+func (p *parser) skipBlock() error {
+	depth := 0
+	for {
+		tok := p.next()
+		switch {
+		case tok.empty:
+			return p.errorf("unterminated block")
+		case tok.str:
+		case tok.text == "{":
+			depth++
+		case tok.text == "}":
+			if depth--; depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+// Resolve the type references like protoc, from the innermost scope outwards
+func resolve(refs []*typeRef, types map[string]bool) error {
+	for _, ref := range refs {
+		candidates := []string{}
+		if strings.HasPrefix(ref.name, ".") {
+			candidates = append(candidates, ref.name[1:])
+		} else {
+			for scope := ref.scope; ; {
+				candidates = append(candidates, join(scope, ref.name))
+				if scope == "" {
+					break
+				}
+				if i := strings.LastIndex(scope, "."); i >= 0 {
+					scope = scope[:i]
+				} else {
+					scope = ""
+				}
+			}
+		}
+		found := false
+		for _, fullName := range candidates {
+			if enum, ok := types[fullName]; ok {
+				ref.set(fullName, enum)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s:%d: unknown type %s", ref.file, ref.line, ref.name)
+		}
+	}
+	return nil
+}
+
+// Full names of the messages and enums of the file, true for the enums
+func declaredTypes(file *descriptorpb.FileDescriptorProto, types map[string]bool) {
+	var walk func(scope string, msg *descriptorpb.DescriptorProto)
+	walk = func(scope string, msg *descriptorpb.DescriptorProto) {
+		fullName := join(scope, msg.GetName())
+		types[fullName] = false
+		for _, enum := range msg.EnumType {
+			types[join(fullName, enum.GetName())] = true
+		}
+		for _, nested := range msg.NestedType {
+			walk(fullName, nested)
+		}
+	}
+	for _, enum := range file.EnumType {
+		types[join(file.GetPackage(), enum.GetName())] = true
+	}
+	for _, msg := range file.MessageType {
+		walk(file.GetPackage(), msg)
+	}
+}
+
+func join(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// The json name protoc derives from a field name
+func jsonName(name string) string {
+	res := strings.Builder{}
+	upper := false
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upper = true
+		case upper:
+			res.WriteRune(unicode.ToUpper(r))
+			upper = false
+		default:
+			res.WriteRune(r)
+		}
+	}
+	return res.String()
+}
@@ -6,7 +6,8 @@ require (
 	github.com/sirupsen/logrus v1.9.3
 	github.com/tidwall/gjson v1.18.0
 	golang.org/x/net v0.40.0
-	google.golang.org/protobuf v1.36.6
+	google.golang.org/protobuf v1.36.6 // pinned, cmd/protogen imports its internal_gengo
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
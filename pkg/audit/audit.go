@@ -0,0 +1,161 @@
+// Package audit records every state-changing call of the library, with the job that made it,
+// into an append-only sink. Every record holds the hash of the previous one,
+// so a record edited or removed afterwards breaks the chain checked by Verify.
+package audit
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	OutcomeOK    = "ok"
+	OutcomeError = "error"
+)
+
+type Record struct {
+	Seq       uint64          `json:"seq"`
+	Time      time.Time       `json:"time"`
+	Account   string          `json:"account"`
+	SteamID   string          `json:"steamid,omitempty"`
+	Caller    string          `json:"caller,omitempty"` // label set with WithCaller
+	Operation string          `json:"operation"`        // e.g. market.CreateBuyOrder
+	Params    json.RawMessage `json:"params,omitempty"`
+	Response  json.RawMessage `json:"response,omitempty"`
+	Outcome   string          `json:"outcome"`
+	EResult   string          `json:"eresult,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	DryRun    bool            `json:"dry_run,omitempty"` // simulated by a dryrun.Simulator, steam never saw it
+	PrevHash  string          `json:"prev_hash"`
+	Hash      string          `json:"hash,omitempty"`
+}
+
+// Destination of the records, it must only append
+type Sink interface {
+	Append(record *Record) error
+}
+
+// Optional Sink extension returning the last record, so a new Log continues its chain;
+// nil while the sink is empty
+type Tailer interface {
+	Last() (*Record, error)
+}
+
+// Log chains the records and writes them to the sink, it is safe for concurrent use
+// and may be shared between cores. A sink must be written by a single Log
+type Log struct {
+	mu   sync.Mutex
+	sink Sink
+	seq  uint64
+	last string // hash of the last record
+}
+
+// Continue the chain of the sink while it implements Tailer
+func New(sink Sink) (*Log, error) {
+	l := &Log{sink: sink}
+	if tailer, ok := sink.(Tailer); ok {
+		last, err := tailer.Last()
+		if err != nil {
+			return nil, fmt.Errorf("fail to read the last audit record, %w", err)
+		}
+		if last != nil {
+			l.seq, l.last = last.Seq, last.Hash
+		}
+	}
+	return l, nil
+}
+
+// Number, chain and write the record
+func (l *Log) Append(record Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	record.Seq = l.seq + 1
+	record.PrevHash = l.last
+	hash, err := Hash(&record)
+	if err != nil {
+		return err
+	}
+	record.Hash = hash
+	if err := l.sink.Append(&record); err != nil {
+		return err
+	}
+	l.seq, l.last = record.Seq, record.Hash
+	return nil
+}
+
+// Hash of the record with its Hash field cleared, the previous hash included
+func Hash(record *Record) (string, error) {
+	tmp := *record
+	tmp.Hash = ""
+	data, err := json.Marshal(&tmp)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Returned by Verify at the first record breaking the chain
+type ChainError struct {
+	Seq    uint64 // 0 while the line is not a record
+	Line   int
+	Reason string
+}
+
+func (e *ChainError) Error() string {
+	return fmt.Sprintf("audit chain broken at line %d (seq %d): %s", e.Line, e.Seq, e.Reason)
+}
+
+// Check the chain of a JSONL audit file from its first record, return the number of records
+func Verify(r io.Reader) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	count, line := 0, 0
+	prev := (*Record)(nil)
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return count, &ChainError{Line: line, Reason: err.Error()}
+		}
+		hash, err := Hash(record)
+		if err != nil {
+			return count, &ChainError{Seq: record.Seq, Line: line, Reason: err.Error()}
+		}
+		switch {
+		case hash != record.Hash:
+			return count, &ChainError{Seq: record.Seq, Line: line, Reason: "hash mismatch"}
+		case prev == nil && (record.Seq != 1 || record.PrevHash != ""):
+			return count, &ChainError{Seq: record.Seq, Line: line, Reason: "not the head of the chain"}
+		case prev != nil && record.PrevHash != prev.Hash:
+			return count, &ChainError{Seq: record.Seq, Line: line, Reason: "previous hash mismatch"}
+		case prev != nil && record.Seq != prev.Seq+1:
+			return count, &ChainError{Seq: record.Seq, Line: line, Reason: "sequence gap"}
+		}
+		prev = record
+		count++
+	}
+	return count, scanner.Err()
+}
+
+type callerKey struct{}
+
+// Label the state-changing calls made with ctx, e.g. the name of the job
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+func CallerFrom(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
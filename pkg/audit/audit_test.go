@@ -0,0 +1,95 @@
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/audit"
+)
+
+// Write n records to a new file, the last one through a second Log continuing the chain
+func writeLog(t *testing.T, n int) []string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	for i := 0; i < n; i++ {
+		file, err := audit.NewFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		log, err := audit.New(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		record := audit.Record{
+			Time:      time.Date(2024, 3, 1, 12, i, 0, 0, time.UTC),
+			Account:   "bot",
+			Operation: "market.CreateBuyOrder",
+			Params:    json.RawMessage(`{"price":100}`),
+			Outcome:   audit.OutcomeOK,
+		}
+		if err := log.Append(record); err != nil {
+			t.Fatal(err)
+		}
+		file.Close()
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func edit(t *testing.T, line string, rehash bool) string {
+	t.Helper()
+	record := &audit.Record{}
+	if err := json.Unmarshal([]byte(line), record); err != nil {
+		t.Fatal(err)
+	}
+	record.Params = json.RawMessage(`{"price":1}`)
+	if rehash {
+		record.Hash, _ = audit.Hash(record)
+	}
+	data, _ := json.Marshal(record)
+	return string(data)
+}
+
+func TestVerify(t *testing.T) {
+	lines := writeLog(t, 4)
+	count, err := audit.Verify(strings.NewReader(strings.Join(lines, "\n")))
+	if err != nil || count != 4 {
+		t.Fatalf("count = %d, err = %v", count, err)
+	}
+
+	tests := []struct {
+		name   string
+		lines  []string
+		line   int
+		reason string
+	}{
+		{"edited", []string{lines[0], edit(t, lines[1], false), lines[2], lines[3]}, 2, "hash mismatch"},
+		{"edited and rehashed", []string{lines[0], edit(t, lines[1], true), lines[2], lines[3]}, 3, "previous hash mismatch"},
+		{"removed", []string{lines[0], lines[2], lines[3]}, 2, "previous hash mismatch"},
+		{"head removed", lines[1:], 1, "not the head of the chain"},
+		{"head edited and rehashed", []string{edit(t, lines[0], true), lines[1], lines[2], lines[3]}, 2, "previous hash mismatch"},
+		{"swapped", []string{lines[0], lines[2], lines[1], lines[3]}, 2, "previous hash mismatch"},
+		{"not a record", []string{lines[0], "garbage", lines[1]}, 2, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := audit.Verify(bytes.NewReader([]byte(strings.Join(tt.lines, "\n"))))
+			var chainErr *audit.ChainError
+			if !errors.As(err, &chainErr) || chainErr.Line != tt.line {
+				t.Fatalf("err = %v, want a break at line %d", err, tt.line)
+			}
+			if tt.reason != "" && chainErr.Reason != tt.reason {
+				t.Fatalf("reason = %s, want %s", chainErr.Reason, tt.reason)
+			}
+		})
+	}
+}
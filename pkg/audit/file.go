@@ -0,0 +1,73 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// File appends the records to a JSONL file, one record per line, synced after every write
+type File struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+// The file is created if missing, and only ever appended to
+func NewFile(path string) (*File, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &File{path: path, file: file}, nil
+}
+
+func (f *File) Append(record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return f.file.Sync()
+}
+
+func (f *File) Last() (*Record, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.Open(f.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	last := []byte(nil)
+	for scanner.Scan() {
+		if line := bytes.TrimSpace(scanner.Bytes()); len(line) != 0 {
+			last = append(last[:0], line...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if last == nil {
+		return nil, nil
+	}
+	record := &Record{}
+	if err := json.Unmarshal(last, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (f *File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
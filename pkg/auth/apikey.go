@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/tidwall/gjson"
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/retry"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+// Credential sent with the web api calls
+type APIAuth int
+
+const (
+	APIAuthDefault APIAuth = iota // the access token while logged in, the web api key otherwise
+	APIAuthToken                  // the access token only
+	APIAuthKey                    // the web api key only, e.g. for ISteamUser
+)
+
+func (mode APIAuth) String() string {
+	switch mode {
+	case APIAuthToken:
+		return "Token"
+	case APIAuthKey:
+		return "Key"
+	}
+	return "Default"
+}
+
+// Returned by FetchAPIKey while the account has registered no web api key
+var ErrNoAPIKey = errors.New("no web api key registered")
+
+// Interval between the checks of a registration waiting for its mobile confirmation
+const apiKeyPollInterval = 5 * time.Second
+
+var apiKeyRegexp = regexp.MustCompile(`Key: ([0-9A-F]{32})`)
+
+func (core *Core) APIKey() string {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.apiKey
+}
+
+// Used by the web api calls choosing the key, set by FetchAPIKey and RegisterAPIKey
+func (core *Core) SetAPIKey(key string) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.apiKey = key
+}
+
+func (core *Core) APIAuth() APIAuth {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.apiAuth
+}
+
+// Credential of the web api calls made without WithAPIAuth, APIAuthDefault after Init
+func (core *Core) SetAPIAuth(mode APIAuth) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.apiAuth = mode
+}
+
+type apiAuthKey struct{}
+
+// Choose the credential of the web api calls made with ctx, over the one of the Core
+func WithAPIAuth(ctx context.Context, mode APIAuth) context.Context {
+	return context.WithValue(ctx, apiAuthKey{}, mode)
+}
+
+// Add the credential chosen for ctx to the query of a web api call,
+// fail with errcode.ErrNotLoggedOn while the chosen credential is missing
+func (core *Core) AuthorizeAPI(ctx context.Context, query url.Values) error {
+	mode, ok := ctx.Value(apiAuthKey{}).(APIAuth)
+	if !ok {
+		mode = core.APIAuth()
+	}
+	token, key := core.AccessToken(), core.APIKey()
+	switch mode {
+	case APIAuthToken:
+		if token == "" {
+			return errcode.New("", 0, errcode.EResultNotLoggedOn, "no access token")
+		}
+		query.Set("access_token", token)
+	case APIAuthKey:
+		if key == "" {
+			return errcode.New("", 0, errcode.EResultNotLoggedOn, "no web api key")
+		}
+		query.Set("key", key)
+	default:
+		if token != "" {
+			query.Set("access_token", token)
+		} else if key != "" {
+			query.Set("key", key)
+		}
+	}
+	return nil
+}
+
+func (core *Core) FetchAPIKey() (string, error) {
+	return core.FetchAPIKeyContext(context.Background())
+}
+
+// Read the web api key of the account from the dev/apikey page and keep it on the Core,
+// fail with ErrNoAPIKey while none is registered
+func (core *Core) FetchAPIKeyContext(ctx context.Context) (string, error) {
+	key, err := retry.Read(ctx, core, retry.OpFetchAPIKey, core.fetchAPIKey)
+	if err != nil {
+		return "", err
+	}
+	core.SetAPIKey(key)
+	return key, nil
+}
+
+func (core *Core) fetchAPIKey(ctx context.Context) (string, error) {
+	page, err := web.Page(ctx, core, &web.Request{Path: "/dev/apikey", Login: true})
+	if err != nil {
+		return "", err
+	}
+	match := apiKeyRegexp.FindStringSubmatch(page)
+	if match == nil {
+		return "", ErrNoAPIKey
+	}
+	return match[1], nil
+}
+
+func (core *Core) RegisterAPIKey(domain string) (string, error) {
+	return core.RegisterAPIKeyContext(context.Background(), domain)
+}
+
+// Register a web api key for domain and keep it on the Core. Steam asks for a mobile confirmation,
+// the call waits until it is accepted, e.g. with confirm.AnswerConfirmation, or until ctx is done
+func (core *Core) RegisterAPIKeyContext(ctx context.Context, domain string) (string, error) {
+	params := map[string]any{"domain": domain}
+	res, err := retry.Write(ctx, core, retry.OpRegisterAPIKey, func(ctx context.Context) (*apiKeyResponse, error) {
+		return core.requestAPIKey(ctx, domain, "0")
+	}, nil)
+	for err == nil && res.APIKey == "" {
+		core.Logger().Info("web api key waiting for the mobile confirmation", logging.F("request_id", res.RequestID))
+		if err = utils.SleepContext(ctx, core.Clock(), apiKeyPollInterval); err != nil {
+			break
+		}
+		requestID := res.RequestID
+		res, err = retry.Read(ctx, core, retry.OpAPIKeyStatus, func(ctx context.Context) (*apiKeyResponse, error) {
+			return core.requestAPIKey(ctx, domain, requestID)
+		})
+	}
+	core.Audit(ctx, retry.OpRegisterAPIKey.Name, params, nil, err)
+	if err != nil {
+		core.Logger().Warn("fail to register web api key", logging.Err(err))
+		return "", err
+	}
+	core.SetAPIKey(res.APIKey)
+	core.Logger().Info("web api key registered", logging.F("domain", domain))
+	return res.APIKey, nil
+}
+
+type apiKeyResponse struct {
+	APIKey    string
+	RequestID string
+}
+
+// A response without key waits for the confirmation of its request ID
+func (core *Core) requestAPIKey(ctx context.Context, domain, requestID string) (*apiKeyResponse, error) {
+	res, err := web.Do(ctx, core, &web.Request{
+		Method: http.MethodPost,
+		Path:   "/dev/requestkey",
+		Form: url.Values{
+			"domain":       {domain},
+			"request_id":   {requestID},
+			"agreeToTerms": {"true"},
+		},
+		Referer: "/dev/apikey",
+		Login:   true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := res.CheckStatus(); err != nil {
+		return nil, err
+	}
+	if !gjson.ValidBytes(res.Body) {
+		return nil, errcode.New(res.Endpoint, res.Status, errcode.EResultBadResponse, "invalid json")
+	}
+	body := gjson.ParseBytes(res.Body)
+	success := errcode.EResult(body.Get("success").Int())
+	pending := &apiKeyResponse{RequestID: body.Get("request_id").String()}
+	if success == errcode.EResultPending && body.Get("requires_confirmation").Bool() && pending.RequestID != "" {
+		return pending, nil
+	}
+	if err := errcode.CheckResult(res.Endpoint, res.Status, success, ""); err != nil {
+		return nil, err
+	}
+	key := body.Get("api_key").String()
+	if key == "" {
+		return nil, errcode.New(res.Endpoint, res.Status, errcode.EResultBadResponse, "no api_key in the response")
+	}
+	return &apiKeyResponse{APIKey: key}, nil
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/umichan0621/steam/pkg/audit"
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/logging"
+)
+
+func (core *Core) AuditLog() *audit.Log {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.audit
+}
+
+// Record every state-changing call of the Core, share the log between the cores; nil disables it
+func (core *Core) SetAuditLog(log *audit.Log) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.audit = log
+}
+
+// Record a state-changing call with its caller label from ctx, called by the packages once the
+// outcome is known, the simulated ones of dry run included. A failure to write the record is
+// logged, the call already happened
+func (core *Core) Audit(ctx context.Context, operation string, params, response any, err error) {
+	log := core.AuditLog()
+	if log == nil {
+		return
+	}
+	record := audit.Record{
+		Time:      core.Clock().Now().UTC(),
+		Account:   core.loginInfo.UserName,
+		Caller:    audit.CallerFrom(ctx),
+		Operation: operation,
+		Outcome:   audit.OutcomeOK,
+		DryRun:    core.DryRun() != nil,
+	}
+	if steamID := core.SteamID(); steamID != 0 {
+		record.SteamID = steamID.String()
+	}
+	record.Params, _ = json.Marshal(params)
+	if err != nil {
+		record.Outcome = audit.OutcomeError
+		record.EResult = errcode.EResultOf(err).String()
+		record.Error = err.Error()
+	} else if response != nil {
+		record.Response, _ = json.Marshal(response)
+	}
+	if err := log.Append(record); err != nil {
+		core.Logger().Error("fail to write audit record", logging.F("operation", operation), logging.Err(err))
+	}
+}
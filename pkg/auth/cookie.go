@@ -6,20 +6,23 @@ import (
 	"net/http/cookiejar"
 	"net/url"
 	"time"
+
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/steamid"
 )
 
 type CookieData struct {
 	SessionID        string
 	SteamLoginSecure string
 	RefreshToken     string
-	SteamID          string
+	SteamID          steamid.SteamID
 	Expires          int64
 	MaxAge           int
 	RefreshTime      time.Time
 }
 
 func (core *Core) CookieString() (string, error) {
-	cookieData, err := json.Marshal(core.cookieData)
+	cookieData, err := json.Marshal(core.Session())
 	if err != nil {
 		return "", err
 	}
@@ -32,18 +35,33 @@ func (core *Core) SetCookie(cookieString string) error {
 	if err != nil {
 		return err
 	}
+	core.mu.Lock()
 	core.cookieData = cookieData
+	core.mu.Unlock()
 	return nil
 }
 
 func (core *Core) ApplyCookie() {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.applyCookie()
+}
+
+// Replace the cookie jar with one built from cookieData, core.mu must be held
+func (core *Core) applyCookie() {
+	communityUrl, err := url.Parse(core.endpoints.Community)
+	if err != nil {
+		communityUrl, _ = url.Parse(common.URI_STEAM_COMMUNITY)
+	}
+	// Secure cookies are never sent to a plain http stand-in
+	secure := communityUrl.Scheme == "https"
 	cookieList := []*http.Cookie{}
 	cookie1 := http.Cookie{
 		Name:     "sessionid",
 		Value:    core.cookieData.SessionID,
 		Path:     "/",
 		HttpOnly: true,
-		Secure:   true,
+		Secure:   secure,
 		SameSite: http.SameSiteNoneMode,
 	}
 	cookie2 := http.Cookie{
@@ -53,7 +71,7 @@ func (core *Core) ApplyCookie() {
 		Expires:  time.Unix(core.cookieData.Expires, 0),
 		MaxAge:   core.cookieData.MaxAge,
 		HttpOnly: true,
-		Secure:   true,
+		Secure:   secure,
 		SameSite: http.SameSiteNoneMode,
 	}
 
@@ -61,17 +79,15 @@ func (core *Core) ApplyCookie() {
 	cookieList = append(cookieList, &cookie2)
 	cookieList = append(cookieList, &http.Cookie{Name: "mobileClientVersion", Value: "0 (2.1.3)"})
 	cookieList = append(cookieList, &http.Cookie{Name: "mobileClient", Value: "android"})
-	cookieList = append(cookieList, &http.Cookie{Name: "steamid", Value: core.cookieData.SteamID})
-	cookieList = append(cookieList, &http.Cookie{Name: "Steam_Language", Value: "english"})
+	if core.cookieData.SteamID != 0 {
+		cookieList = append(cookieList, &http.Cookie{Name: "steamid", Value: core.cookieData.SteamID.String()})
+	}
+	cookieList = append(cookieList, &http.Cookie{Name: "Steam_Language", Value: core.language.String()})
 	cookieList = append(cookieList, &http.Cookie{Name: "dob", Value: ""})
 	jar, _ := cookiejar.New(nil)
 
-	jar.SetCookies(
-		&url.URL{
-			Scheme: "https",
-			Host:   "steamcommunity.com",
-		},
-		cookieList,
-	)
-	core.httpClient.Jar = jar
+	jar.SetCookies(communityUrl, cookieList)
+	client := *core.httpClient
+	client.Jar = jar
+	core.httpClient = &client
 }
@@ -0,0 +1,43 @@
+package auth_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/steamid"
+)
+
+// The cookies saved before SteamID was typed hold it as a quoted string
+func TestSetCookieSteamID(t *testing.T) {
+	tests := []struct {
+		name   string
+		cookie string
+		want   steamid.SteamID
+	}{
+		{"quoted string", `{"SessionID":"abc","SteamLoginSecure":"76561197960287930%7C%7Ctoken","SteamID":"76561197960287930","Expires":0,"MaxAge":0}`, 76561197960287930},
+		{"number", `{"SessionID":"abc","SteamID":76561197960287930}`, 76561197960287930},
+		{"empty string", `{"SessionID":"abc","SteamID":""}`, 0},
+		{"missing", `{"SessionID":"abc"}`, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core := &auth.Core{}
+			core.Init(auth.LoginInfo{UserName: "bot"})
+			if err := core.SetCookie(tt.cookie); err != nil {
+				t.Fatal(err)
+			}
+			if got := core.Session().SteamID; got != tt.want {
+				t.Fatalf("steam id = %d, want %d", got, tt.want)
+			}
+			// Saved again as a quoted string, readable by the older versions
+			saved, err := core.CookieString()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(saved, `"SteamID":"`+tt.want.String()+`"`) {
+				t.Fatalf("cookie = %s", saved)
+			}
+		})
+	}
+}
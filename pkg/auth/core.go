@@ -7,7 +7,20 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/umichan0621/steam/pkg/audit"
+	"github.com/umichan0621/steam/pkg/cache"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/dryrun"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/observe"
+	"github.com/umichan0621/steam/pkg/policy"
+	"github.com/umichan0621/steam/pkg/ratelimit"
+	"github.com/umichan0621/steam/pkg/retry"
+	"github.com/umichan0621/steam/pkg/steamid"
+	"github.com/umichan0621/steam/pkg/utils"
 )
 
 type LoginInfo struct {
@@ -17,8 +30,29 @@ type LoginInfo struct {
 	IdentitySecret string
 }
 
+// Core is safe for concurrent use after Init.
+// The http client is never modified after it is published, SetHttpParam and
+// ApplyCookie replace it with a copy, so requests in flight are not affected
 type Core struct {
+	mu         sync.RWMutex // guard httpClient, transport, limiter, hooks, cache, logger, audit, policy, dryRun, apiKey, apiAuth, language, wallet, cookieData, endpoints, clock, random and retry
+	loginMu    sync.Mutex   // serialize Login and RefreshCookieWithToken
 	httpClient *http.Client
+	transport  http.RoundTripper // set by SetHttpParam or SetTransport, wrapped by the hooks and the limiter
+	limiter    *ratelimit.Limiter
+	hooks      observe.Hooks
+	cache      *cache.Cache
+	logger     logging.Logger
+	audit      *audit.Log
+	policy     *policy.Engine
+	dryRun     *dryrun.Simulator
+	apiKey     string
+	apiAuth    APIAuth
+	language   common.Language
+	wallet     WalletLocale
+	endpoints  common.Endpoints
+	clock      utils.Clock
+	random     utils.Rand
+	retry      retry.Policy
 	loginInfo  LoginInfo
 	cookieData CookieData
 	profileUrl string
@@ -28,28 +62,159 @@ type Core struct {
 func (core *Core) Init(info LoginInfo) {
 	core.loginInfo = info
 	core.httpClient = &http.Client{}
+	core.endpoints = common.DefaultEndpoints()
+	core.language = common.LanguageEnglish
+	core.clock = utils.SystemClock{}
+	core.random = utils.CryptoRand{}
+	core.retry = retry.DefaultPolicy()
+	core.logger = logging.NewSlog(nil)
 	core.profileUrl = ""
 	sum := md5.Sum([]byte(info.UserName + info.Password))
 	core.deviceID = fmt.Sprintf("android:%x-%x-%x-%x-%x",
 		sum[:2], sum[2:4], sum[4:6], sum[6:8], sum[8:10])
 }
 
-func (core *Core) HttpClient() *http.Client { return core.httpClient }
-func (core *Core) SteamID() string          { return core.cookieData.SteamID }
-func (core *Core) SessionID() string        { return core.cookieData.SessionID }
-func (core *Core) DeviceID() string         { return core.deviceID }
-func (core *Core) IdentitySecret() string   { return core.loginInfo.IdentitySecret }
-func (core *Core) RefreshTime() time.Time   { return core.cookieData.RefreshTime }
+func (core *Core) UserName() string       { return core.loginInfo.UserName }
+func (core *Core) DeviceID() string       { return core.deviceID }
+func (core *Core) IdentitySecret() string { return core.loginInfo.IdentitySecret }
+
+func (core *Core) HttpClient() *http.Client {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.httpClient
+}
+
+// A consistent snapshot of the current session
+func (core *Core) Session() CookieData {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.cookieData
+}
+
+func (core *Core) Endpoints() common.Endpoints {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.endpoints
+}
+
+// Empty fields keep the production value, call ApplyCookie after changing the community host
+func (core *Core) SetEndpoints(endpoints common.Endpoints) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.endpoints = endpoints.WithDefaults()
+}
+
+func (core *Core) Language() common.Language {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.language
+}
+
+// Currency and country of the wallet, steam never changes them once the account has a wallet
+type WalletLocale struct {
+	Currency common.Currency
+	Country  string
+}
+
+// The wallet of the account as last read by inventory.WalletBalance, false while never read
+func (core *Core) WalletLocale() (WalletLocale, bool) {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.wallet, core.wallet.Currency.ID != 0 && core.wallet.Country != ""
+}
+
+func (core *Core) SetWalletLocale(currency common.Currency, country string) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.wallet = WalletLocale{Currency: currency, Country: country}
+}
 
-func (core *Core) AccessToken() string {
-	temp := strings.Split(core.cookieData.SteamLoginSecure, "%7C%7C")
+// Sent as the Steam_Language cookie and the language parameters of every endpoint,
+// the dates and names of the pages are parsed in it
+func (core *Core) SetLanguage(language common.Language) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.language = language
+	if core.httpClient.Jar != nil {
+		core.applyCookie()
+	}
+}
+
+func (core *Core) Clock() utils.Clock {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.clock
+}
+
+// Used by every package for the current time and sleeps, e.g. utils.ManualClock in tests
+func (core *Core) SetClock(clock utils.Clock) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.clock = clock
+}
+
+func (core *Core) Rand() utils.Rand {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.random
+}
+
+// Used for the login jitter and the session ID, e.g. utils.NewRand(seed) in tests
+func (core *Core) SetRand(random utils.Rand) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.random = random
+}
+
+func (core *Core) RetryPolicy() retry.Policy {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.retry
+}
+
+// Applied to every operation run with this Core, MaxAttempts = 1 disables the retries
+func (core *Core) SetRetryPolicy(policy retry.Policy) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.retry = policy
+}
+
+// Logger with the account and steamid fields of the Core
+func (core *Core) Logger() logging.Logger {
+	core.mu.RLock()
+	logger, steamID := core.logger, core.cookieData.SteamID
+	core.mu.RUnlock()
+	fields := []logging.Field{logging.Account(core.loginInfo.UserName)}
+	if steamID != 0 {
+		fields = append(fields, logging.SteamID(steamID.String()))
+	}
+	return logger.With(fields...)
+}
+
+// Used by every package for the entries of this account, the current slog.Default() until set; nil discards them
+func (core *Core) SetLogger(logger logging.Logger) {
+	if logger == nil {
+		logger = logging.Nop()
+	}
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.logger = logger
+}
+
+func (core *Core) SteamID() steamid.SteamID { return core.Session().SteamID }
+func (core *Core) SessionID() string        { return core.Session().SessionID }
+func (core *Core) RefreshTime() time.Time   { return core.Session().RefreshTime }
+func (core *Core) AccessToken() string      { return core.Session().AccessToken() }
+
+func (cookieData CookieData) AccessToken() string {
+	temp := strings.Split(cookieData.SteamLoginSecure, "%7C%7C")
 	if len(temp) >= 2 {
 		return temp[1]
 	}
 	return ""
 }
 
-// timeout: millsecond, set only while timeout > 0;
+// timeout: millsecond, 0 means none and removes the one set before;
 // proxy: if proxyUrl == "", ignore
 func (core *Core) SetHttpParam(timeout int, proxy string) error {
 	transport := &http.Transport{}
@@ -60,6 +225,10 @@ func (core *Core) SetHttpParam(timeout int, proxy string) error {
 		}
 		transport.Proxy = http.ProxyURL(proxyUrl)
 	}
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	client := *core.httpClient
+	client.Timeout = 0
 	if timeout > 0 {
 		timeoutVal := time.Duration(timeout) * time.Millisecond
 		dialer := net.Dialer{Timeout: timeoutVal}
@@ -68,8 +237,104 @@ func (core *Core) SetHttpParam(timeout int, proxy string) error {
 		transport.TLSHandshakeTimeout = timeoutVal
 		transport.ResponseHeaderTimeout = timeoutVal
 		transport.ExpectContinueTimeout = timeoutVal
-		core.httpClient.Timeout = timeoutVal
+		client.Timeout = timeoutVal
 	}
-	core.httpClient.Transport = transport
+	core.httpClient = &client
+	core.installTransport(transport)
 	return nil
 }
+
+// Replace the transport of the http client, e.g. with a recording or mocking RoundTripper,
+// nil means http.DefaultTransport
+func (core *Core) SetTransport(transport http.RoundTripper) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.installTransport(transport)
+}
+
+func (core *Core) RateLimiter() *ratelimit.Limiter {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.limiter
+}
+
+// Throttle every request of the Core, share the limiter between the cores behind the same IP;
+// nil removes the limiter
+func (core *Core) SetRateLimiter(limiter *ratelimit.Limiter) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.limiter = limiter
+	core.installTransport(core.transport)
+}
+
+func (core *Core) Hooks() observe.Hooks {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.hooks
+}
+
+// Observe every request and operation of the Core, e.g. an observe.Metrics
+// or observe.Multi(metrics, observe.Tracing(tracer)); nil removes the hooks
+func (core *Core) SetHooks(hooks observe.Hooks) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.hooks = hooks
+	core.installTransport(core.transport)
+}
+
+func (core *Core) Policy() *policy.Engine {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.policy
+}
+
+// Check the buy orders, sell orders and trade acceptances of the Core against the engine,
+// share it between the cores to apply the same rules; nil disables the checks
+func (core *Core) SetPolicy(engine *policy.Engine) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.policy = engine
+}
+
+func (core *Core) DryRun() *dryrun.Simulator {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.dryRun
+}
+
+// Simulate the buy orders, sell orders, trade actions and confirmations of the Core against sim,
+// the reads still hit steam; nil sends them again
+func (core *Core) SetDryRun(sim *dryrun.Simulator) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.dryRun = sim
+}
+
+func (core *Core) Cache() *cache.Cache {
+	core.mu.RLock()
+	defer core.mu.RUnlock()
+	return core.cache
+}
+
+// Keep the public market data fetched with the Core, the cache may be shared between cores;
+// nil disables the cache
+func (core *Core) SetCache(c *cache.Cache) {
+	core.mu.Lock()
+	defer core.mu.Unlock()
+	core.cache = c
+}
+
+// Publish a client copy using transport, observed by the hooks and behind the limiter if any,
+// so every attempt of a throttled request is reported; core.mu must be held
+func (core *Core) installTransport(transport http.RoundTripper) {
+	core.transport = transport
+	client := *core.httpClient
+	client.Transport = transport
+	if core.hooks != nil {
+		client.Transport = observe.Transport(client.Transport, core.hooks, core.loginInfo.UserName)
+	}
+	if core.limiter != nil {
+		client.Transport = core.limiter.Transport(client.Transport)
+	}
+	core.httpClient = &client
+}
@@ -0,0 +1,55 @@
+package auth_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/umichan0621/steam/pkg/inventory"
+	"github.com/umichan0621/steam/pkg/steamtest"
+)
+
+// Run with -race: the session setters must not race with the requests in flight
+func TestCoreConcurrentSession(t *testing.T) {
+	_, core, _ := steamtest.NewLoggedIn(t, steamtest.Account{Wallet: 10000})
+	steamID := core.SteamID()
+
+	const rounds = 20
+	wg := sync.WaitGroup{}
+	errs := make(chan error, 4*rounds)
+	run := func(fn func()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				fn()
+			}
+		}()
+	}
+	run(func() {
+		if _, err := inventory.WalletBalance(core); err != nil {
+			errs <- err
+		}
+	})
+	run(func() {
+		if err := core.RefreshCookieWithToken(); err != nil {
+			errs <- err
+		}
+	})
+	run(core.ApplyCookie)
+	run(func() {
+		if err := core.SetHttpParam(5000, ""); err != nil {
+			errs <- err
+		}
+	})
+	run(func() {
+		session := core.Session()
+		if session.SteamID != steamID || session.AccessToken() == "" {
+			t.Errorf("inconsistent session: %+v", session)
+		}
+	})
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
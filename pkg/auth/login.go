@@ -2,107 +2,183 @@ package auth
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
 	"mime/multipart"
 	"net/http"
+	"os"
 	"strconv"
 	"strings"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
-	"github.com/umichan0621/steam/pkg/common"
 	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/logging"
 	pb "github.com/umichan0621/steam/pkg/proto"
+	"github.com/umichan0621/steam/pkg/retry"
+	"github.com/umichan0621/steam/pkg/steamid"
 	"github.com/umichan0621/steam/pkg/utils"
 	"google.golang.org/protobuf/proto"
 )
 
-const kURI_STEAM_SETTOKEN = common.URI_STEAM_COMMUNITY + "/login/settoken"
+// Returned by RefreshCookieWithToken while steam refuses the refresh token,
+// a full login is required to get a new one
+var ErrRefreshTokenRevoked = errors.New("refresh token is expired or revoked")
 
 func (core *Core) Login() error {
-	log.Info("Connecting to steam server...")
+	return core.LoginContext(context.Background())
+}
+
+// Cancelling ctx aborts the pending request and the pauses between the login steps
+func (core *Core) LoginContext(ctx context.Context) error {
+	return core.login(ctx, true)
+}
+
+// interactive: allow reading the guard code from stdin,
+// otherwise the login fails while the shared secret can not provide the code.
+// Run as a write without reconciliation, so it is observed but never sent twice
+func (core *Core) login(ctx context.Context, interactive bool) error {
+	_, err := retry.Write(ctx, core, retry.OpLogin, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, core.authenticate(ctx, interactive)
+	}, nil)
+	if err != nil {
+		core.Logger().Warn("login failed", logging.Err(err))
+	}
+	return err
+}
+
+func (core *Core) authenticate(ctx context.Context, interactive bool) error {
+	core.loginMu.Lock()
+	defer core.loginMu.Unlock()
+	logger := core.Logger()
+	logger.Debug("connecting to steam")
 	// Get RSA public key by proto message
 	rsaRes := pb.CAuthentication_GetPasswordRSAPublicKey_Response{}
-	err := core.getPasswordRSAPublicKey(&rsaRes)
+	err := core.getPasswordRSAPublicKey(ctx, &rsaRes)
 	if err != nil {
 		return err
 	}
-	encryptedPassword, err := core.encryptPassword(rsaRes.PublickeyMod, rsaRes.PublickeyExp)
+	encryptedPassword, err := core.encryptPassword(rsaRes.GetPublickeyMod(), rsaRes.GetPublickeyExp())
 	if err != nil {
 		return err
 	}
-	time.Sleep(time.Millisecond * time.Duration(utils.RandRange(120, 300)))
+	if err := core.sleepJitter(ctx); err != nil {
+		return err
+	}
 
-	log.Infof("Try login as user: %s...", core.loginInfo.UserName)
+	logger.Debug("beginning auth session")
 	// Try begin auth
 	beginAuthRes := pb.CAuthentication_BeginAuthSessionViaCredentials_Response{}
-	err = core.beginAuthSessionViaCredentials(encryptedPassword, rsaRes.Timestamp,
+	err = core.beginAuthSessionViaCredentials(ctx, encryptedPassword, rsaRes.GetTimestamp(),
 		&beginAuthRes)
 	if err != nil {
 		return err
 	}
-	time.Sleep(time.Millisecond * time.Duration(utils.RandRange(120, 300)))
+	if err := core.sleepJitter(ctx); err != nil {
+		return err
+	}
 
-	// Handle confirmation if exist
-	confirmationType := beginAuthRes.AllowedConfirmations.ConfirmationType
+	// Handle confirmation if exist, steam lists the preferred one first
+	confirmationType := beginAuthRes.GetAllowedConfirmations()[0].GetConfirmationType()
 	if confirmationType != pb.EAuthSessionGuardType_k_EAuthSessionGuardType_None {
-		log.Info("Need authentication...")
+		logger.Debug("steam guard required", logging.F("guard_type", confirmationType.String()))
 		updateAuthRes := pb.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response{}
-		core.updateAuthSessionWithSteamGuardCode(beginAuthRes.ClientId, beginAuthRes.SteamId, confirmationType,
-			&updateAuthRes)
+		err = core.updateAuthSessionWithSteamGuardCode(ctx, beginAuthRes.GetClientId(), beginAuthRes.GetSteamid(), confirmationType,
+			interactive, &updateAuthRes)
+		if err != nil {
+			return err
+		}
 	}
 
-	log.Info("Logging in...")
+	logger.Debug("polling auth session")
 	pollAuthRes := pb.CAuthentication_PollAuthSessionStatus_Response{}
-	err = core.pollAuthSessionStatus(beginAuthRes.ClientId, beginAuthRes.RequestId, &pollAuthRes)
+	err = core.pollAuthSessionStatus(ctx, beginAuthRes.GetClientId(), beginAuthRes.GetRequestId(), &pollAuthRes)
 	if err != nil {
 		return err
 	}
-	nonce, auth, err := core.finalizeLogin(pollAuthRes.RefreshToken)
+	cookieData := CookieData{}
+	nonce, auth, err := core.finalizeLogin(ctx, pollAuthRes.GetRefreshToken(), &cookieData)
 	if err != nil {
 		return err
 	}
-	time.Sleep(time.Millisecond * time.Duration(utils.RandRange(120, 300)))
+	if err := core.sleepJitter(ctx); err != nil {
+		return err
+	}
 
 	// Generate cookie and persistence
-	err = core.generateCookieData(nonce, auth)
+	err = core.generateCookieData(ctx, nonce, auth, &cookieData)
 	if err != nil {
 		return err
 	}
-	core.cookieData.RefreshTime = time.Now()
-	log.Info("Login succeeded.")
+	cookieData.RefreshTime = core.Clock().Now()
+	core.mu.Lock()
+	core.cookieData = cookieData
+	core.applyCookie()
+	core.mu.Unlock()
+	core.Logger().Info("logged in")
 	return nil
 }
 
 func (core *Core) RefreshCookieWithToken() error {
+	return core.RefreshCookieWithTokenContext(context.Background())
+}
+
+func (core *Core) RefreshCookieWithTokenContext(ctx context.Context) error {
+	core.loginMu.Lock()
+	defer core.loginMu.Unlock()
+	_, err := retry.Read(ctx, core, retry.OpRefreshCookie, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, core.refreshCookie(ctx)
+	})
+	if err != nil {
+		core.Logger().Warn("session refresh failed", logging.Err(err))
+		return err
+	}
+	core.Logger().Debug("session refreshed")
+	return nil
+}
+
+// core.loginMu must be held
+func (core *Core) refreshCookie(ctx context.Context) error {
 	reqBody := new(bytes.Buffer)
-	steamID := core.cookieData.SteamID
-	refreshToken := core.cookieData.RefreshToken
+	session := core.Session()
+	steamID := session.SteamID
+	refreshToken := session.RefreshToken
 	multipartWriter := multipart.NewWriter(reqBody)
-	multipartWriter.WriteField("steamid", steamID)
+	multipartWriter.WriteField("steamid", steamID.String())
 	multipartWriter.WriteField("refresh_token", refreshToken)
 	multipartWriter.Close()
-	reqUrl := fmt.Sprintf("%s/IAuthenticationService/GenerateAccessTokenForApp/v1", common.URI_STEAM_API)
-	httpReq, err := http.NewRequest("POST", reqUrl, reqBody)
+	reqUrl := fmt.Sprintf("%s/IAuthenticationService/GenerateAccessTokenForApp/v1", core.Endpoints().API)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", reqUrl, reqBody)
 	if err != nil {
 		return err
 	}
 	httpReq.Header.Set("Content-Type", multipartWriter.FormDataContentType())
-	res, err := core.httpClient.Do(httpReq)
+	res, err := core.HttpClient().Do(httpReq)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
+	if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("%w, status code = %d", ErrRefreshTokenRevoked, res.StatusCode)
+	}
 	if res.StatusCode != 200 {
 		return fmt.Errorf("fail to post settoken, status code = %d", res.StatusCode)
 	}
+	err = errcode.CheckResponse(res)
+	if err != nil {
+		// Only the results refusing the token itself need a login, a busy or rate limited steam is retried
+		if isRevokedResult(errcode.EResultOf(err)) {
+			return fmt.Errorf("%w, %w", ErrRefreshTokenRevoked, err)
+		}
+		return err
+	}
 	data, err := io.ReadAll(res.Body)
 	if err != nil {
 		return err
@@ -110,103 +186,62 @@ func (core *Core) RefreshCookieWithToken() error {
 	jsonStr := string(data)
 	accessToken := gjson.Get(jsonStr, "response").Get("access_token").String()
 	if accessToken == "" {
-		return fmt.Errorf("fail to parse access token: %s", jsonStr)
+		return errcode.New(httpReq.URL.Path, res.StatusCode, errcode.EResultBadResponse, "no access_token in the response")
 	}
-	steamLoginSecure := steamID + "%7C%7C" + accessToken
+	steamLoginSecure := steamID.String() + "%7C%7C" + accessToken
+	core.mu.Lock()
+	defer core.mu.Unlock()
 	core.cookieData.SteamLoginSecure = steamLoginSecure
-	core.cookieData.RefreshTime = time.Now()
-	core.ApplyCookie()
+	core.cookieData.RefreshTime = core.clock.Now()
+	core.applyCookie()
 	return nil
 }
 
-func (core *Core) getPasswordRSAPublicKey(rsaRes *pb.CAuthentication_GetPasswordRSAPublicKey_Response) error {
-	pbReq := pb.CAuthentication_GetPasswordRSAPublicKey_Request{
-		AccountName: core.loginInfo.UserName,
-	}
-	marshalData, err := proto.Marshal(&pbReq)
-	if err != nil {
-		return err
-	}
-	protoEncoded := base64.StdEncoding.EncodeToString(marshalData)
-
-	reqUrl := fmt.Sprintf("%s/IAuthenticationService/GetPasswordRSAPublicKey/v1?input_protobuf_encoded=%s", common.URI_STEAM_API, protoEncoded)
-	httpReq, err := http.NewRequest("GET", reqUrl, nil)
-	if err != nil {
-		return err
-	}
-
-	res, err := core.httpClient.Do(httpReq)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
-		return err
-	}
-	if res.StatusCode != 200 {
-		return fmt.Errorf("fail to request GetPasswordRSAPublicKey, status code = %d", res.StatusCode)
+// The results of GenerateAccessTokenForApp meaning the refresh token is no longer accepted
+func isRevokedResult(eresult errcode.EResult) bool {
+	switch eresult {
+	case errcode.EResultAccessDenied, errcode.EResultNotLoggedOn, errcode.EResultRevoked, errcode.EResultExpired,
+		errcode.EResultInvalidPassword, errcode.EResultInvalidSignature, errcode.EResultRequirePasswordReEntry:
+		return true
 	}
+	return false
+}
 
-	err = proto.Unmarshal(data, rsaRes)
-	if err != nil {
-		return err
+func (core *Core) getPasswordRSAPublicKey(ctx context.Context, rsaRes *pb.CAuthentication_GetPasswordRSAPublicKey_Response) error {
+	pbReq := pb.CAuthentication_GetPasswordRSAPublicKey_Request{
+		AccountName: proto.String(core.loginInfo.UserName),
 	}
-	return nil
+	return core.CallService(ctx, "IAuthenticationService", "GetPasswordRSAPublicKey", 1, &pbReq, rsaRes, WithoutAccessToken())
 }
 
-func (core *Core) beginAuthSessionViaCredentials(encryptedPassword string, rsaTimestamp uint64,
+func (core *Core) beginAuthSessionViaCredentials(ctx context.Context, encryptedPassword string, rsaTimestamp uint64,
 	beginAuthRes *pb.CAuthentication_BeginAuthSessionViaCredentials_Response) error {
 	pbReq := pb.CAuthentication_BeginAuthSessionViaCredentials_Request{
-		DeviceFriendlyName:  "Galaxy S22",
-		AccountName:         core.loginInfo.UserName,
-		EncryptedPassword:   encryptedPassword,
-		EncryptionTimestamp: rsaTimestamp,
-		RememberLogin:       true,
-		Persistence:         pb.ESessionPersistence_k_ESessionPersistence_Persistent,
-		WebsiteId:           "Mobile",
-		Language:            6,
+		DeviceFriendlyName:  proto.String("Galaxy S22"),
+		AccountName:         proto.String(core.loginInfo.UserName),
+		EncryptedPassword:   proto.String(encryptedPassword),
+		EncryptionTimestamp: proto.Uint64(rsaTimestamp),
+		RememberLogin:       proto.Bool(true),
+		Persistence:         pb.ESessionPersistence_k_ESessionPersistence_Persistent.Enum(),
+		WebsiteId:           proto.String("Mobile"),
+		Language:            proto.Uint32(6),
 		DeviceDetails: &pb.CAuthentication_DeviceDetails{
-			DeviceFriendlyName: "Galaxy S22",
-			PlatformType:       pb.EAuthTokenPlatformType_k_EAuthTokenPlatformType_MobileApp,
+			DeviceFriendlyName: proto.String("Galaxy S22"),
+			PlatformType:       pb.EAuthTokenPlatformType_k_EAuthTokenPlatformType_MobileApp.Enum(),
 		},
 	}
-
-	marshalData, err := proto.Marshal(&pbReq)
-	if err != nil {
-		return err
-	}
-	protoEncoded := base64.StdEncoding.EncodeToString(marshalData)
-	reqUrl := fmt.Sprintf("%s/IAuthenticationService/BeginAuthSessionViaCredentials/v1", common.URI_STEAM_API)
-
-	res, err := core.loginAuthPost(reqUrl, protoEncoded)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
-		return err
-	}
-	if res.StatusCode != 200 {
-		return fmt.Errorf("fail to request BeginAuthSessionViaCredentials, status code = %d", res.StatusCode)
-	}
-	err = errcode.CheckHeader(&res.Header)
-	if err != nil {
-		return err
-	}
-	err = proto.Unmarshal(data, beginAuthRes)
+	err := core.CallService(ctx, "IAuthenticationService", "BeginAuthSessionViaCredentials", 1, &pbReq, beginAuthRes, WithoutAccessToken())
 	if err != nil {
 		return err
 	}
-	if beginAuthRes.AllowedConfirmations == nil {
-		return fmt.Errorf("fail to login, AllowedConfirmations is nil")
+	if len(beginAuthRes.AllowedConfirmations) == 0 {
+		return fmt.Errorf("fail to login, AllowedConfirmations is empty")
 	}
 	return nil
 }
 
-func (core *Core) updateAuthSessionWithSteamGuardCode(clientID, steamID uint64, guardType pb.EAuthSessionGuardType,
-	updateAuthRes *pb.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) error {
+func (core *Core) updateAuthSessionWithSteamGuardCode(ctx context.Context, clientID, steamID uint64, guardType pb.EAuthSessionGuardType,
+	interactive bool, updateAuthRes *pb.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) error {
 	code := ""
 	if guardType == pb.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceConfirmation {
 		guardType = pb.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode
@@ -214,113 +249,76 @@ func (core *Core) updateAuthSessionWithSteamGuardCode(clientID, steamID uint64,
 	switch guardType {
 	case pb.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode:
 		if core.loginInfo.SharedSecret != "" {
-			code2fa, err := GenerateTwoFactorCode(core.loginInfo.SharedSecret, time.Now().Unix())
+			code2fa, err := GenerateTwoFactorCode(core.loginInfo.SharedSecret, core.Clock().Now().Unix())
 			if err != nil {
 				return err
 			}
 			code = code2fa
-			log.Infof("2FA(Two-Factor Authentication) code: %s", code)
+			core.Logger().Debug("generated 2FA code from the shared secret")
 		} else {
-			log.Info("Please input 2FA(Two-Factor Authentication) code:")
+			if !interactive {
+				return fmt.Errorf("fail to generate 2FA code, empty shared secret")
+			}
+			fmt.Fprint(os.Stderr, "Please input 2FA(Two-Factor Authentication) code: ")
 			fmt.Scanf("%s", &code)
 			code = strings.ToUpper(code)
-			log.Infof("The input 2FA code is: %s", code)
 		}
 	case pb.EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode, pb.EAuthSessionGuardType_k_EAuthSessionGuardType_EmailConfirmation:
 		guardType = pb.EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode
-		log.Info("Please input E-mail verification code:")
+		if !interactive {
+			return fmt.Errorf("fail to login, E-mail verification code is required")
+		}
+		fmt.Fprint(os.Stderr, "Please input E-mail verification code: ")
 		fmt.Scanf("%s", &code)
 		code = strings.ToUpper(code)
-		log.Infof("The input E-mail verification code is: %s", code)
 	default:
 		return fmt.Errorf("fail, guardType = %d", guardType)
 	}
 	pbReq := pb.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request{
-		ClientId: clientID,
-		SteamId:  steamID,
-		Code:     code,
-		CodeType: guardType,
+		ClientId: proto.Uint64(clientID),
+		Steamid:  proto.Uint64(steamID),
+		Code:     proto.String(code),
+		CodeType: guardType.Enum(),
 	}
 
-	marshalData, err := proto.Marshal(&pbReq)
-	if err != nil {
-		return err
-	}
-	protoEncoded := base64.StdEncoding.EncodeToString(marshalData)
-	reqUrl := fmt.Sprintf("%s/IAuthenticationService/UpdateAuthSessionWithSteamGuardCode/v1", common.URI_STEAM_API)
-	res, err := core.loginAuthPost(reqUrl, protoEncoded)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
-		return err
-	}
-	if res.StatusCode != 200 {
-		return fmt.Errorf("fail to request PollAuthSessionStatus, status code = %d", res.StatusCode)
-	}
-	return proto.Unmarshal(data, updateAuthRes)
+	return core.CallService(ctx, "IAuthenticationService", "UpdateAuthSessionWithSteamGuardCode", 1, &pbReq, updateAuthRes, WithoutAccessToken())
 }
 
-func (core *Core) pollAuthSessionStatus(clientID uint64, requestID []byte,
+func (core *Core) pollAuthSessionStatus(ctx context.Context, clientID uint64, requestID []byte,
 	pollAuthRes *pb.CAuthentication_PollAuthSessionStatus_Response) error {
 	pbReq := pb.CAuthentication_PollAuthSessionStatus_Request{
-		ClientId:  clientID,
+		ClientId:  proto.Uint64(clientID),
 		RequestId: requestID,
 	}
-
-	marshalData, err := proto.Marshal(&pbReq)
-	if err != nil {
-		return err
-	}
-	protoEncoded := base64.StdEncoding.EncodeToString(marshalData)
-	reqUrl := fmt.Sprintf("%s/IAuthenticationService/PollAuthSessionStatus/v1", common.URI_STEAM_API)
-	res, err := core.loginAuthPost(reqUrl, protoEncoded)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
-		return err
-	}
-	if res.StatusCode != 200 {
-		return fmt.Errorf("fail to request PollAuthSessionStatus, status code = %d", res.StatusCode)
-	}
-	err = errcode.CheckHeader(&res.Header)
-	if err != nil {
-		return err
-	}
-	return proto.Unmarshal(data, pollAuthRes)
+	return core.CallService(ctx, "IAuthenticationService", "PollAuthSessionStatus", 1, &pbReq, pollAuthRes, WithoutAccessToken())
 }
 
-func (core *Core) finalizeLogin(refreshToken string) (string, string, error) {
+func (core *Core) finalizeLogin(ctx context.Context, refreshToken string, cookieData *CookieData) (string, string, error) {
 	// Generate sessiond ID
 	randomBytes := make([]byte, 12)
-	if _, err := rand.Read(randomBytes); err != nil {
+	if _, err := core.Rand().Read(randomBytes); err != nil {
 		return "", "", err
 	}
 
 	sessionID := make([]byte, hex.EncodedLen(len(randomBytes)))
 	hex.Encode(sessionID, randomBytes)
-	core.cookieData.SessionID = string(sessionID)
-	core.cookieData.RefreshToken = refreshToken
+	cookieData.SessionID = string(sessionID)
+	cookieData.RefreshToken = refreshToken
 	// Finalizelogin request
 	reqBody := new(bytes.Buffer)
 	multipartWriter := multipart.NewWriter(reqBody)
 	multipartWriter.WriteField("nonce", refreshToken)
-	multipartWriter.WriteField("sessionid", core.cookieData.SessionID)
-	multipartWriter.WriteField("redir", fmt.Sprintf("%s/login/home/?goto=", common.URI_STEAM_COMMUNITY))
+	multipartWriter.WriteField("sessionid", cookieData.SessionID)
+	multipartWriter.WriteField("redir", fmt.Sprintf("%s/login/home/?goto=", core.Endpoints().Community))
 	multipartWriter.Close()
 
-	reqUrl := "https://login.steampowered.com/jwt/finalizelogin"
-	httpReq, err := http.NewRequest("POST", reqUrl, reqBody)
+	reqUrl := fmt.Sprintf("%s/jwt/finalizelogin", core.Endpoints().Login)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", reqUrl, reqBody)
 	if err != nil {
 		return "", "", err
 	}
 	httpReq.Header.Set("Content-Type", multipartWriter.FormDataContentType())
-	res, err := core.httpClient.Do(httpReq)
+	res, err := core.HttpClient().Do(httpReq)
 	if err != nil {
 		return "", "", err
 	}
@@ -341,13 +339,13 @@ func (core *Core) finalizeLogin(refreshToken string) (string, string, error) {
 	// }
 
 	jsonData := string(data)
-	steamID := gjson.Get(jsonData, "steamID").String()
-	if steamID == "" {
+	steamID, err := steamid.Parse(gjson.Get(jsonData, "steamID").String())
+	if err != nil {
 		return "", "", fmt.Errorf("fail to get steam Id, response data: %s", jsonData)
 	}
-	core.cookieData.SteamID = steamID
+	cookieData.SteamID = steamID
 	for _, tokenData := range gjson.Get(jsonData, "transfer_info").Array() {
-		if tokenData.Get("url").String() == kURI_STEAM_SETTOKEN {
+		if tokenData.Get("url").String() == core.setTokenUrl() {
 			params := tokenData.Get("params")
 			nonce := params.Get("nonce").String()
 			auth := params.Get("auth").String()
@@ -357,26 +355,26 @@ func (core *Core) finalizeLogin(refreshToken string) (string, string, error) {
 	return "", "", fmt.Errorf("fail to get nonce and auth")
 }
 
-func (core *Core) generateCookieData(nonce, auth string) error {
+func (core *Core) generateCookieData(ctx context.Context, nonce, auth string, cookieData *CookieData) error {
 	// Get loginSecure
-	steamID := core.cookieData.SteamID
+	steamID := cookieData.SteamID
 	reqBody := new(bytes.Buffer)
 	multipartWriter := multipart.NewWriter(reqBody)
 	multipartWriter.WriteField("nonce", nonce)
 	multipartWriter.WriteField("auth", auth)
-	multipartWriter.WriteField("steamID", steamID)
+	multipartWriter.WriteField("steamID", steamID.String())
 	multipartWriter.Close()
 
-	httpReq, err := http.NewRequest("POST", kURI_STEAM_SETTOKEN, reqBody)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", core.setTokenUrl(), reqBody)
 	if err != nil {
 		return err
 	}
 	httpReq.AddCookie(&http.Cookie{
 		Name:  "sessionid",
-		Value: core.cookieData.SessionID,
+		Value: cookieData.SessionID,
 	})
 	httpReq.Header.Set("Content-Type", multipartWriter.FormDataContentType())
-	res, err := core.httpClient.Do(httpReq)
+	res, err := core.HttpClient().Do(httpReq)
 	if err != nil {
 		return err
 	}
@@ -387,13 +385,12 @@ func (core *Core) generateCookieData(nonce, auth string) error {
 
 	for _, cookie := range res.Cookies() {
 		if cookie.Name == "steamLoginSecure" {
-			core.cookieData.MaxAge = cookie.MaxAge
-			core.cookieData.Expires = cookie.Expires.Unix()
-			core.cookieData.SteamLoginSecure = cookie.Value
+			cookieData.MaxAge = cookie.MaxAge
+			cookieData.Expires = cookie.Expires.Unix()
+			cookieData.SteamLoginSecure = cookie.Value
 			break
 		}
 	}
-	core.ApplyCookie()
 	return nil
 }
 
@@ -420,17 +417,11 @@ func (core *Core) encryptPassword(publicKeyMod, publicKeyExp string) (string, er
 	return encodedPassword, nil
 }
 
-func (core *Core) loginAuthPost(reqUrl, postData string) (*http.Response, error) {
-	reqBody := new(bytes.Buffer)
-	multipartWriter := multipart.NewWriter(reqBody)
-	multipartWriter.WriteField("input_protobuf_encoded", postData)
-	multipartWriter.Close()
-
-	httpReq, err := http.NewRequest("POST", reqUrl, reqBody)
-	if err != nil {
-		return nil, err
-	}
+// Random pause between the login steps, interrupted while ctx is done
+func (core *Core) sleepJitter(ctx context.Context) error {
+	return utils.SleepContext(ctx, core.Clock(), time.Millisecond*time.Duration(utils.RandRangeWith(core.Rand(), 120, 300)))
+}
 
-	httpReq.Header.Set("Content-Type", multipartWriter.FormDataContentType())
-	return core.httpClient.Do(httpReq)
+func (core *Core) setTokenUrl() string {
+	return core.Endpoints().Community + "/login/settoken"
 }
@@ -0,0 +1,107 @@
+package auth_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/retry"
+	"github.com/umichan0621/steam/pkg/steamtest"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+func TestRefreshCookieWithToken(t *testing.T) {
+	server, core, steamID := steamtest.NewLoggedIn(t, steamtest.Account{Wallet: 10000})
+	policy := retry.DefaultPolicy()
+	policy.MaxAttempts = 1
+	core.SetRetryPolicy(policy)
+	const path = "/IAuthenticationService/GenerateAccessTokenForApp"
+
+	if err := core.RefreshCookieWithToken(); err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		failure steamtest.Failure
+		want    error
+	}{
+		{"busy", steamtest.Failure{EResult: int(errcode.EResultBusy)}, errcode.ErrBusy},
+		{"rate limited", steamtest.Failure{EResult: int(errcode.EResultRateLimitExceeded)}, errcode.ErrRateLimitExceeded},
+		{"service unavailable", steamtest.Failure{Status: 503}, nil},
+		{"truncated body", steamtest.Failure{MalformedRaw: true}, errcode.EResultBadResponse},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.Fail(path, tt.failure)
+			defer server.ClearFailures()
+			err := core.RefreshCookieWithToken()
+			if err == nil {
+				t.Fatal("refresh succeeded")
+			}
+			if errors.Is(err, auth.ErrRefreshTokenRevoked) {
+				t.Fatalf("transient failure reported as revoked: %v", err)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	server.RevokeTokens(steamID)
+	if err := core.RefreshCookieWithToken(); !errors.Is(err, auth.ErrRefreshTokenRevoked) {
+		t.Fatalf("err = %v, want ErrRefreshTokenRevoked", err)
+	}
+}
+
+func TestSchedulerUsesCoreClock(t *testing.T) {
+	_, core, _ := steamtest.NewLoggedIn(t, steamtest.Account{Wallet: 10000})
+	clock := core.Clock().(*utils.ManualClock)
+	clock.SetBlocking(true)
+	start := clock.Now()
+	events := make(chan auth.Event, 4)
+	scheduler := auth.Scheduler{}
+	scheduler.Init(core)
+	scheduler.SetParam(30*time.Minute, 0, 0, 0)
+	scheduler.SetEventHandler(func(event auth.Event) { events <- event })
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	// Wait for the scheduler to sleep on the clock after its first check
+	waitSleeping := func() {
+		t.Helper()
+		deadline := time.Now().Add(10 * time.Second)
+		for clock.Waiters() == 0 {
+			if time.Now().After(deadline) {
+				t.Fatal("the scheduler never slept on the clock")
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	waitSleeping()
+	if now := clock.Now(); !now.Equal(start) {
+		t.Fatalf("clock moved to %s without Advance", now)
+	}
+
+	// The access token lives 24 hours, 1 hour is left at the check after 23 hours
+	clock.Advance(23 * time.Hour)
+	waitSleeping()
+	select {
+	case event := <-events:
+		t.Fatalf("event %s before the refresh margin", event.Type)
+	default:
+	}
+	// The param of the running scheduler can be changed, 30 minutes left is within the new margin
+	scheduler.SetParam(0, 31*time.Minute, 0, 0)
+	clock.Advance(30 * time.Minute)
+	select {
+	case event := <-events:
+		if event.Type != auth.EventRefreshed || !event.Time.Equal(start.Add(23*time.Hour+30*time.Minute)) {
+			t.Fatalf("event = %s at %s, %v", event.Type, event.Time, event.Err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("no check ran")
+	}
+}
@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+type EventType int
+
+const (
+	EventRefreshed     EventType = iota // access token refreshed with the refresh token
+	EventRefreshFailed                  // access token refresh failed, will retry on next check
+	EventRelogin                        // full login succeeded, a new refresh token is issued
+	EventReloginFailed                  // full login failed, will retry after the retry interval
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventRefreshed:
+		return "Refreshed"
+	case EventRefreshFailed:
+		return "RefreshFailed"
+	case EventRelogin:
+		return "Relogin"
+	case EventReloginFailed:
+		return "ReloginFailed"
+	}
+	return fmt.Sprintf("EventType(%d)", int(t))
+}
+
+type Event struct {
+	Type EventType
+	Time time.Time
+	Err  error // nil on success
+}
+
+// Keep the session of a Core alive in background:
+// refresh the access token before it expires and re-login with the shared secret
+// while the refresh token is near expiry or revoked
+type Scheduler struct {
+	core          *Core
+	checkInterval time.Duration
+	refreshMargin time.Duration // refresh the access token while it expires within the margin
+	reloginMargin time.Duration // re-login while the refresh token expires within the margin
+	retryInterval time.Duration // min interval between two failed re-login attempts
+	handler       func(Event)
+	lastFailure   time.Time
+	mu            sync.Mutex // guard lastFailure, serialize checks
+	paramMu       sync.Mutex // guard checkInterval, the margins, retryInterval and handler
+	runMu         sync.Mutex // guard cancel
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+func (s *Scheduler) Init(core *Core) {
+	s.core = core
+	s.checkInterval = time.Minute
+	s.refreshMargin = 30 * time.Minute
+	s.reloginMargin = 7 * 24 * time.Hour
+	s.retryInterval = 10 * time.Minute
+	s.handler = nil
+}
+
+// Set only while the duration > 0, safe to call while the scheduler runs
+func (s *Scheduler) SetParam(checkInterval, refreshMargin, reloginMargin, retryInterval time.Duration) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+	if checkInterval > 0 {
+		s.checkInterval = checkInterval
+	}
+	if refreshMargin > 0 {
+		s.refreshMargin = refreshMargin
+	}
+	if reloginMargin > 0 {
+		s.reloginMargin = reloginMargin
+	}
+	if retryInterval > 0 {
+		s.retryInterval = retryInterval
+	}
+}
+
+// The handler is called from the scheduler goroutine, e.g. to persist CookieString after a refresh
+func (s *Scheduler) SetEventHandler(handler func(Event)) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+	s.handler = handler
+}
+
+func (s *Scheduler) params() (checkInterval, refreshMargin, reloginMargin, retryInterval time.Duration) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+	return s.checkInterval, s.refreshMargin, s.reloginMargin, s.retryInterval
+}
+
+func (s *Scheduler) Start() {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+	if s.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go s.run(ctx)
+}
+
+func (s *Scheduler) Stop() {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+	if s.cancel == nil {
+		return
+	}
+	// Cancelling also aborts a refresh or re-login in flight
+	s.cancel()
+	s.wg.Wait()
+	s.cancel = nil
+}
+
+// Run a single check immediately, it is called periodically after Start
+func (s *Scheduler) Check() {
+	s.CheckContext(context.Background())
+}
+
+func (s *Scheduler) CheckContext(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, refreshMargin, reloginMargin, _ := s.params()
+	now := s.core.Clock().Now()
+	refreshExpiry := s.core.RefreshTokenExpiry()
+	if refreshExpiry.IsZero() || refreshExpiry.Sub(now) < reloginMargin {
+		s.relogin(ctx, now)
+		return
+	}
+
+	accessExpiry := s.core.AccessTokenExpiry()
+	if !accessExpiry.IsZero() && accessExpiry.Sub(now) >= refreshMargin {
+		return
+	}
+	err := s.core.RefreshCookieWithTokenContext(ctx)
+	if err == nil {
+		s.emit(EventRefreshed, nil)
+		return
+	}
+	s.emit(EventRefreshFailed, err)
+	if errors.Is(err, ErrRefreshTokenRevoked) {
+		s.core.Logger().Info("refresh token revoked, logging in again")
+		s.relogin(ctx, now)
+	}
+}
+
+// The checks are paced by the clock of the Core, a blocking utils.ManualClock steps them
+func (s *Scheduler) run(ctx context.Context) {
+	defer s.wg.Done()
+	for {
+		s.CheckContext(ctx)
+		checkInterval, _, _, _ := s.params()
+		if utils.SleepContext(ctx, s.core.Clock(), checkInterval) != nil {
+			return
+		}
+	}
+}
+
+func (s *Scheduler) relogin(ctx context.Context, now time.Time) {
+	_, _, _, retryInterval := s.params()
+	if !s.lastFailure.IsZero() && now.Sub(s.lastFailure) < retryInterval {
+		return
+	}
+	if s.core.loginInfo.SharedSecret == "" {
+		s.lastFailure = now
+		s.emit(EventReloginFailed, fmt.Errorf("fail to re-login, empty shared secret"))
+		return
+	}
+	err := s.core.login(ctx, false)
+	if err != nil {
+		s.lastFailure = now
+		s.emit(EventReloginFailed, err)
+		return
+	}
+	s.lastFailure = time.Time{}
+	s.emit(EventRelogin, nil)
+}
+
+func (s *Scheduler) emit(eventType EventType, err error) {
+	s.paramMu.Lock()
+	handler := s.handler
+	s.paramMu.Unlock()
+	if handler == nil {
+		return
+	}
+	handler(Event{Type: eventType, Time: s.core.Clock().Now(), Err: err})
+}
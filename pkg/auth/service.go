@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/retry"
+	"google.golang.org/protobuf/proto"
+)
+
+type serviceCall struct {
+	method    string
+	anonymous bool
+}
+
+type ServiceOption func(call *serviceCall)
+
+// Override the http method, by default GET for the methods named Get* and POST for the others
+func WithHTTPMethod(method string) ServiceOption {
+	return func(call *serviceCall) { call.method = method }
+}
+
+// Send neither the access token nor the web api key
+func WithoutAccessToken() ServiceOption {
+	return func(call *serviceCall) { call.anonymous = true }
+}
+
+// Call a protobuf method of the web api, e.g. CallService(ctx, "IPlayerService", "GetOwnedGames", 1, req, resp).
+// The credential is chosen by AuthorizeAPI. A GET is retried like a read, a POST like
+// a write never sent twice. The X-Eresult header and the http status are turned into an *errcode.Error
+func (core *Core) CallService(ctx context.Context, iface, method string, version int, req, resp proto.Message, opts ...ServiceOption) error {
+	call := serviceCall{method: http.MethodPost}
+	if strings.HasPrefix(method, "Get") {
+		call.method = http.MethodGet
+	}
+	for _, opt := range opts {
+		opt(&call)
+	}
+	op := retry.Operation{Name: iface + "." + method, Kind: retry.KindWrite}
+	if call.method == http.MethodGet {
+		op.Kind = retry.KindRead
+	}
+	_, err := retry.Write(ctx, core, op, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, core.callService(ctx, &call, iface, method, version, req, resp)
+	}, nil)
+	return err
+}
+
+func (core *Core) callService(ctx context.Context, call *serviceCall, iface, method string, version int, req, resp proto.Message) error {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	encoded := base64.StdEncoding.EncodeToString(data)
+	webReq := &web.Request{
+		Method: call.method,
+		Path:   fmt.Sprintf("%s/%s/%s/v%d", core.Endpoints().API, iface, method, version),
+		Query:  url.Values{},
+	}
+	if !call.anonymous {
+		if err := core.AuthorizeAPI(ctx, webReq.Query); err != nil {
+			return err
+		}
+	}
+	if call.method == http.MethodGet {
+		webReq.Query.Set("input_protobuf_encoded", encoded)
+	} else {
+		webReq.Multipart = url.Values{"input_protobuf_encoded": {encoded}}
+	}
+	res, err := web.Do(ctx, core, webReq)
+	if err != nil {
+		return err
+	}
+	if err := res.CheckStatus(); err != nil {
+		return err
+	}
+	if err := proto.Unmarshal(res.Body, resp); err != nil {
+		return &errcode.Error{EResult: errcode.EResultBadResponse, Endpoint: res.Endpoint, Status: res.Status, Err: err}
+	}
+	return nil
+}
+
+// Call a JSON method of the web api and decode the body into v, e.g.
+// CallAPI(WithAPIAuth(ctx, APIAuthKey), "ISteamUser", "GetPlayerSummaries", 2, url.Values{"steamids": {id}}, &res).
+// GET by default, retried like a read; a POST set by WithHTTPMethod is never sent twice
+func (core *Core) CallAPI(ctx context.Context, iface, method string, version int, params url.Values, v any, opts ...ServiceOption) error {
+	call := serviceCall{method: http.MethodGet}
+	for _, opt := range opts {
+		opt(&call)
+	}
+	op := retry.Operation{Name: iface + "." + method, Kind: retry.KindWrite}
+	if call.method == http.MethodGet {
+		op.Kind = retry.KindRead
+	}
+	_, err := retry.Write(ctx, core, op, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, core.callAPI(ctx, &call, iface, method, version, params, v)
+	}, nil)
+	return err
+}
+
+func (core *Core) callAPI(ctx context.Context, call *serviceCall, iface, method string, version int, params url.Values, v any) error {
+	webReq := &web.Request{
+		Method: call.method,
+		Path:   fmt.Sprintf("%s/%s/%s/v%d/", core.Endpoints().API, iface, method, version),
+		Query:  url.Values{},
+	}
+	if !call.anonymous {
+		if err := core.AuthorizeAPI(ctx, webReq.Query); err != nil {
+			return err
+		}
+	}
+	if call.method == http.MethodGet {
+		for name, values := range params {
+			webReq.Query[name] = values
+		}
+	} else {
+		webReq.Form = params
+	}
+	_, err := web.JSON(ctx, core, webReq, v)
+	return err
+}
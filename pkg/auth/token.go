@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// Steam tokens are JWTs, the expiry is read from the "exp" claim of the payload
+func tokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("fail to parse token, invalid format")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, err
+	}
+	exp := gjson.GetBytes(payload, "exp")
+	if !exp.Exists() {
+		return time.Time{}, fmt.Errorf("fail to parse token, exp not found")
+	}
+	return time.Unix(exp.Int(), 0), nil
+}
+
+// Zero time if the refresh token is missing or can not be parsed
+func (core *Core) RefreshTokenExpiry() time.Time {
+	tm, err := tokenExpiry(core.Session().RefreshToken)
+	if err != nil {
+		return time.Time{}
+	}
+	return tm
+}
+
+// Zero time if the access token is missing or can not be parsed
+func (core *Core) AccessTokenExpiry() time.Time {
+	tm, err := tokenExpiry(core.AccessToken())
+	if err != nil {
+		return time.Time{}
+	}
+	return tm
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"net/url"
+
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/steamid"
+)
+
+// Steam ID of a vanity name or an /id/ profile url, other forms are parsed by steamid.Parse.
+// ISteamUser takes the web api key only, see SetAPIKey
+func (core *Core) ResolveVanityURL(ctx context.Context, vanity string) (steamid.SteamID, error) {
+	if name, ok := steamid.VanityName(vanity); ok {
+		vanity = name
+	} else if id, err := steamid.Parse(vanity); err == nil {
+		return id, nil
+	}
+	res := struct {
+		Response struct {
+			SteamID steamid.SteamID `json:"steamid"`
+			Success errcode.EResult `json:"success"`
+			Message string          `json:"message"`
+		} `json:"response"`
+	}{}
+	err := core.CallAPI(WithAPIAuth(ctx, APIAuthKey), "ISteamUser", "ResolveVanityURL", 1, url.Values{"vanityurl": {vanity}}, &res)
+	if err != nil {
+		return 0, err
+	}
+	if err := errcode.CheckResult("/ISteamUser/ResolveVanityURL/v1/", 200, res.Response.Success, res.Response.Message); err != nil {
+		return 0, err
+	}
+	return res.Response.SteamID, nil
+}
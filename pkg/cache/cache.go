@@ -0,0 +1,251 @@
+// Package cache keeps the public market data for a while, so jobs asking for the same
+// name ID, price overview or histogram within minutes send a single request.
+// Concurrent misses of a key share one load, and a Store implementing Locker
+// extends that to the processes sharing the store.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+type Endpoint string
+
+const (
+	EndpointItemNameID     Endpoint = "itemnameid"
+	EndpointOrderHistogram Endpoint = "itemordershistogram"
+	EndpointPriceOverview  Endpoint = "priceoverview"
+	EndpointPriceHistory   Endpoint = "pricehistory"
+)
+
+// TTL of the entries that never expire
+const Forever time.Duration = -1
+
+// A name ID never changes, the prices move within minutes
+func DefaultTTLs() map[Endpoint]time.Duration {
+	return map[Endpoint]time.Duration{
+		EndpointItemNameID:     Forever,
+		EndpointOrderHistogram: 2 * time.Minute,
+		EndpointPriceOverview:  5 * time.Minute,
+		EndpointPriceHistory:   15 * time.Minute,
+	}
+}
+
+type Stats struct {
+	Hits   uint64
+	Misses uint64 // loads sent to steam
+	Shared uint64 // misses served by the load of another caller
+	Errors uint64 // store failures, the value is loaded or returned anyway
+}
+
+// Cache is safe for concurrent use, install it with auth.Core.SetCache
+type Cache struct {
+	mu      sync.Mutex
+	store   Store
+	clock   utils.Clock
+	ttls    map[Endpoint]time.Duration
+	flights map[string]*flight
+	stats   map[Endpoint]*Stats
+}
+
+type flight struct {
+	done  chan struct{}
+	value []byte
+	err   error
+}
+
+// nil store means NewMemory()
+func New(store Store) *Cache {
+	if store == nil {
+		store = NewMemory()
+	}
+	return &Cache{
+		store:   store,
+		clock:   utils.SystemClock{},
+		ttls:    DefaultTTLs(),
+		flights: map[string]*flight{},
+		stats:   map[Endpoint]*Stats{},
+	}
+}
+
+// ttl = 0 disables the cache of the endpoint, Forever keeps the entries until they are invalidated
+func (c *Cache) SetTTL(endpoint Endpoint, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttls[endpoint] = ttl
+}
+
+// Share it with the Core, e.g. a utils.ManualClock in tests; a File store gets it as well
+func (c *Cache) SetClock(clock utils.Clock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.clock = clock
+	if file, ok := c.store.(*File); ok {
+		file.SetClock(clock)
+	}
+}
+
+func (c *Cache) Invalidate(endpoint Endpoint, key string) error {
+	return c.store.Delete(storeKey(endpoint, key))
+}
+
+func (c *Cache) Stats() map[Endpoint]Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	res := map[Endpoint]Stats{}
+	for endpoint, stats := range c.stats {
+		res[endpoint] = *stats
+	}
+	return res
+}
+
+// Return the cached value of the key, or load and cache it. The errors are never cached.
+// A nil cache or an endpoint without TTL always loads
+func Fetch[T any](ctx context.Context, c *Cache, endpoint Endpoint, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+	if c == nil {
+		return load(ctx)
+	}
+	ttl, clock := c.config(endpoint)
+	if ttl == 0 {
+		return load(ctx)
+	}
+	raw, err := c.fetch(ctx, endpoint, storeKey(endpoint, key), ttl, clock, func(ctx context.Context) ([]byte, error) {
+		value, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(value)
+	})
+	if err != nil {
+		return zero, err
+	}
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		// An entry written by an older version, drop it and load again
+		c.count(endpoint, func(stats *Stats) { stats.Errors++ })
+		c.store.Delete(storeKey(endpoint, key))
+		return load(ctx)
+	}
+	return value, nil
+}
+
+func (c *Cache) fetch(ctx context.Context, endpoint Endpoint, key string, ttl time.Duration, clock utils.Clock, load func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	for {
+		if value, ok := c.lookup(endpoint, key, clock); ok {
+			c.count(endpoint, func(stats *Stats) { stats.Hits++ })
+			return value, nil
+		}
+
+		c.mu.Lock()
+		f, shared := c.flights[key]
+		if !shared {
+			f = &flight{done: make(chan struct{})}
+			c.flights[key] = f
+		}
+		c.mu.Unlock()
+
+		if shared {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-f.done:
+			}
+			// The leader gave up on its own context, ours is still alive so try again
+			if f.err != nil && isContextErr(f.err) && ctx.Err() == nil {
+				continue
+			}
+			if f.err == nil {
+				c.count(endpoint, func(stats *Stats) { stats.Shared++ })
+			}
+			return f.value, f.err
+		}
+
+		f.value, f.err = c.lead(ctx, endpoint, key, ttl, clock, load)
+		c.mu.Lock()
+		delete(c.flights, key)
+		c.mu.Unlock()
+		close(f.done)
+		return f.value, f.err
+	}
+}
+
+// Load the key once for every caller of the process, and of the other processes with a Locker
+func (c *Cache) lead(ctx context.Context, endpoint Endpoint, key string, ttl time.Duration, clock utils.Clock, load func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	if locker, ok := c.store.(Locker); ok {
+		unlock, err := locker.Lock(ctx, key)
+		if err != nil {
+			if isContextErr(err) {
+				return nil, err
+			}
+			c.count(endpoint, func(stats *Stats) { stats.Errors++ })
+		} else {
+			defer unlock()
+			// Another process may have loaded it while we were waiting for the lock
+			if value, ok := c.lookup(endpoint, key, clock); ok {
+				c.count(endpoint, func(stats *Stats) { stats.Shared++ })
+				return value, nil
+			}
+		}
+	}
+
+	c.count(endpoint, func(stats *Stats) { stats.Misses++ })
+	value, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entry := Entry{Value: value}
+	if ttl > 0 {
+		entry.Expires = clock.Now().Add(ttl)
+	}
+	if err := c.store.Set(key, entry); err != nil {
+		c.count(endpoint, func(stats *Stats) { stats.Errors++ })
+	}
+	return value, nil
+}
+
+func (c *Cache) lookup(endpoint Endpoint, key string, clock utils.Clock) ([]byte, bool) {
+	entry, ok, err := c.store.Get(key)
+	if err != nil {
+		c.count(endpoint, func(stats *Stats) { stats.Errors++ })
+		return nil, false
+	}
+	if !ok {
+		return nil, false
+	}
+	if !entry.Expires.IsZero() && !clock.Now().Before(entry.Expires) {
+		c.store.Delete(key)
+		return nil, false
+	}
+	return entry.Value, true
+}
+
+func (c *Cache) config(endpoint Endpoint) (time.Duration, utils.Clock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ttls[endpoint], c.clock
+}
+
+func (c *Cache) count(endpoint Endpoint, update func(stats *Stats)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats, ok := c.stats[endpoint]
+	if !ok {
+		stats = &Stats{}
+		c.stats[endpoint] = stats
+	}
+	update(stats)
+}
+
+func storeKey(endpoint Endpoint, key string) string {
+	return string(endpoint) + ":" + key
+}
+
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
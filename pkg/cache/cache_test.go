@@ -0,0 +1,220 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/cache"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+var start = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+// A load returning the number of calls so far
+func counter() (*atomic.Int64, func(ctx context.Context) (int64, error)) {
+	calls := &atomic.Int64{}
+	return calls, func(ctx context.Context) (int64, error) { return calls.Add(1), nil }
+}
+
+func TestFetchTTL(t *testing.T) {
+	c := cache.New(nil)
+	clock := utils.NewManualClock(start)
+	c.SetClock(clock)
+	calls, load := counter()
+	ctx := context.Background()
+	fetch := func() int64 {
+		t.Helper()
+		value, err := cache.Fetch(ctx, c, cache.EndpointPriceOverview, "730/Case", load)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+
+	if fetch() != 1 || fetch() != 1 {
+		t.Fatal("the second fetch within the TTL loaded again")
+	}
+	clock.Advance(5*time.Minute - time.Second)
+	if fetch() != 1 {
+		t.Fatal("entry expired before its TTL")
+	}
+	clock.Advance(time.Second)
+	if fetch() != 2 {
+		t.Fatal("entry served after its TTL")
+	}
+	stats := c.Stats()[cache.EndpointPriceOverview]
+	if stats.Hits != 2 || stats.Misses != 2 || calls.Load() != 2 {
+		t.Fatalf("stats = %+v, %d loads", stats, calls.Load())
+	}
+
+	// Forever never expires, 0 disables the cache, Invalidate drops the entry
+	c.SetTTL(cache.EndpointItemNameID, cache.Forever)
+	cache.Fetch(ctx, c, cache.EndpointItemNameID, "Case", load)
+	clock.Advance(365 * 24 * time.Hour)
+	if value, _ := cache.Fetch(ctx, c, cache.EndpointItemNameID, "Case", load); value != 3 {
+		t.Fatalf("Forever entry reloaded, value = %d", value)
+	}
+	if err := c.Invalidate(cache.EndpointItemNameID, "Case"); err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := cache.Fetch(ctx, c, cache.EndpointItemNameID, "Case", load); value != 4 {
+		t.Fatalf("invalidated entry served, value = %d", value)
+	}
+	c.SetTTL(cache.EndpointPriceOverview, 0)
+	if fetch() != 5 || fetch() != 6 {
+		t.Fatal("an endpoint without TTL was cached")
+	}
+}
+
+func TestFetchErrorNotCached(t *testing.T) {
+	c := cache.New(nil)
+	failure := errors.New("fail to load")
+	calls := 0
+	load := func(ctx context.Context) (string, error) {
+		calls++
+		if calls == 1 {
+			return "", failure
+		}
+		return "ok", nil
+	}
+	if _, err := cache.Fetch(context.Background(), c, cache.EndpointPriceOverview, "Case", load); !errors.Is(err, failure) {
+		t.Fatalf("err = %v", err)
+	}
+	if value, err := cache.Fetch(context.Background(), c, cache.EndpointPriceOverview, "Case", load); err != nil || value != "ok" {
+		t.Fatalf("value = %q, %v after a failed load", value, err)
+	}
+}
+
+func TestFetchSharesLoad(t *testing.T) {
+	const callers = 8
+	c := cache.New(nil)
+	started := sync.WaitGroup{}
+	started.Add(callers)
+	calls := atomic.Int64{}
+	// The load holds until every caller asked for the key, they wait for it instead of loading
+	load := func(ctx context.Context) (int64, error) {
+		started.Wait()
+		time.Sleep(10 * time.Millisecond)
+		return calls.Add(1), nil
+	}
+	values := make(chan int64, callers)
+	wg := sync.WaitGroup{}
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			started.Done()
+			value, err := cache.Fetch(context.Background(), c, cache.EndpointOrderHistogram, "Case", load)
+			if err != nil {
+				t.Error(err)
+			}
+			values <- value
+		}()
+	}
+	wg.Wait()
+	close(values)
+	for value := range values {
+		if value != 1 {
+			t.Fatalf("value = %d, the load ran more than once", value)
+		}
+	}
+	stats := c.Stats()[cache.EndpointOrderHistogram]
+	if calls.Load() != 1 || stats.Misses != 1 || stats.Shared+stats.Hits != callers-1 {
+		t.Fatalf("stats = %+v, %d loads", stats, calls.Load())
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	clock := utils.NewManualClock(start)
+	newCache := func() *cache.Cache {
+		store, err := cache.NewFile(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c := cache.New(store)
+		c.SetClock(clock)
+		return c
+	}
+	// Two caches on the directory stand for two processes
+	first, second := newCache(), newCache()
+	calls, load := counter()
+	ctx := context.Background()
+	if value, err := cache.Fetch(ctx, first, cache.EndpointPriceHistory, "730/Case", load); err != nil || value != 1 {
+		t.Fatalf("value = %d, %v", value, err)
+	}
+	if value, err := cache.Fetch(ctx, second, cache.EndpointPriceHistory, "730/Case", load); err != nil || value != 1 {
+		t.Fatalf("value = %d, %v, the entry of the other cache was not read", value, err)
+	}
+	clock.Advance(15 * time.Minute)
+	if value, _ := cache.Fetch(ctx, second, cache.EndpointPriceHistory, "730/Case", load); value != 2 {
+		t.Fatalf("value = %d, expired file entry served", value)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("%d loads", calls.Load())
+	}
+
+	// An entry of an older version is dropped and loaded again
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("%d entry files", len(files))
+	}
+	if err := os.WriteFile(files[0], []byte(`{"key":"pricehistory:730/Case","value":"text"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := cache.Fetch(ctx, first, cache.EndpointPriceHistory, "730/Case", load); err != nil || value != 3 {
+		t.Fatalf("value = %d, %v", value, err)
+	}
+}
+
+func TestFileLock(t *testing.T) {
+	store, err := cache.NewFile(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := utils.NewManualClock(start)
+	store.SetClock(clock)
+	unlock, err := store.Lock(context.Background(), "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+
+	// Blocked by the lock until the context is done, the clock does not move
+	clock.SetBlocking(true)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := store.Lock(ctx, "key")
+		done <- err
+	}()
+	for clock.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(store.PollInterval)
+	for clock.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want canceled", err)
+	}
+
+	// The lock of a dead process is taken over once the clock passes the timeout
+	clock.SetBlocking(false)
+	before := clock.Now()
+	unlockStale, err := store.Lock(context.Background(), "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlockStale()
+	if waited := clock.Now().Sub(before); waited < store.LockTimeout-store.PollInterval || waited > store.LockTimeout+store.PollInterval {
+		t.Fatalf("lock taken over after %s, timeout %s", waited, store.LockTimeout)
+	}
+}
@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+// File keeps one file per entry in a directory, it may be shared by several processes.
+// The writes are atomic, and Lock uses a lock file per key holding the time it was taken
+type File struct {
+	dir          string
+	PollInterval time.Duration // wait between two attempts to take a lock
+	LockTimeout  time.Duration // a lock older than that is left by a dead process and taken over
+	mu           sync.Mutex    // guard clock
+	clock        utils.Clock
+}
+
+type fileEntry struct {
+	Key     string          `json:"key"`
+	Expires time.Time       `json:"expires"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// The directory is created if missing
+func NewFile(dir string) (*File, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &File{
+		dir:          dir,
+		PollInterval: 50 * time.Millisecond,
+		LockTimeout:  30 * time.Second,
+		clock:        utils.SystemClock{},
+	}, nil
+}
+
+// Pace the lock polls and date the locks, Cache.SetClock shares its clock with it.
+// The processes sharing the directory must agree on the time
+func (f *File) SetClock(clock utils.Clock) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.clock = clock
+}
+
+func (f *File) Clock() utils.Clock {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.clock
+}
+
+func (f *File) Get(key string) (Entry, bool, error) {
+	data, err := os.ReadFile(f.path(key, ".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return Entry{}, false, nil
+	}
+	if err != nil {
+		return Entry{}, false, err
+	}
+	tmp := fileEntry{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return Entry{}, false, err
+	}
+	// Two keys with the same hash, the file belongs to the other one
+	if tmp.Key != key {
+		return Entry{}, false, nil
+	}
+	return Entry{Value: tmp.Value, Expires: tmp.Expires}, true, nil
+}
+
+func (f *File) Set(key string, entry Entry) error {
+	data, err := json.Marshal(fileEntry{Key: key, Expires: entry.Expires, Value: entry.Value})
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	// The readers of the other processes see the old file or the new one, never a partial one
+	return os.Rename(tmp.Name(), f.path(key, ".json"))
+}
+
+func (f *File) Delete(key string) error {
+	err := os.Remove(f.path(key, ".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (f *File) Lock(ctx context.Context, key string) (func(), error) {
+	path := f.path(key, ".lock")
+	clock := f.Clock()
+	for {
+		lock, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			_, err := lock.WriteString(clock.Now().UTC().Format(time.RFC3339Nano))
+			lock.Close()
+			if err != nil {
+				os.Remove(path)
+				return nil, err
+			}
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		if taken, ok := lockTime(path); ok && clock.Now().Sub(taken) > f.LockTimeout {
+			os.Remove(path)
+			continue
+		}
+		if err := utils.SleepContext(ctx, clock, f.PollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Time written in the lock file, its modification time while it is still empty
+// or was written by an older version
+func lockTime(path string) (time.Time, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if taken, err := time.Parse(time.RFC3339Nano, string(data)); err == nil {
+		return taken, true
+	}
+	return info.ModTime(), true
+}
+
+func (f *File) path(key, ext string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+ext)
+}
@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Entry struct {
+	Value   []byte    // json encoded
+	Expires time.Time // zero never expires
+}
+
+// Backend of a Cache, the expired entries are dropped by the Cache
+type Store interface {
+	Get(key string) (Entry, bool, error)
+	Set(key string, entry Entry) error
+	Delete(key string) error
+}
+
+// Optional Store extension holding a lock per key across processes,
+// so a single process loads a missing key
+type Locker interface {
+	Lock(ctx context.Context, key string) (unlock func(), err error)
+}
+
+// Memory keeps the entries in the process
+type Memory struct {
+	mu      sync.Mutex
+	entries map[string]Entry
+}
+
+func NewMemory() *Memory {
+	return &Memory{entries: map[string]Entry{}}
+}
+
+func (m *Memory) Get(key string) (Entry, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	return entry, ok, nil
+}
+
+func (m *Memory) Set(key string, entry Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = entry
+	return nil
+}
+
+func (m *Memory) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+	return nil
+}
+
+// Drop the entries expired at now, the Cache only drops the ones it reads again
+func (m *Memory) Purge(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, entry := range m.entries {
+		if !entry.Expires.IsZero() && !now.Before(entry.Expires) {
+			delete(m.entries, key)
+		}
+	}
+}
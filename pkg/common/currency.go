@@ -1,5 +1,12 @@
 package common
 
+import (
+	"strconv"
+	"strings"
+)
+
+// Wallet currency IDs, the currency parameter of the market endpoints
+
 const (
 	CurrencyUSD = "1"
 	CurrencyGBP = "2"
@@ -41,5 +48,104 @@ const (
 	CurrencyQAR = "39"
 	CurrencyCRC = "40"
 	CurrencyUYU = "41"
-	CurrencyRMB = "9000"
 )
+
+// Currency of a steam wallet with the way the community pages render its prices
+type Currency struct {
+	ID          int    // wallet currency ID, the currency parameter of the market endpoints
+	Code        string // ISO 4217
+	Symbol      string
+	Decimals    int  // digits of the minor unit shown by steam, 0 for JPY, KRW and VND
+	SymbolAfter bool // "1,23€" rather than "$1.23"
+	Space       bool // a space between the symbol and the amount
+	DecimalSep  string
+	GroupSep    string
+}
+
+var currencies = []Currency{
+	{ID: 1, Code: "USD", Symbol: "$", Decimals: 2, DecimalSep: ".", GroupSep: ","},
+	{ID: 2, Code: "GBP", Symbol: "£", Decimals: 2, DecimalSep: ".", GroupSep: ","},
+	{ID: 3, Code: "EUR", Symbol: "€", Decimals: 2, SymbolAfter: true, DecimalSep: ",", GroupSep: "."},
+	{ID: 4, Code: "CHF", Symbol: "CHF", Decimals: 2, Space: true, DecimalSep: ".", GroupSep: " "},
+	{ID: 5, Code: "RUB", Symbol: "pуб.", Decimals: 2, SymbolAfter: true, Space: true, DecimalSep: ",", GroupSep: ""},
+	{ID: 6, Code: "PLN", Symbol: "zł", Decimals: 2, SymbolAfter: true, DecimalSep: ",", GroupSep: " "},
+	{ID: 7, Code: "BRL", Symbol: "R$", Decimals: 2, Space: true, DecimalSep: ",", GroupSep: "."},
+	{ID: 8, Code: "JPY", Symbol: "¥", Decimals: 0, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 9, Code: "NOK", Symbol: "kr", Decimals: 2, SymbolAfter: true, Space: true, DecimalSep: ",", GroupSep: " "},
+	{ID: 10, Code: "IDR", Symbol: "Rp", Decimals: 0, Space: true, DecimalSep: ".", GroupSep: " "},
+	{ID: 11, Code: "MYR", Symbol: "RM", Decimals: 2, DecimalSep: ".", GroupSep: ","},
+	{ID: 12, Code: "PHP", Symbol: "P", Decimals: 2, DecimalSep: ".", GroupSep: ","},
+	{ID: 13, Code: "SGD", Symbol: "S$", Decimals: 2, DecimalSep: ".", GroupSep: ","},
+	{ID: 14, Code: "THB", Symbol: "฿", Decimals: 2, DecimalSep: ".", GroupSep: ","},
+	{ID: 15, Code: "VND", Symbol: "₫", Decimals: 0, SymbolAfter: true, DecimalSep: ",", GroupSep: "."},
+	{ID: 16, Code: "KRW", Symbol: "₩", Decimals: 0, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 17, Code: "TRY", Symbol: "TL", Decimals: 2, SymbolAfter: true, Space: true, DecimalSep: ",", GroupSep: "."},
+	{ID: 18, Code: "UAH", Symbol: "₴", Decimals: 2, SymbolAfter: true, DecimalSep: ",", GroupSep: " "},
+	{ID: 19, Code: "MXN", Symbol: "Mex$", Decimals: 2, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 20, Code: "CAD", Symbol: "CDN$", Decimals: 2, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 21, Code: "AUD", Symbol: "A$", Decimals: 2, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 22, Code: "NZD", Symbol: "NZ$", Decimals: 2, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 23, Code: "CNY", Symbol: "¥", Decimals: 2, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 24, Code: "INR", Symbol: "₹", Decimals: 2, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 25, Code: "CLP", Symbol: "CLP$", Decimals: 0, Space: true, DecimalSep: ",", GroupSep: "."},
+	{ID: 26, Code: "PEN", Symbol: "S/.", Decimals: 2, DecimalSep: ".", GroupSep: ","},
+	{ID: 27, Code: "COP", Symbol: "COL$", Decimals: 2, Space: true, DecimalSep: ",", GroupSep: "."},
+	{ID: 28, Code: "ZAR", Symbol: "R", Decimals: 2, Space: true, DecimalSep: ".", GroupSep: " "},
+	{ID: 29, Code: "HKD", Symbol: "HK$", Decimals: 2, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 30, Code: "TWD", Symbol: "NT$", Decimals: 2, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 31, Code: "SAR", Symbol: "SR", Decimals: 2, SymbolAfter: true, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 32, Code: "AED", Symbol: "AED", Decimals: 2, SymbolAfter: true, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 34, Code: "ARS", Symbol: "ARS$", Decimals: 2, Space: true, DecimalSep: ",", GroupSep: "."},
+	{ID: 35, Code: "ILS", Symbol: "₪", Decimals: 2, DecimalSep: ".", GroupSep: ","},
+	{ID: 36, Code: "BYN", Symbol: "Br", Decimals: 2, DecimalSep: ".", GroupSep: ","},
+	{ID: 37, Code: "KZT", Symbol: "₸", Decimals: 2, SymbolAfter: true, DecimalSep: ",", GroupSep: " "},
+	{ID: 38, Code: "KWD", Symbol: "KD", Decimals: 2, SymbolAfter: true, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 39, Code: "QAR", Symbol: "QR", Decimals: 2, SymbolAfter: true, Space: true, DecimalSep: ".", GroupSep: ","},
+	{ID: 40, Code: "CRC", Symbol: "₡", Decimals: 2, DecimalSep: ",", GroupSep: "."},
+	{ID: 41, Code: "UYU", Symbol: "$U", Decimals: 2, DecimalSep: ",", GroupSep: "."},
+}
+
+// Every known currency, ordered by ID
+func Currencies() []Currency { return append([]Currency{}, currencies...) }
+
+func CurrencyByID(id int) (Currency, bool) {
+	for _, currency := range currencies {
+		if currency.ID == id {
+			return currency, true
+		}
+	}
+	return Currency{}, false
+}
+
+// Case insensitive, e.g. "usd"
+func CurrencyByCode(code string) (Currency, bool) {
+	for _, currency := range currencies {
+		if strings.EqualFold(currency.Code, code) {
+			return currency, true
+		}
+	}
+	return Currency{}, false
+}
+
+// The currency of a market currency parameter such as CurrencyEUR, an unknown ID keeps
+// two decimals and is rendered with its ID as symbol
+func LookupCurrency(id string) Currency {
+	value, err := strconv.Atoi(id)
+	if err != nil {
+		value = 0
+	}
+	if currency, ok := CurrencyByID(value); ok {
+		return currency
+	}
+	return Currency{ID: value, Symbol: id, Decimals: 2, Space: true, DecimalSep: ".", GroupSep: ","}
+}
+
+// The currency parameter of the market endpoints, e.g. "1" for USD
+func (currency Currency) IDString() string { return strconv.Itoa(currency.ID) }
+
+func (currency Currency) String() string {
+	if currency.Code == "" {
+		return currency.IDString()
+	}
+	return currency.Code
+}
@@ -0,0 +1,158 @@
+package common
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Language of the community pages, the l parameter and the Steam_Language cookie, e.g. "german"
+type Language string
+
+const (
+	LanguageEnglish    Language = "english"
+	LanguageGerman     Language = "german"
+	LanguageFrench     Language = "french"
+	LanguageItalian    Language = "italian"
+	LanguageSpanish    Language = "spanish"
+	LanguageLatam      Language = "latam"
+	LanguagePortuguese Language = "portuguese"
+	LanguageBrazilian  Language = "brazilian"
+	LanguageDutch      Language = "dutch"
+	LanguagePolish     Language = "polish"
+	LanguageCzech      Language = "czech"
+	LanguageHungarian  Language = "hungarian"
+	LanguageRomanian   Language = "romanian"
+	LanguageTurkish    Language = "turkish"
+	LanguageRussian    Language = "russian"
+	LanguageUkrainian  Language = "ukrainian"
+	LanguageSwedish    Language = "swedish"
+	LanguageDanish     Language = "danish"
+	LanguageNorwegian  Language = "norwegian"
+	LanguageFinnish    Language = "finnish"
+	LanguageSChinese   Language = "schinese"
+	LanguageTChinese   Language = "tchinese"
+	LanguageJapanese   Language = "japanese"
+	LanguageKoreana    Language = "koreana"
+	LanguageThai       Language = "thai"
+)
+
+type languageInfo struct {
+	code string // ISO 639-1, with the region where steam has one
+	// Month names as the dates render them, lowercase; an abbreviation is matched by prefix
+	months [12]string
+	extra  map[string]time.Month // abbreviations that are no prefix of the month name
+}
+
+var languages = map[Language]languageInfo{
+	LanguageEnglish: {code: "en", months: [12]string{"january", "february", "march", "april", "may", "june", "july", "august", "september", "october", "november", "december"}},
+	LanguageGerman: {code: "de", months: [12]string{"januar", "februar", "märz", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "dezember"},
+		extra: map[string]time.Month{"jän": time.January, "mrz": time.March}},
+	LanguageFrench:  {code: "fr", months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"}},
+	LanguageItalian: {code: "it", months: [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"}},
+	LanguageSpanish: {code: "es", months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		extra: map[string]time.Month{"set": time.September}},
+	LanguageLatam: {code: "es-419", months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		extra: map[string]time.Month{"set": time.September}},
+	LanguagePortuguese: {code: "pt", months: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"}},
+	LanguageBrazilian:  {code: "pt-BR", months: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"}},
+	LanguageDutch: {code: "nl", months: [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		extra: map[string]time.Month{"mrt": time.March}},
+	LanguagePolish: {code: "pl", months: [12]string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+		extra: map[string]time.Month{"paź": time.October}},
+	LanguageCzech: {code: "cs", months: [12]string{"ledna", "února", "března", "dubna", "května", "června", "července", "srpna", "září", "října", "listopadu", "prosince"},
+		extra: map[string]time.Month{"čvn": time.June, "čvc": time.July}},
+	LanguageHungarian: {code: "hu", months: [12]string{"január", "február", "március", "április", "május", "június", "július", "augusztus", "szeptember", "október", "november", "december"}},
+	LanguageRomanian:  {code: "ro", months: [12]string{"ianuarie", "februarie", "martie", "aprilie", "mai", "iunie", "iulie", "august", "septembrie", "octombrie", "noiembrie", "decembrie"}},
+	LanguageTurkish:   {code: "tr", months: [12]string{"ocak", "şubat", "mart", "nisan", "mayıs", "haziran", "temmuz", "ağustos", "eylül", "ekim", "kasım", "aralık"}},
+	LanguageRussian: {code: "ru", months: [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+		extra: map[string]time.Month{"май": time.May}},
+	LanguageUkrainian: {code: "uk", months: [12]string{"січня", "лютого", "березня", "квітня", "травня", "червня", "липня", "серпня", "вересня", "жовтня", "листопада", "грудня"}},
+	LanguageSwedish:   {code: "sv", months: [12]string{"januari", "februari", "mars", "april", "maj", "juni", "juli", "augusti", "september", "oktober", "november", "december"}},
+	LanguageDanish:    {code: "da", months: [12]string{"januar", "februar", "marts", "april", "maj", "juni", "juli", "august", "september", "oktober", "november", "december"}},
+	LanguageNorwegian: {code: "no", months: [12]string{"januar", "februar", "mars", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"}},
+	LanguageFinnish:   {code: "fi", months: [12]string{"tammikuuta", "helmikuuta", "maaliskuuta", "huhtikuuta", "toukokuuta", "kesäkuuta", "heinäkuuta", "elokuuta", "syyskuuta", "lokakuuta", "marraskuuta", "joulukuuta"}},
+	// The CJK dates count the months, e.g. 1月2日 or 1월 2일
+	LanguageSChinese: {code: "zh-CN"},
+	LanguageTChinese: {code: "zh-TW"},
+	LanguageJapanese: {code: "ja"},
+	LanguageKoreana:  {code: "ko"},
+	LanguageThai: {code: "th", months: [12]string{"มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน", "กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม"},
+		extra: map[string]time.Month{"ม.ค": time.January, "ก.พ": time.February, "มี.ค": time.March, "เม.ย": time.April, "พ.ค": time.May, "มิ.ย": time.June,
+			"ก.ค": time.July, "ส.ค": time.August, "ก.ย": time.September, "ต.ค": time.October, "พ.ย": time.November, "ธ.ค": time.December}},
+}
+
+// Every known language, English first and then by name
+func Languages() []Language {
+	res := []Language{}
+	for language := range languages {
+		if language != LanguageEnglish {
+			res = append(res, language)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return append([]Language{LanguageEnglish}, res...)
+}
+
+// A steam language name or its ISO code, case insensitive, e.g. "German", "de" or "pt-BR"
+func ParseLanguage(name string) (Language, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if _, ok := languages[Language(name)]; ok {
+		return Language(name), true
+	}
+	for language, info := range languages {
+		if strings.EqualFold(info.code, name) {
+			return language, true
+		}
+	}
+	return "", false
+}
+
+// ISO code of the language, e.g. "de" for german and "zh-CN" for schinese
+func (language Language) Code() string {
+	if info, ok := languages[language]; ok {
+		return info.code
+	}
+	return string(language)
+}
+
+func (language Language) String() string { return string(language) }
+
+// Month of a name or an abbreviation in the dates of language, e.g. "Jan", "janv." or "марта".
+// The names of English and then of the other languages are tried after the ones of language
+func ParseMonth(name string, language Language) (time.Month, bool) {
+	name = strings.ToLower(strings.Trim(strings.TrimSpace(name), "."))
+	if name == "" {
+		return 0, false
+	}
+	if month, ok := languageMonth(name, language); ok {
+		return month, true
+	}
+	for _, other := range Languages() {
+		if other == language {
+			continue
+		}
+		if month, ok := languageMonth(name, other); ok {
+			return month, true
+		}
+	}
+	return 0, false
+}
+
+func languageMonth(name string, language Language) (time.Month, bool) {
+	info, ok := languages[language]
+	if !ok {
+		return 0, false
+	}
+	if month, ok := info.extra[name]; ok {
+		return month, true
+	}
+	for i, month := range info.months {
+		// Three letters at least, "ma" would be both March and May
+		if month == name || utf8.RuneCountInString(name) >= 3 && strings.HasPrefix(month, name) {
+			return time.Month(i + 1), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,81 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Money is an exact amount in the minor unit of its currency, e.g. 29 for $0.29 or ¥ 29.
+// The market endpoints count in cents, hundredths of the main unit, whatever the currency
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Rounded to the nearest minor unit, e.g. 0.29 is 29 cents and not 28
+func MoneyFromFloat(value float64, currency Currency) Money {
+	return Money{Amount: int64(math.Round(value * math.Pow10(currency.Decimals))), Currency: currency}
+}
+
+// The amount of a market endpoint, rounded to the nearest minor unit
+func MoneyFromCents(cents int64, currency Currency) Money {
+	if currency.Decimals >= 2 {
+		return Money{Amount: cents * int64(math.Pow10(currency.Decimals-2)), Currency: currency}
+	}
+	return Money{Amount: int64(math.Round(float64(cents) / math.Pow10(2-currency.Decimals))), Currency: currency}
+}
+
+// The amount in hundredths of the main unit, as the market endpoints expect it
+func (money Money) Cents() int64 {
+	if money.Currency.Decimals >= 2 {
+		return int64(math.Round(float64(money.Amount) / math.Pow10(money.Currency.Decimals-2)))
+	}
+	return money.Amount * int64(math.Pow10(2-money.Currency.Decimals))
+}
+
+// The amount in the main unit, for display and ratios only
+func (money Money) Float() float64 {
+	return float64(money.Amount) / math.Pow10(money.Currency.Decimals)
+}
+
+func (money Money) IsZero() bool { return money.Amount == 0 }
+
+// The amount of n items priced money
+func (money Money) Mul(n int64) Money {
+	return Money{Amount: money.Amount * n, Currency: money.Currency}
+}
+
+// Rendered like the community pages, see FormatPrice
+func (money Money) String() string { return FormatPrice(money) }
+
+type moneyJSON struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// {"amount":29,"currency":"USD"}, an unknown currency is written by ID
+func (money Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: money.Amount, Currency: money.Currency.String()})
+}
+
+func (money *Money) UnmarshalJSON(data []byte) error {
+	value := moneyJSON{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	currency, ok := CurrencyByCode(value.Currency)
+	if !ok {
+		if _, err := strconv.Atoi(value.Currency); err != nil {
+			return fmt.Errorf("fail to parse money, unknown currency %q", value.Currency)
+		}
+		currency = LookupCurrency(value.Currency)
+	}
+	*money = Money{Amount: value.Amount, Currency: currency}
+	return nil
+}
@@ -0,0 +1,100 @@
+package common_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/umichan0621/steam/pkg/common"
+)
+
+func currency(t *testing.T, code string) common.Currency {
+	t.Helper()
+	res, ok := common.CurrencyByCode(code)
+	if !ok {
+		t.Fatalf("unknown currency %s", code)
+	}
+	return res
+}
+
+func TestMoneyCents(t *testing.T) {
+	tests := []struct {
+		code   string
+		value  float64
+		amount int64 // in the minor unit
+		cents  int64 // as the market endpoints count
+	}{
+		{"USD", 0.29, 29, 29},
+		{"USD", 1234.56, 123456, 123456},
+		{"EUR", 0.07, 7, 7},
+		{"JPY", 45, 45, 4500},
+		{"KRW", 1500, 1500, 150000},
+		{"VND", 23000, 23000, 2300000},
+		{"IDR", 15000, 15000, 1500000},
+		{"CNY", 45.6, 4560, 4560},
+	}
+	for _, tt := range tests {
+		cur := currency(t, tt.code)
+		money := common.MoneyFromFloat(tt.value, cur)
+		if money.Amount != tt.amount || money.Cents() != tt.cents {
+			t.Errorf("%s %v = %d, %d cents, want %d, %d cents", tt.code, tt.value, money.Amount, money.Cents(), tt.amount, tt.cents)
+		}
+		if back := common.MoneyFromCents(tt.cents, cur); back != money {
+			t.Errorf("MoneyFromCents(%d, %s) = %+v, want %+v", tt.cents, tt.code, back, money)
+		}
+	}
+	// The cents of a currency without fraction are rounded to its unit
+	if money := common.MoneyFromCents(4550, currency(t, "JPY")); money.Amount != 46 {
+		t.Errorf("4550 cents of JPY = %d", money.Amount)
+	}
+	if total := common.MoneyFromCents(29, currency(t, "USD")).Mul(3); total.Cents() != 87 {
+		t.Errorf("3 x 0.29 = %d cents", total.Cents())
+	}
+}
+
+func TestCurrencies(t *testing.T) {
+	codes, ids := map[string]bool{}, map[int]bool{}
+	for _, cur := range common.Currencies() {
+		if cur.Code == "" || cur.Symbol == "" || cur.DecimalSep == "" || codes[cur.Code] || ids[cur.ID] {
+			t.Errorf("currency %+v", cur)
+		}
+		codes[cur.Code], ids[cur.ID] = true, true
+		if byID, ok := common.CurrencyByID(cur.ID); !ok || byID != cur {
+			t.Errorf("CurrencyByID(%d) = %+v", cur.ID, byID)
+		}
+		if lookup := common.LookupCurrency(cur.IDString()); lookup != cur {
+			t.Errorf("LookupCurrency(%s) = %+v", cur.IDString(), lookup)
+		}
+	}
+	for _, code := range []string{"JPY", "KRW", "VND"} {
+		if currency(t, code).Decimals != 0 {
+			t.Errorf("%s has a fraction", code)
+		}
+	}
+	if unknown := common.LookupCurrency("99"); unknown.ID != 99 || unknown.Decimals != 2 || unknown.String() != "99" {
+		t.Errorf("LookupCurrency(99) = %+v", unknown)
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	for _, money := range []common.Money{
+		common.MoneyFromCents(29, currency(t, "USD")),
+		common.NewMoney(45, currency(t, "JPY")),
+		common.NewMoney(-120, currency(t, "EUR")),
+		common.NewMoney(5, common.LookupCurrency("99")),
+	} {
+		data, err := json.Marshal(money)
+		if err != nil {
+			t.Fatal(err)
+		}
+		back := common.Money{}
+		if err := json.Unmarshal(data, &back); err != nil || back != money {
+			t.Errorf("%s = %+v, %v, want %+v", data, back, err, money)
+		}
+	}
+	if data, _ := json.Marshal(common.MoneyFromCents(29, currency(t, "USD"))); string(data) != `{"amount":29,"currency":"USD"}` {
+		t.Errorf("json = %s", data)
+	}
+	if err := json.Unmarshal([]byte(`{"amount":1,"currency":"XYZ"}`), &common.Money{}); err == nil {
+		t.Error("unknown currency accepted")
+	}
+}
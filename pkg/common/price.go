@@ -0,0 +1,208 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Returned by the price parsers for "--", the price steam shows while an item has none
+var ErrNoPrice = errors.New("no price")
+
+// Render money like the community pages, e.g. "$1,234.56", "1.234,56€" or "¥ 1,234"
+func FormatPrice(money Money) string {
+	currency := money.Currency
+	amount := money.Amount
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	unit := int64(math.Pow10(currency.Decimals))
+	digits := strconv.FormatInt(amount/unit, 10)
+	grouped := strings.Builder{}
+	for i, r := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			grouped.WriteString(currency.GroupSep)
+		}
+		grouped.WriteRune(r)
+	}
+	number := grouped.String()
+	if currency.Decimals > 0 {
+		number += currency.DecimalSep + fmt.Sprintf("%0*d", currency.Decimals, amount%unit)
+	}
+	space := ""
+	if currency.Space {
+		space = " "
+	}
+	if currency.SymbolAfter {
+		return sign + number + space + currency.Symbol
+	}
+	return sign + currency.Symbol + space + number
+}
+
+// Parse a price formatted by steam, the currency is told by its symbol or a trailing ISO code,
+// e.g. "1,23€", "CDN$ 1,234.56" or "$0.03 USD". The ¥ is JPY, or CNY when a fraction follows it.
+// "--" fails with ErrNoPrice
+func ParsePrice(text string) (Money, error) {
+	text = cleanPrice(text)
+	if text == "--" {
+		return Money{}, ErrNoPrice
+	}
+	sign, unsigned := splitSign(text)
+	currency, number, ok := detectCurrency(unsigned)
+	if !ok {
+		return Money{}, fmt.Errorf("fail to parse price %q, unknown currency", text)
+	}
+	integer, fraction, err := splitAmount(sign+number, currency)
+	if err != nil {
+		return Money{}, fmt.Errorf("fail to parse price %q, %w", text, err)
+	}
+	if currency.Code == "JPY" && fraction != "" {
+		currency, _ = CurrencyByCode("CNY")
+	}
+	return toMoney(integer, fraction, currency)
+}
+
+// Parse a price known to be in currency, e.g. the prices of a market endpoint called with it.
+// A symbol or ISO code left in the text is ignored, "--" fails with ErrNoPrice
+func ParsePriceIn(text string, currency Currency) (Money, error) {
+	text = cleanPrice(text)
+	if text == "--" {
+		return Money{Currency: currency}, ErrNoPrice
+	}
+	sign, number := splitSign(text)
+	if _, rest, ok := detectCurrency(number); ok {
+		number = rest
+	}
+	integer, fraction, err := splitAmount(sign+number, currency)
+	if err != nil {
+		return Money{Currency: currency}, fmt.Errorf("fail to parse price %q, %w", text, err)
+	}
+	return toMoney(integer, fraction, currency)
+}
+
+// Collapse the spaces, tabs and no-break spaces of the html around and inside a price
+func cleanPrice(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// The minus FormatPrice writes before the symbol, e.g. "-$1.00"
+func splitSign(text string) (string, string) {
+	if rest, ok := strings.CutPrefix(text, "-"); ok {
+		return "-", strings.TrimSpace(rest)
+	}
+	return "", text
+}
+
+// Symbols by decreasing length, so that "CDN$" is tried before "$"
+var symbolOrder = func() []Currency {
+	res := Currencies()
+	sort.SliceStable(res, func(i, j int) bool { return len(res[i].Symbol) > len(res[j].Symbol) })
+	return res
+}()
+
+// The currency of a price and the text left around its number
+func detectCurrency(text string) (Currency, string, bool) {
+	if index := strings.LastIndex(text, " "); index >= 0 {
+		if currency, ok := CurrencyByCode(text[index+1:]); ok && !hasDigit(text[index+1:]) {
+			_, number, found := detectCurrency(text[:index])
+			if !found {
+				number = text[:index]
+			}
+			return currency, number, true
+		}
+	}
+	for _, currency := range symbolOrder {
+		symbol := currency.Symbol
+		if strings.HasPrefix(text, symbol) && !startsWithLetter(text[len(symbol):]) {
+			return currency, strings.TrimSpace(text[len(symbol):]), true
+		}
+		if strings.HasSuffix(text, symbol) && !endsWithLetter(text[:len(text)-len(symbol)]) {
+			return currency, strings.TrimSpace(text[:len(text)-len(symbol)]), true
+		}
+	}
+	return Currency{}, "", false
+}
+
+// Integer and fraction digits of a number. The decimal separator is the last dot or comma
+// followed by fewer than three digits, or the one of the currency
+func splitAmount(number string, currency Currency) (string, string, error) {
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+	digits := strings.Builder{}
+	separator := -1 // number of digits before the decimal separator
+	lastSep, sepCount := rune(0), 0
+	for _, r := range number {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '.' || r == ',':
+			separator = digits.Len()
+			if r != lastSep {
+				sepCount = 0
+			}
+			lastSep = r
+			sepCount++
+		case r == ' ' || r == '\'':
+		default:
+			return "", "", fmt.Errorf("unexpected %q", r)
+		}
+	}
+	if digits.Len() == 0 {
+		return "", "", errors.New("no digits")
+	}
+	str := digits.String()
+	if separator < 0 {
+		return sign + str, "", nil
+	}
+	fraction := len(str) - separator
+	// A separator repeated, or followed by a group of three digits, separates the thousands
+	isDecimal := fraction > 0 && sepCount == 1 && (fraction < 3 || string(lastSep) == currency.DecimalSep && fraction <= currency.Decimals)
+	if !isDecimal {
+		return sign + str, "", nil
+	}
+	return sign + str[:separator], str[separator:], nil
+}
+
+// Fractions finer than the minor unit of the currency are rounded
+func toMoney(integer, fraction string, currency Currency) (Money, error) {
+	for len(fraction) < currency.Decimals {
+		fraction += "0"
+	}
+	rounding := len(fraction) > currency.Decimals && fraction[currency.Decimals] >= '5'
+	value, err := strconv.ParseInt(integer+fraction[:currency.Decimals], 10, 64)
+	if err != nil {
+		return Money{Currency: currency}, fmt.Errorf("fail to parse price, %w", err)
+	}
+	if rounding {
+		if value < 0 {
+			value--
+		} else {
+			value++
+		}
+	}
+	return Money{Amount: value, Currency: currency}, nil
+}
+
+func hasDigit(text string) bool {
+	return strings.IndexFunc(text, unicode.IsDigit) >= 0
+}
+
+// A symbol such as "R" must not be read from the start of "RM 1.00"
+func startsWithLetter(text string) bool {
+	for _, r := range text {
+		return unicode.IsLetter(r)
+	}
+	return false
+}
+
+func endsWithLetter(text string) bool {
+	runes := []rune(text)
+	return len(runes) > 0 && unicode.IsLetter(runes[len(runes)-1])
+}
@@ -0,0 +1,133 @@
+package common_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/umichan0621/steam/pkg/common"
+)
+
+// Every currency reads back what it renders, with or without knowing the currency
+func TestPriceRoundTrip(t *testing.T) {
+	for _, cur := range common.Currencies() {
+		for _, amount := range []int64{0, 5, 1234, 123456, 123456789, -1234} {
+			money := common.NewMoney(amount, cur)
+			text := common.FormatPrice(money)
+			if got, err := common.ParsePrice(text); err != nil || got != money {
+				t.Errorf("ParsePrice(%q) = %+v, %v, want %+v", text, got, err, money)
+			}
+			if got, err := common.ParsePriceIn(text, cur); err != nil || got != money {
+				t.Errorf("ParsePriceIn(%q, %s) = %+v, %v, want %+v", text, cur, got, err, money)
+			}
+		}
+	}
+}
+
+func TestFormatPrice(t *testing.T) {
+	tests := []struct {
+		code   string
+		amount int64
+		want   string
+	}{
+		{"USD", 123456, "$1,234.56"},
+		{"USD", 3, "$0.03"},
+		{"EUR", 123456, "1.234,56€"},
+		{"GBP", -1999, "-£19.99"},
+		{"CHF", 123456, "CHF 1 234.56"},
+		{"RUB", 123456, "1234,56 pуб."},
+		{"BRL", 123456, "R$ 1.234,56"},
+		{"JPY", 1234, "¥ 1,234"},
+		{"CNY", 4560, "¥ 45.60"},
+		{"KRW", 1500, "₩ 1,500"},
+		{"VND", 23000, "23.000₫"},
+		{"NOK", 990, "9,90 kr"},
+		{"PLN", 123456, "1 234,56zł"},
+		{"CAD", 100, "CDN$ 1.00"},
+		{"KWD", 250, "2.50 KD"},
+		{"UYU", 123456, "$U1.234,56"},
+	}
+	for _, tt := range tests {
+		if got := common.FormatPrice(common.NewMoney(tt.amount, currency(t, tt.code))); got != tt.want {
+			t.Errorf("%d %s = %q, want %q", tt.amount, tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestParsePrice(t *testing.T) {
+	tests := []struct {
+		text   string
+		code   string
+		amount int64
+	}{
+		// Symbols, suffixes and ISO codes
+		{"$0.03 USD", "USD", 3},
+		{"CDN$ 1,234.56", "CAD", 123456},
+		{"R$ 1,50", "BRL", 150},
+		{"R 12.00", "ZAR", 1200},
+		{"RM12.00", "MYR", 1200},
+		{"$U1.234,56", "UYU", 123456},
+		{"S/.5.00", "PEN", 500},
+		{"12,34 pуб.", "RUB", 1234},
+		{"0,50 TL", "TRY", 50},
+		{"1.234,56 EUR", "EUR", 123456},
+		{"\n\t\t$1.00 USD\t", "USD", 100},
+		// ¥ is JPY, CNY when a fraction follows it
+		{"¥ 45", "JPY", 45},
+		{"¥ 1,234", "JPY", 1234},
+		{"¥ 45.6", "CNY", 4560},
+		{"¥ 1,234.56", "CNY", 123456},
+		// The thousands separators: repeated, or followed by three digits
+		{"$1,234", "USD", 123400},
+		{"$1,234,567", "USD", 123456700},
+		{"1.234€", "EUR", 123400},
+		{"1,234€", "EUR", 123400},
+		{"1.234.567,89€", "EUR", 123456789},
+		{"1,5€", "EUR", 150},
+		{"23.000₫", "VND", 23000},
+		{"₩ 1,500", "KRW", 1500},
+		{"CHF 1'234.50", "CHF", 123450},
+		{"1 234,56zł", "PLN", 123456},
+		{"-$0.25", "USD", -25},
+	}
+	for _, tt := range tests {
+		money, err := common.ParsePrice(tt.text)
+		if err != nil || money != common.NewMoney(tt.amount, currency(t, tt.code)) {
+			t.Errorf("ParsePrice(%q) = %+v, %v, want %d %s", tt.text, money, err, tt.amount, tt.code)
+		}
+	}
+	for _, text := range []string{"", "abc", "$", "$1.2x", "12.00"} {
+		if _, err := common.ParsePrice(text); err == nil {
+			t.Errorf("ParsePrice(%q) succeeded", text)
+		}
+	}
+	if _, err := common.ParsePrice(" -- "); !errors.Is(err, common.ErrNoPrice) {
+		t.Errorf("err = %v, want ErrNoPrice", err)
+	}
+}
+
+func TestParsePriceIn(t *testing.T) {
+	jpy, cny, eur := currency(t, "JPY"), currency(t, "CNY"), currency(t, "EUR")
+	tests := []struct {
+		text     string
+		currency common.Currency
+		amount   int64
+	}{
+		// The currency is known, ¥ 45 of a CNY wallet is not read as JPY
+		{"¥ 45", cny, 4500},
+		{"¥ 45", jpy, 45},
+		{"45.60", cny, 4560},
+		{"1,23", eur, 123},
+		{"1,23€", eur, 123},
+		{"1.234", eur, 123400},
+		{"2,50 USD", eur, 250},
+	}
+	for _, tt := range tests {
+		money, err := common.ParsePriceIn(tt.text, tt.currency)
+		if err != nil || money != common.NewMoney(tt.amount, tt.currency) {
+			t.Errorf("ParsePriceIn(%q, %s) = %+v, %v, want %d", tt.text, tt.currency, money, err, tt.amount)
+		}
+	}
+	if money, err := common.ParsePriceIn("--", eur); !errors.Is(err, common.ErrNoPrice) || money.Currency != eur {
+		t.Errorf("ParsePriceIn(--) = %+v, %v", money, err)
+	}
+}
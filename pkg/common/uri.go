@@ -3,4 +3,47 @@ package common
 const (
 	URI_STEAM_API       = "https://api.steampowered.com"
 	URI_STEAM_COMMUNITY = "https://steamcommunity.com"
+	URI_STEAM_LOGIN     = "https://login.steampowered.com"
+	URI_STEAM_STORE     = "https://store.steampowered.com"
+	URI_STEAM_HELP      = "https://help.steampowered.com"
 )
+
+// Base urls of steam hosts without trailing slash, replace them to target a mock server or a proxy
+type Endpoints struct {
+	API       string
+	Community string
+	Login     string
+	Store     string
+	Help      string
+}
+
+func DefaultEndpoints() Endpoints {
+	return Endpoints{
+		API:       URI_STEAM_API,
+		Community: URI_STEAM_COMMUNITY,
+		Login:     URI_STEAM_LOGIN,
+		Store:     URI_STEAM_STORE,
+		Help:      URI_STEAM_HELP,
+	}
+}
+
+// Empty fields are filled with the default value
+func (endpoints Endpoints) WithDefaults() Endpoints {
+	defaults := DefaultEndpoints()
+	if endpoints.API == "" {
+		endpoints.API = defaults.API
+	}
+	if endpoints.Community == "" {
+		endpoints.Community = defaults.Community
+	}
+	if endpoints.Login == "" {
+		endpoints.Login = defaults.Login
+	}
+	if endpoints.Store == "" {
+		endpoints.Store = defaults.Store
+	}
+	if endpoints.Help == "" {
+		endpoints.Help = defaults.Help
+	}
+	return endpoints
+}
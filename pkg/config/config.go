@@ -0,0 +1,268 @@
+// Package config loads the accounts of a YAML file and builds a ready auth.Core and market.Core
+// for each of them. The secrets may be read from the environment ("env:NAME") or from a file
+// ("file:path"), and a Manager reloads the file while the bots run.
+//
+//	shared:
+//	  eu: &eu
+//	    currency: EUR
+//	    country: DE
+//	accounts:
+//	  - name: main
+//	    <<: *eu
+//	    username: env:STEAM_USER
+//	    password: file:secrets/main_password
+//	    shared_secret: env:STEAM_SHARED_SECRET
+//	    identity_secret: env:STEAM_IDENTITY_SECRET
+//	    proxy: http://127.0.0.1:1234
+//	    language: german
+//	    session_file: sessions/main.json
+//	    rate_limits:
+//	      pricehistory: {interval: 6s, burst: 1}
+//	    policy:
+//	      daily_spend_cap: 50
+//	      max_over_median: 1.2
+//	    strategies:
+//	      restock: true
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/ratelimit"
+	"gopkg.in/yaml.v3"
+)
+
+type Config struct {
+	Shared   yaml.Node `yaml:"shared"` // room for the anchors merged into the accounts, ignored otherwise
+	Accounts []Account `yaml:"accounts"`
+}
+
+type Account struct {
+	Name           string `yaml:"name"` // the user name while empty
+	UserName       string `yaml:"username"`
+	Password       string `yaml:"password"`
+	SharedSecret   string `yaml:"shared_secret"`
+	IdentitySecret string `yaml:"identity_secret"`
+	APIKey         string `yaml:"api_key"`
+
+	Proxy       string        `yaml:"proxy"`
+	Timeout     time.Duration `yaml:"timeout"`
+	Currency    string        `yaml:"currency"` // ISO code or wallet currency ID, USD while empty
+	Country     string        `yaml:"country"`  // CN while empty, like market.Core
+	Language    string        `yaml:"language"` // steam name or ISO code, english while empty
+	SessionFile string        `yaml:"session_file"`
+
+	// Overrides of ratelimit.DefaultLimits by class, e.g. pricehistory. The accounts behind
+	// the same proxy share a limiter and must not override a class differently
+	RateLimits map[ratelimit.Class]Limit `yaml:"rate_limits"`
+	Policy     *Policy                   `yaml:"policy"`
+	Strategies map[string]bool           `yaml:"strategies"`
+}
+
+type Limit struct {
+	Interval time.Duration `yaml:"interval"`
+	Burst    int           `yaml:"burst"`
+}
+
+// The rules of policy.Rules, the amounts in the main unit of the account currency, e.g. 50 for $50
+type Policy struct {
+	DailySpendCap  float64            `yaml:"daily_spend_cap"`
+	MaxOverMedian  float64            `yaml:"max_over_median"`
+	MaxOpenOrders  int                `yaml:"max_open_orders"`
+	ForbiddenItems []string           `yaml:"forbidden_items"`
+	SellFloor      float64            `yaml:"sell_floor"`
+	SellFloors     map[string]float64 `yaml:"sell_floors"`
+}
+
+// Read, resolve and validate the file; the relative paths are relative to its directory
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read config, %w", err)
+	}
+	return Parse(data, filepath.Dir(path))
+}
+
+// Decode, resolve and validate a config, the relative paths are relative to dir
+func Parse(data []byte, dir string) (*Config, error) {
+	cfg := &Config{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("fail to parse config, %w", err)
+	}
+	if err := cfg.resolve(dir); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Read the env: and file: references and make the paths absolute
+func (cfg *Config) resolve(dir string) error {
+	errs := []error{}
+	for i := range cfg.Accounts {
+		acc := &cfg.Accounts[i]
+		for _, field := range []*string{&acc.UserName, &acc.Password, &acc.SharedSecret, &acc.IdentitySecret, &acc.APIKey, &acc.Proxy} {
+			value, err := resolveSecret(*field, dir)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("account %s: %w", acc.label(i), err))
+			}
+			*field = value
+		}
+		if acc.Name == "" {
+			acc.Name = acc.UserName
+		}
+		if acc.SessionFile != "" && !filepath.IsAbs(acc.SessionFile) {
+			acc.SessionFile = filepath.Join(dir, acc.SessionFile)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// "env:NAME" is the variable NAME, "file:path" the trimmed content of the file, anything else the value itself
+func resolveSecret(value, dir string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, "env:"):
+		name := strings.TrimPrefix(value, "env:")
+		res, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return res, nil
+	case strings.HasPrefix(value, "file:"):
+		path := strings.TrimPrefix(value, "file:")
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("fail to read secret file, %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	return value, nil
+}
+
+// Every problem of the config at once, joined
+func (cfg *Config) Validate() error {
+	errs := []error{}
+	if len(cfg.Accounts) == 0 {
+		errs = append(errs, errors.New("no account"))
+	}
+	names := map[string]bool{}
+	limits := map[string]map[ratelimit.Class]Limit{} // by proxy
+	for i := range cfg.Accounts {
+		acc := &cfg.Accounts[i]
+		fail := func(format string, args ...any) {
+			errs = append(errs, fmt.Errorf("account %s: %s", acc.label(i), fmt.Sprintf(format, args...)))
+		}
+		if acc.UserName == "" {
+			fail("no username")
+		}
+		if acc.Password == "" {
+			fail("no password")
+		}
+		if names[acc.Name] {
+			fail("duplicate name")
+		}
+		names[acc.Name] = true
+		if acc.Proxy != "" {
+			if u, err := url.Parse(acc.Proxy); err != nil || u.Scheme == "" || u.Host == "" {
+				fail("invalid proxy")
+			}
+		}
+		if acc.Timeout < 0 {
+			fail("negative timeout")
+		}
+		if _, ok := acc.currency(); !ok {
+			fail("unknown currency %s", acc.Currency)
+		}
+		if acc.Country != "" && (len(acc.Country) != 2 || strings.ToUpper(acc.Country) != acc.Country) {
+			fail("country %s is no upper case ISO 3166 code", acc.Country)
+		}
+		if _, ok := acc.language(); !ok {
+			fail("unknown language %s", acc.Language)
+		}
+		defaults := ratelimit.DefaultLimits()
+		if limits[acc.Proxy] == nil {
+			limits[acc.Proxy] = map[ratelimit.Class]Limit{}
+		}
+		for class, limit := range acc.RateLimits {
+			if _, ok := defaults[class]; !ok {
+				fail("unknown rate limit class %s", class)
+			}
+			if limit.Interval < 0 || limit.Burst < 0 {
+				fail("negative rate limit of %s", class)
+			}
+			if other, ok := limits[acc.Proxy][class]; ok && other != limit {
+				fail("rate limit of %s differs from another account behind the same proxy", class)
+			}
+			limits[acc.Proxy][class] = limit
+		}
+		if policy := acc.Policy; policy != nil {
+			if policy.DailySpendCap < 0 || policy.MaxOverMedian < 0 || policy.MaxOpenOrders < 0 || policy.SellFloor < 0 {
+				fail("negative policy rule")
+			}
+			for name, floor := range policy.SellFloors {
+				if floor < 0 {
+					fail("negative sell floor of %s", name)
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// The name, or the position while the account has none
+func (acc Account) label(i int) string {
+	if acc.Name != "" {
+		return acc.Name
+	}
+	return "#" + strconv.Itoa(i+1)
+}
+
+func (acc Account) currency() (common.Currency, bool) {
+	if acc.Currency == "" {
+		return common.CurrencyByID(1)
+	}
+	if currency, ok := common.CurrencyByCode(acc.Currency); ok {
+		return currency, true
+	}
+	id, err := strconv.Atoi(acc.Currency)
+	if err != nil {
+		return common.Currency{}, false
+	}
+	return common.CurrencyByID(id)
+}
+
+func (acc Account) language() (common.Language, bool) {
+	if acc.Language == "" {
+		return common.LanguageEnglish, true
+	}
+	return common.ParseLanguage(acc.Language)
+}
+
+func (acc Account) country() string {
+	if acc.Country == "" {
+		return "CN"
+	}
+	return acc.Country
+}
+
+// Same credentials, a Core keeps its session across a reload only then
+func (acc Account) sameLogin(other Account) bool {
+	return acc.UserName == other.UserName && acc.Password == other.Password &&
+		acc.SharedSecret == other.SharedSecret && acc.IdentitySecret == other.IdentitySecret
+}
@@ -0,0 +1,367 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/market"
+	"github.com/umichan0621/steam/pkg/policy"
+	"github.com/umichan0621/steam/pkg/ratelimit"
+)
+
+// Manager is safe for concurrent use. It holds the accounts of a config file and applies
+// the changes of the file on Reload; an account keeps its Core, and so its session,
+// while its credentials are unchanged
+type Manager struct {
+	mu       sync.RWMutex // guard bots, limiters, accounts and logger
+	path     string
+	setup    func(core *auth.Core)
+	bots     map[string]*Bot
+	limiters map[string]*ratelimit.Limiter // by proxy
+	accounts []Account                     // of the last reload applied without error, nil otherwise
+	logger   logging.Logger
+}
+
+// An account of the config with its Core; the market settings and the config
+// are replaced on reload, read them again instead of keeping them
+type Bot struct {
+	Name string
+	Auth *auth.Core
+
+	mu     sync.RWMutex // guard config and market
+	config Account
+	market *market.Core
+}
+
+// Load the file and build its accounts. setup runs on every Core built, before its session
+// is loaded, e.g. to install a logger, hooks or the endpoints of a test server; nil skips it
+func NewManager(path string, setup func(core *auth.Core)) (*Manager, error) {
+	m := &Manager{
+		path:     path,
+		setup:    setup,
+		bots:     map[string]*Bot{},
+		limiters: map[string]*ratelimit.Limiter{},
+		logger:   logging.NewSlog(nil),
+	}
+	if err := m.Reload(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Used for the entries of the reloads, the current slog.Default() until set; nil discards them
+func (m *Manager) SetLogger(logger logging.Logger) {
+	if logger == nil {
+		logger = logging.Nop()
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.logger = logger
+}
+
+func (m *Manager) Logger() logging.Logger {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.logger
+}
+
+// Every account, ordered by name
+func (m *Manager) Bots() []*Bot {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	res := make([]*Bot, 0, len(m.bots))
+	for _, bot := range m.bots {
+		res = append(res, bot)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+func (m *Manager) Bot(name string) (*Bot, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	bot, ok := m.bots[name]
+	return bot, ok
+}
+
+// Load the file again and apply it. An invalid file changes nothing; the secrets are read
+// again, so a rotated password or api key is picked up as well. Nothing is done while the
+// accounts and their secrets are the ones of the last reload applied without error
+func (m *Manager) Reload() error {
+	cfg, err := Load(m.path)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.accounts != nil && reflect.DeepEqual(m.accounts, cfg.Accounts) {
+		return nil
+	}
+	limiters := m.buildLimiters(cfg)
+	bots := map[string]*Bot{}
+	errs := []error{}
+	for i := range cfg.Accounts {
+		acc := cfg.Accounts[i]
+		bot, ok := m.bots[acc.Name]
+		current := Account{}
+		if ok {
+			current = bot.Config()
+		}
+		if ok && current.sameLogin(acc) {
+			if !reflect.DeepEqual(current, acc) {
+				if err := bot.apply(acc, limiters[acc.Proxy], false); err != nil {
+					errs = append(errs, fmt.Errorf("account %s: %w", acc.Name, err))
+				}
+				m.logger.Info("account reloaded", logging.Account(acc.UserName), logging.F("name", acc.Name))
+			}
+			bots[acc.Name] = bot
+			continue
+		}
+		built, err := m.build(acc, limiters[acc.Proxy])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("account %s: %w", acc.Name, err))
+			// The account keeps running with its previous credentials until they build
+			if ok {
+				m.logger.Warn("account kept with its previous credentials", logging.Account(current.UserName),
+					logging.F("name", acc.Name), logging.Err(err))
+				bots[acc.Name] = bot
+			}
+			continue
+		}
+		if ok {
+			m.logger.Info("account rebuilt for its new credentials", logging.Account(acc.UserName), logging.F("name", acc.Name))
+		}
+		bots[acc.Name] = built
+	}
+	for name, bot := range m.bots {
+		if _, ok := bots[name]; !ok {
+			m.logger.Info("account removed", logging.Account(bot.Auth.UserName()), logging.F("name", name))
+		}
+	}
+	m.bots = bots
+	m.limiters = limiters
+	m.accounts = nil
+	if len(errs) == 0 {
+		m.accounts = append([]Account{}, cfg.Accounts...)
+	}
+	return errors.Join(errs...)
+}
+
+// Reload the file every interval until ctx is done, the failures are logged and the last
+// valid config is kept
+func (m *Manager) Watch(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := m.Reload(); err != nil {
+				m.Logger().Warn("fail to reload config", logging.F("path", m.path), logging.Err(err))
+			}
+		}
+	}
+}
+
+// One limiter per proxy with the overrides of its accounts, the current ones are kept
+// so the reservations in flight are not lost; only the limits changed are set, which keeps
+// the buckets as they are. m.mu must be held
+func (m *Manager) buildLimiters(cfg *Config) map[string]*ratelimit.Limiter {
+	limits := map[string]map[ratelimit.Class]ratelimit.Limit{}
+	for _, acc := range cfg.Accounts {
+		proxyLimits, ok := limits[acc.Proxy]
+		if !ok {
+			proxyLimits = ratelimit.DefaultLimits()
+			limits[acc.Proxy] = proxyLimits
+		}
+		for class, limit := range acc.RateLimits {
+			proxyLimits[class] = ratelimit.Limit{Interval: limit.Interval, Burst: limit.Burst}
+		}
+	}
+	res := map[string]*ratelimit.Limiter{}
+	for proxy, proxyLimits := range limits {
+		limiter, ok := m.limiters[proxy]
+		if !ok {
+			limiter = ratelimit.NewLimiter()
+		}
+		for class, limit := range proxyLimits {
+			limiter.SetLimit(class, limit)
+		}
+		res[proxy] = limiter
+	}
+	return res
+}
+
+func (m *Manager) build(acc Account, limiter *ratelimit.Limiter) (*Bot, error) {
+	core := &auth.Core{}
+	core.Init(auth.LoginInfo{
+		UserName:       acc.UserName,
+		Password:       acc.Password,
+		SharedSecret:   acc.SharedSecret,
+		IdentitySecret: acc.IdentitySecret,
+	})
+	if m.setup != nil {
+		m.setup(core)
+	}
+	bot := &Bot{Name: acc.Name, Auth: core}
+	if err := bot.apply(acc, limiter, true); err != nil {
+		return nil, err
+	}
+	if err := bot.loadSession(); err != nil {
+		return nil, err
+	}
+	return bot, nil
+}
+
+// Apply the settings of acc to the Core, the http client is only replaced while
+// the proxy or the timeout changes so a transport installed by the setup is kept.
+// acc is checked before the Core is touched, a failed apply changes nothing
+func (bot *Bot) apply(acc Account, limiter *ratelimit.Limiter, created bool) error {
+	language, ok := acc.language()
+	if !ok {
+		return fmt.Errorf("unknown language %s", acc.Language)
+	}
+	currency, ok := acc.currency()
+	if !ok {
+		return fmt.Errorf("unknown currency %s", acc.Currency)
+	}
+	if _, err := url.Parse(acc.Proxy); err != nil {
+		return fmt.Errorf("fail to parse the proxy, %w", err)
+	}
+
+	// The only setter that can fail goes first
+	old := bot.Config()
+	if created && (acc.Proxy != "" || acc.Timeout != 0) || !created && (acc.Proxy != old.Proxy || acc.Timeout != old.Timeout) {
+		if err := bot.Auth.SetHttpParam(int(acc.Timeout/time.Millisecond), acc.Proxy); err != nil {
+			return fmt.Errorf("fail to set the proxy, %w", err)
+		}
+	}
+	if bot.Auth.RateLimiter() != limiter {
+		bot.Auth.SetRateLimiter(limiter)
+	}
+	if language != bot.Auth.Language() {
+		bot.Auth.SetLanguage(language)
+	}
+	if acc.APIKey != "" || !created && old.APIKey != "" {
+		bot.Auth.SetAPIKey(acc.APIKey)
+	}
+	switch {
+	case acc.Policy == nil:
+		bot.Auth.SetPolicy(nil)
+	case bot.Auth.Policy() == nil:
+		engine := policy.NewEngine(acc.Policy.rules(currency))
+		engine.SetClock(bot.Auth.Clock())
+		bot.Auth.SetPolicy(engine)
+	default:
+		bot.Auth.Policy().SetRules(acc.Policy.rules(currency))
+	}
+	marketCore := &market.Core{}
+	marketCore.Init()
+	marketCore.SetCurrency(currency)
+	marketCore.SetCountry(acc.country())
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+	bot.config = acc
+	bot.market = marketCore
+	return nil
+}
+
+// The amounts in cents of currency
+func (cfg *Policy) rules(currency common.Currency) policy.Rules {
+	cents := func(value float64) int64 { return common.MoneyFromFloat(value, currency).Cents() }
+	rules := policy.Rules{
+		Currency:       currency,
+		DailySpendCap:  cents(cfg.DailySpendCap),
+		MaxOverMedian:  cfg.MaxOverMedian,
+		MaxOpenOrders:  cfg.MaxOpenOrders,
+		ForbiddenItems: cfg.ForbiddenItems,
+		SellFloor:      cents(cfg.SellFloor),
+	}
+	if len(cfg.SellFloors) != 0 {
+		rules.SellFloors = map[string]int64{}
+		for name, floor := range cfg.SellFloors {
+			rules.SellFloors[name] = cents(floor)
+		}
+	}
+	return rules
+}
+
+// A copy of the config of the account, as of the last reload
+func (bot *Bot) Config() Account {
+	bot.mu.RLock()
+	defer bot.mu.RUnlock()
+	return bot.config
+}
+
+// The market settings of the account, a new Core after every reload changing them
+func (bot *Bot) Market() *market.Core {
+	bot.mu.RLock()
+	defer bot.mu.RUnlock()
+	return bot.market
+}
+
+func (bot *Bot) Currency() common.Currency {
+	currency, _ := bot.Config().currency()
+	return currency
+}
+
+// False for a strategy missing from the config
+func (bot *Bot) Enabled(strategy string) bool {
+	return bot.Config().Strategies[strategy]
+}
+
+// Restore the session written by SaveSession, a missing file is no error
+func (bot *Bot) loadSession() error {
+	path := bot.Config().SessionFile
+	if path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("fail to read session, %w", err)
+	}
+	if err := bot.Auth.SetCookie(string(data)); err != nil {
+		return fmt.Errorf("fail to parse session %s, %w", path, err)
+	}
+	bot.Auth.ApplyCookie()
+	return nil
+}
+
+// Write the session of the Core to the session file, e.g. after a login; nothing without session file
+func (bot *Bot) SaveSession() error {
+	path := bot.Config().SessionFile
+	if path == "" {
+		return nil
+	}
+	session, err := bot.Auth.CookieString()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("fail to save session, %w", err)
+	}
+	// Written aside and renamed, a crash never leaves half a session
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(session), 0o600); err != nil {
+		return fmt.Errorf("fail to save session, %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("fail to save session, %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package config_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/config"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/ratelimit"
+)
+
+func write(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newManager(t *testing.T, content string) (*config.Manager, string) {
+	t.Helper()
+	dir := t.TempDir()
+	write(t, filepath.Join(dir, "password"), "pass")
+	path := filepath.Join(dir, "config.yaml")
+	write(t, path, content)
+	m, err := config.NewManager(path, func(core *auth.Core) { core.SetLogger(nil) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetLogger(logging.Nop())
+	return m, dir
+}
+
+type okTransport struct{}
+
+func (okTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+// The tokens spent stay spent across the reloads
+func TestReloadKeepsBuckets(t *testing.T) {
+	m, dir := newManager(t, `accounts:
+  - name: main
+    username: bot
+    password: file:password
+`)
+	bot, _ := m.Bot("main")
+	limiter := bot.Auth.RateLimiter()
+	req, _ := http.NewRequest(http.MethodGet, "https://steamcommunity.com/market/pricehistory/", nil)
+	if _, err := limiter.Transport(okTransport{}).RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	interval := ratelimit.DefaultLimits()[ratelimit.ClassPriceHistory].Interval
+
+	for _, content := range []string{
+		// Unchanged
+		"accounts:\n  - name: main\n    username: bot\n    password: file:password\n",
+		// Another setting, and a limit of another class
+		"accounts:\n  - name: main\n    username: bot\n    password: file:password\n    strategies: {restock: true}\n    rate_limits:\n      market: {interval: 2s, burst: 1}\n",
+	} {
+		write(t, filepath.Join(dir, "config.yaml"), content)
+		if err := m.Reload(); err != nil {
+			t.Fatal(err)
+		}
+		if wait := limiter.Wait(ratelimit.ClassPriceHistory); wait < interval/2 {
+			t.Fatalf("wait = %s after the reload, the burst was handed out again", wait)
+		}
+	}
+	if bot, _ := m.Bot("main"); bot.Auth.RateLimiter() != limiter || !bot.Enabled("restock") {
+		t.Fatal("reload not applied")
+	}
+}
+
+func TestReloadCredentials(t *testing.T) {
+	m, dir := newManager(t, `accounts:
+  - name: main
+    username: bot
+    password: file:password
+`)
+	bot, _ := m.Bot("main")
+
+	// A rotated secret rebuilds the account, even with the file unchanged
+	write(t, filepath.Join(dir, "password"), "rotated")
+	if err := m.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	rotated, _ := m.Bot("main")
+	if rotated == bot || rotated.Config().Password != "rotated" {
+		t.Fatal("rotated password not picked up")
+	}
+
+	// New credentials failing to build keep the running account
+	write(t, filepath.Join(dir, "session.json"), "not json")
+	write(t, filepath.Join(dir, "config.yaml"), `accounts:
+  - name: main
+    username: bot
+    password: new
+    session_file: session.json
+`)
+	if err := m.Reload(); err == nil {
+		t.Fatal("broken session accepted")
+	}
+	if kept, ok := m.Bot("main"); !ok || kept != rotated {
+		t.Fatal("account dropped after a failed build")
+	}
+	// and the same file is tried again on the next reload
+	write(t, filepath.Join(dir, "session.json"), `{"SessionID":"abc"}`)
+	if err := m.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if rebuilt, _ := m.Bot("main"); rebuilt == rotated || rebuilt.Config().Password != "new" {
+		t.Fatal("account not rebuilt once its session is fixed")
+	}
+}
+
+func TestReloadTimeout(t *testing.T) {
+	m, dir := newManager(t, `accounts:
+  - name: main
+    username: bot
+    password: file:password
+    timeout: 5s
+`)
+	bot, _ := m.Bot("main")
+	if timeout := bot.Auth.HttpClient().Timeout; timeout != 5*time.Second {
+		t.Fatalf("timeout = %s", timeout)
+	}
+
+	// An invalid file leaves the account as it is
+	write(t, filepath.Join(dir, "config.yaml"), `accounts:
+  - name: main
+    username: bot
+    password: file:password
+    language: german
+    currency: XXX
+`)
+	if err := m.Reload(); err == nil {
+		t.Fatal("unknown currency accepted")
+	}
+	if bot.Auth.Language() != common.LanguageEnglish || bot.Auth.HttpClient().Timeout != 5*time.Second || bot.Config().Language != "" {
+		t.Fatal("a rejected reload changed the account")
+	}
+
+	// Removing the timeout removes it from the client, not only from the config
+	write(t, filepath.Join(dir, "config.yaml"), `accounts:
+  - name: main
+    username: bot
+    password: file:password
+`)
+	if err := m.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if timeout := bot.Auth.HttpClient().Timeout; timeout != 0 {
+		t.Fatalf("timeout = %s after its removal", timeout)
+	}
+}
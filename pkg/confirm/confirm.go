@@ -1,25 +1,25 @@
 package confirm
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/binary"
-	"encoding/json"
-	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
-	"time"
 
 	"github.com/umichan0621/steam/pkg/auth"
-	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/retry"
 )
 
 type ConfirmationResponse struct {
 	Success       bool            `json:"success"`
+	NeedAuth      bool            `json:"needauth"`
 	Confirmations []*Confirmation `json:"conf"`
 }
 
@@ -39,11 +39,26 @@ type Confirmation struct {
 }
 
 func GetConfirmations(auth *auth.Core) ([]*Confirmation, error) {
+	return GetConfirmationsContext(context.Background(), auth)
+}
+
+// The confirmations of the simulated listings come after the ones of steam in dry run
+func GetConfirmationsContext(ctx context.Context, auth *auth.Core) ([]*Confirmation, error) {
+	res, err := retry.Read(ctx, auth, retry.OpGetConfirmations, func(ctx context.Context) ([]*Confirmation, error) {
+		return getConfirmations(ctx, auth)
+	})
+	if sim := auth.DryRun(); sim != nil && err == nil {
+		res = append(res, simulatedConfirmations(sim)...)
+	}
+	return res, err
+}
+
+func getConfirmations(ctx context.Context, auth *auth.Core) ([]*Confirmation, error) {
 	identitySecret := auth.IdentitySecret()
 	if identitySecret == "" {
 		return nil, fmt.Errorf("empty identity secret")
 	}
-	current := time.Now().Unix()
+	current := auth.Clock().Now().Unix()
 
 	key, err := generateConfirmationCode(identitySecret, "conf", current)
 	if err != nil {
@@ -51,41 +66,57 @@ func GetConfirmations(auth *auth.Core) ([]*Confirmation, error) {
 	}
 	params := url.Values{
 		"p":   {auth.DeviceID()},
-		"a":   {auth.SteamID()},
+		"a":   {auth.SteamID().String()},
 		"k":   {key},
 		"t":   {strconv.FormatInt(current, 10)},
 		"m":   {"android"},
 		"tag": {"conf"},
 	}
 
-	getUrl := fmt.Sprintf("%s/mobileconf/getlist?%s", common.URI_STEAM_COMMUNITY, params.Encode())
-	httpRes, err := auth.HttpClient().Get(getUrl)
+	res := ConfirmationResponse{}
+	_, err = web.JSON(ctx, auth, &web.Request{
+		Path:  "/mobileconf/getlist",
+		Query: params,
+	}, &res)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("fail to get ConfirmationResponse, %w", err)
 	}
-	defer httpRes.Body.Close()
+	return res.Confirmations, nil
+}
 
-	data, err := io.ReadAll(httpRes.Body)
-	if err != nil {
-		return nil, err
+func AnswerConfirmation(auth *auth.Core, confirmation *Confirmation, answer string) error {
+	return AnswerConfirmationContext(context.Background(), auth, confirmation, answer)
+}
+
+func AnswerConfirmationContext(ctx context.Context, auth *auth.Core, confirmation *Confirmation, answer string) error {
+	params := map[string]any{
+		"confirmation_id": confirmation.ID, "type": confirmation.Type, "creator_id": confirmation.Creator,
+		"headline": confirmation.Headline, "answer": answer,
+	}
+	if sim := auth.DryRun(); sim != nil {
+		err := simulatedAnswer(auth, sim, confirmation, answer)
+		auth.Audit(ctx, retry.OpAnswerConfirmation.Name, params, nil, err)
+		return err
 	}
-	res := ConfirmationResponse{}
-	err = json.Unmarshal(data, &res)
+	_, err := retry.Write(ctx, auth, retry.OpAnswerConfirmation, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, answerConfirmation(ctx, auth, confirmation, answer)
+	}, reconcileAnswer(auth, confirmation))
+	auth.Audit(ctx, retry.OpAnswerConfirmation.Name, params, nil, err)
+	fields := []logging.Field{logging.F("confirmation_id", confirmation.ID), logging.F("type", confirmation.TypeName), logging.F("answer", answer)}
 	if err != nil {
-		return nil, err
-	}
-	if !res.Success {
-		return nil, fmt.Errorf("fail to get ConfirmationResponse: %s", string(data))
+		auth.Logger().Warn("fail to answer confirmation", append(fields, logging.Err(err))...)
+		return err
 	}
-	return res.Confirmations, nil
+	auth.Logger().Info("confirmation answered", fields...)
+	return nil
 }
 
-func AnswerConfirmation(auth *auth.Core, confirmation *Confirmation, answer string) error {
+func answerConfirmation(ctx context.Context, auth *auth.Core, confirmation *Confirmation, answer string) error {
 	identitySecret := auth.IdentitySecret()
 	if identitySecret == "" {
 		return fmt.Errorf("empty identity secret")
 	}
-	current := time.Now().Unix()
+	current := auth.Clock().Now().Unix()
 
 	key, err := generateConfirmationCode(identitySecret, answer, current)
 	if err != nil {
@@ -93,7 +124,7 @@ func AnswerConfirmation(auth *auth.Core, confirmation *Confirmation, answer stri
 	}
 	params := url.Values{
 		"p":   {auth.DeviceID()},
-		"a":   {auth.SteamID()},
+		"a":   {auth.SteamID().String()},
 		"k":   {key},
 		"t":   {strconv.FormatInt(current, 10)},
 		"m":   {"android"},
@@ -102,37 +133,28 @@ func AnswerConfirmation(auth *auth.Core, confirmation *Confirmation, answer stri
 		"cid": {confirmation.ID},
 		"ck":  {confirmation.Nonce},
 	}
-	reqUrl := fmt.Sprintf("%s/mobileconf/ajaxop?%s", common.URI_STEAM_COMMUNITY, params.Encode())
-	httpReq, err := http.NewRequest("GET", reqUrl, nil)
-	if err != nil {
-		return err
-	}
-	httpReq.Header.Set("X-Requested-With", "XMLHttpRequest")
-	httpRes, err := auth.HttpClient().Do(httpReq)
-	if err != nil {
-		return err
-	}
-	if httpRes != nil {
-		defer httpRes.Body.Close()
-	}
-	data, err := io.ReadAll(httpRes.Body)
-	if err != nil {
-		return err
-	}
-	type Response struct {
-		Success bool   `json:"success"`
-		Message string `json:"message"`
-	}
-	res := Response{}
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return err
-	}
+	_, err = web.JSON(ctx, auth, &web.Request{
+		Path:   "/mobileconf/ajaxop",
+		Query:  params,
+		Header: http.Header{"X-Requested-With": {"XMLHttpRequest"}},
+	}, nil)
+	return err
+}
 
-	if !res.Success {
-		return errors.New(res.Message)
+// The confirmation gone from the list means the failed answer took effect
+func reconcileAnswer(auth *auth.Core, confirmation *Confirmation) retry.Reconcile[struct{}] {
+	return func(ctx context.Context) (struct{}, retry.Outcome, error) {
+		list, err := GetConfirmationsContext(ctx, auth)
+		if err != nil {
+			return struct{}{}, retry.Unknown, err
+		}
+		for _, tmp := range list {
+			if tmp.ID == confirmation.ID {
+				return struct{}{}, retry.NotApplied, nil
+			}
+		}
+		return struct{}{}, retry.Applied, nil
 	}
-	return nil
 }
 
 func generateConfirmationCode(identitySecret, tag string, current int64) (string, error) {
@@ -0,0 +1,39 @@
+package confirm
+
+import (
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/dryrun"
+	"github.com/umichan0621/steam/pkg/logging"
+)
+
+// Type of the market listing confirmations
+const typeMarketListing = 3
+
+// The pending confirmations of the simulated listings, like the ones of steam
+func simulatedConfirmations(sim *dryrun.Simulator) []*Confirmation {
+	res := []*Confirmation{}
+	for _, tmp := range sim.Confirmations() {
+		res = append(res, &Confirmation{
+			ID:           tmp.ID,
+			Type:         typeMarketListing,
+			Nonce:        tmp.Nonce,
+			CreationTime: uint64(tmp.Created.Unix()),
+			TypeName:     "Market Listing",
+			Cancel:       "Cancel",
+			Accept:       "Confirm",
+			Headline:     tmp.HashName,
+		})
+	}
+	return res
+}
+
+// A simulated listing is listed or given back, the confirmations of steam are left pending
+func simulatedAnswer(auth *auth.Core, sim *dryrun.Simulator, confirmation *Confirmation, answer string) error {
+	fields := []logging.Field{logging.F("confirmation_id", confirmation.ID), logging.F("type", confirmation.TypeName), logging.F("answer", answer)}
+	if !sim.AnswerConfirmation(confirmation.ID, answer == "allow") {
+		auth.Logger().Info("confirmation of steam not answered in dry run", fields...)
+		return nil
+	}
+	auth.Logger().Info("simulated confirmation answered", fields...)
+	return nil
+}
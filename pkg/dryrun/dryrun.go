@@ -0,0 +1,407 @@
+// Package dryrun simulates the state-changing calls against a virtual wallet and inventory,
+// so a strategy can run on live data without moving money. Install a Simulator with
+// auth.Core.SetDryRun: the reads still hit steam, the writes of the market, trade and
+// confirm packages are answered from the Simulator, and market.SimulateFills fills
+// the virtual orders from the live order graphs.
+package dryrun
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+type Item struct {
+	AppID     uint32
+	ContextID uint64
+	AssetID   string // generated while empty
+	HashName  string
+	Amount    uint64
+}
+
+type BuyOrder struct {
+	ID        uint64
+	AppID     string
+	HashName  string
+	Currency  string
+	Price     int64 // per item, in cents
+	Quantity  uint64
+	Remaining uint64
+	Created   time.Time
+}
+
+type Listing struct {
+	ID            uint64
+	Item          Item
+	ReceivedPrice int64 // per item, in cents
+	Confirmed     bool  // listed on the market, false while the confirmation is pending
+	Created       time.Time
+}
+
+type Confirmation struct {
+	ID        string
+	Nonce     string
+	ListingID uint64
+	HashName  string
+	Created   time.Time
+}
+
+type FillKind string
+
+const (
+	FillBuy  FillKind = "buy"
+	FillSell FillKind = "sell"
+)
+
+type Fill struct {
+	Time     time.Time
+	Kind     FillKind
+	OrderID  uint64 // buy order or listing ID
+	HashName string
+	Quantity uint64
+	Price    int64 // per item in cents, paid for a buy and received for a sell
+}
+
+type TradeAction string
+
+const (
+	TradeAccept  TradeAction = "accept"
+	TradeCancel  TradeAction = "cancel"
+	TradeDecline TradeAction = "decline"
+)
+
+type Trade struct {
+	Time     time.Time
+	OfferID  string
+	Action   TradeAction
+	Given    []Item
+	Received []Item
+}
+
+// Simulator is safe for concurrent use
+type Simulator struct {
+	mu            sync.Mutex
+	clock         utils.Clock
+	nextID        uint64
+	wallet        int64 // cents, reserved funds included
+	reserved      int64 // cents held by the open buy orders
+	inventory     map[string]*Item
+	buyOrders     map[uint64]*BuyOrder
+	listings      map[uint64]*Listing
+	confirmations map[string]*Confirmation
+	fills         []Fill
+	trades        []Trade
+}
+
+// wallet: virtual balance in cents
+func New(wallet int64) *Simulator {
+	return &Simulator{
+		clock:         utils.SystemClock{},
+		nextID:        1,
+		wallet:        wallet,
+		inventory:     map[string]*Item{},
+		buyOrders:     map[uint64]*BuyOrder{},
+		listings:      map[uint64]*Listing{},
+		confirmations: map[string]*Confirmation{},
+	}
+}
+
+// Share it with the Core for the timestamps
+func (sim *Simulator) SetClock(clock utils.Clock) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	sim.clock = clock
+}
+
+// Balance in cents, the funds held by the buy orders included
+func (sim *Simulator) Wallet() int64 {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	return sim.wallet
+}
+
+// Balance in cents not held by a buy order
+func (sim *Simulator) Available() int64 {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	return sim.wallet - sim.reserved
+}
+
+func (sim *Simulator) SetWallet(cents int64) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	sim.wallet = cents
+}
+
+// Put an item in the virtual inventory, e.g. one of the real inventory; return its asset ID
+func (sim *Simulator) AddItem(item Item) string {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	return sim.addItem(item)
+}
+
+func (sim *Simulator) Inventory() []Item {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	res := []Item{}
+	for _, item := range sim.inventory {
+		res = append(res, *item)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].AssetID < res[j].AssetID })
+	return res
+}
+
+func (sim *Simulator) BuyOrders() []BuyOrder {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	res := []BuyOrder{}
+	for _, order := range sim.buyOrders {
+		res = append(res, *order)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+func (sim *Simulator) Listings() []Listing {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	res := []Listing{}
+	for _, listing := range sim.listings {
+		res = append(res, *listing)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+func (sim *Simulator) Item(assetID string) (Item, bool) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	item, ok := sim.inventory[assetID]
+	if !ok {
+		return Item{}, false
+	}
+	return *item, true
+}
+
+// Buy orders and listings, the ones waiting for a confirmation included
+func (sim *Simulator) OpenOrders() int {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	return len(sim.buyOrders) + len(sim.listings)
+}
+
+// Pending confirmations of the virtual listings
+func (sim *Simulator) Confirmations() []Confirmation {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	res := []Confirmation{}
+	for _, confirmation := range sim.confirmations {
+		res = append(res, *confirmation)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ListingID < res[j].ListingID })
+	return res
+}
+
+func (sim *Simulator) Fills() []Fill {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	return append([]Fill{}, sim.fills...)
+}
+
+func (sim *Simulator) Trades() []Trade {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	return append([]Trade{}, sim.trades...)
+}
+
+// Hold priceTotal on the wallet like steam, a single order per item
+func (sim *Simulator) CreateBuyOrder(appID, hashName, currency string, priceTotal int64, quantity uint64) (uint64, error) {
+	const endpoint = "/market/createbuyorder/"
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	if quantity == 0 || priceTotal <= 0 {
+		return 0, errcode.New(endpoint, 0, errcode.EResultInvalidParam, "invalid price or quantity")
+	}
+	for _, order := range sim.buyOrders {
+		if order.AppID == appID && order.HashName == hashName {
+			return 0, errcode.New(endpoint, 0, errcode.EResultDuplicateRequest, "You already have an active buy order for this item.")
+		}
+	}
+	if priceTotal > sim.wallet-sim.reserved {
+		return 0, errcode.New(endpoint, 0, errcode.EResultInsufficientFunds, "You do not have enough funds to place this order.")
+	}
+	order := &BuyOrder{
+		ID:        sim.id(),
+		AppID:     appID,
+		HashName:  hashName,
+		Currency:  currency,
+		Price:     priceTotal / int64(quantity),
+		Quantity:  quantity,
+		Remaining: quantity,
+		Created:   sim.clock.Now(),
+	}
+	sim.buyOrders[order.ID] = order
+	sim.reserved += order.Price * int64(quantity)
+	return order.ID, nil
+}
+
+func (sim *Simulator) CancelBuyOrder(orderID uint64) error {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	order, ok := sim.buyOrders[orderID]
+	if !ok {
+		return errcode.New("/market/cancelbuyorder/", 0, errcode.EResultInvalidParam, "no such buy order")
+	}
+	sim.reserved -= order.Price * int64(order.Remaining)
+	delete(sim.buyOrders, orderID)
+	return nil
+}
+
+// Move the item from the inventory to a listing waiting for its confirmation
+func (sim *Simulator) CreateSellOrder(appID, contextID, assetID string, amount uint64, receivedPrice int64) (*Confirmation, error) {
+	const endpoint = "/market/sellitem/"
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	item, ok := sim.inventory[assetID]
+	if !ok || strconv.FormatUint(uint64(item.AppID), 10) != appID || strconv.FormatUint(item.ContextID, 10) != contextID {
+		return nil, errcode.New(endpoint, 0, errcode.EResultFail, "The item specified is no longer in your inventory or is not allowed to be traded on the Community Market.")
+	}
+	if amount == 0 || amount > item.Amount || receivedPrice <= 0 {
+		return nil, errcode.New(endpoint, 0, errcode.EResultInvalidParam, "invalid price or amount")
+	}
+	listed := *item
+	listed.Amount = amount
+	if item.Amount -= amount; item.Amount == 0 {
+		delete(sim.inventory, assetID)
+	}
+	listing := &Listing{ID: sim.id(), Item: listed, ReceivedPrice: receivedPrice, Created: sim.clock.Now()}
+	sim.listings[listing.ID] = listing
+	confirmation := &Confirmation{
+		ID:        strconv.FormatUint(sim.id(), 10),
+		Nonce:     strconv.FormatUint(sim.id(), 10),
+		ListingID: listing.ID,
+		HashName:  listed.HashName,
+		Created:   listing.Created,
+	}
+	sim.confirmations[confirmation.ID] = confirmation
+	tmp := *confirmation
+	return &tmp, nil
+}
+
+// Accept puts the listing on the market, cancel gives the item back;
+// false while the confirmation is not a virtual one
+func (sim *Simulator) AnswerConfirmation(confirmationID string, accept bool) bool {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	confirmation, ok := sim.confirmations[confirmationID]
+	if !ok {
+		return false
+	}
+	delete(sim.confirmations, confirmationID)
+	listing, ok := sim.listings[confirmation.ListingID]
+	if !ok {
+		return true
+	}
+	if accept {
+		listing.Confirmed = true
+		return true
+	}
+	delete(sim.listings, listing.ID)
+	sim.addItem(listing.Item)
+	return true
+}
+
+// Apply an accepted trade: the given items leave the inventory, the received ones enter it
+func (sim *Simulator) AcceptTrade(offerID string, given, received []Item) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	for _, item := range given {
+		if tmp, ok := sim.inventory[item.AssetID]; ok {
+			if tmp.Amount <= item.Amount {
+				delete(sim.inventory, item.AssetID)
+			} else {
+				tmp.Amount -= item.Amount
+			}
+		}
+	}
+	res := []Item{}
+	for _, item := range received {
+		item.AssetID = ""
+		item.AssetID = sim.addItem(item)
+		res = append(res, item)
+	}
+	sim.trades = append(sim.trades, Trade{Time: sim.clock.Now(), OfferID: offerID, Action: TradeAccept, Given: given, Received: res})
+}
+
+// Record a canceled or declined offer, nothing moves
+func (sim *Simulator) RecordTrade(offerID string, action TradeAction) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	sim.trades = append(sim.trades, Trade{Time: sim.clock.Now(), OfferID: offerID, Action: action})
+}
+
+// Fill quantity items of the buy order at price per item, the items enter the inventory
+func (sim *Simulator) FillBuyOrder(orderID uint64, quantity uint64, price int64, appID uint32, contextID uint64) (Fill, error) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	order, ok := sim.buyOrders[orderID]
+	if !ok {
+		return Fill{}, fmt.Errorf("no such buy order %d", orderID)
+	}
+	quantity = min(quantity, order.Remaining)
+	price = min(price, order.Price)
+	sim.reserved -= order.Price * int64(quantity)
+	sim.wallet -= price * int64(quantity)
+	if order.Remaining -= quantity; order.Remaining == 0 {
+		delete(sim.buyOrders, orderID)
+	}
+	for i := uint64(0); i < quantity; i++ {
+		sim.addItem(Item{AppID: appID, ContextID: contextID, HashName: order.HashName, Amount: 1})
+	}
+	fill := Fill{Time: sim.clock.Now(), Kind: FillBuy, OrderID: orderID, HashName: order.HashName, Quantity: quantity, Price: price}
+	sim.fills = append(sim.fills, fill)
+	return fill, nil
+}
+
+// Sell the whole listing, the received price enters the wallet
+func (sim *Simulator) FillListing(listingID uint64) (Fill, error) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	listing, ok := sim.listings[listingID]
+	if !ok || !listing.Confirmed {
+		return Fill{}, fmt.Errorf("no such active listing %d", listingID)
+	}
+	delete(sim.listings, listingID)
+	sim.wallet += listing.ReceivedPrice * int64(listing.Item.Amount)
+	fill := Fill{Time: sim.clock.Now(), Kind: FillSell, OrderID: listingID, HashName: listing.Item.HashName,
+		Quantity: listing.Item.Amount, Price: listing.ReceivedPrice}
+	sim.fills = append(sim.fills, fill)
+	return fill, nil
+}
+
+// sim.mu must be held
+func (sim *Simulator) addItem(item Item) string {
+	if item.Amount == 0 {
+		item.Amount = 1
+	}
+	if item.AssetID == "" {
+		item.AssetID = strconv.FormatUint(sim.id(), 10)
+	}
+	if tmp, ok := sim.inventory[item.AssetID]; ok {
+		tmp.Amount += item.Amount
+		return item.AssetID
+	}
+	sim.inventory[item.AssetID] = &item
+	return item.AssetID
+}
+
+// Virtual IDs, far above the real ones so they never collide; sim.mu must be held
+func (sim *Simulator) id() uint64 {
+	sim.nextID++
+	return 1<<62 + sim.nextID
+}
@@ -0,0 +1,124 @@
+package dryrun_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/umichan0621/steam/pkg/audit"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/confirm"
+	"github.com/umichan0621/steam/pkg/dryrun"
+	"github.com/umichan0621/steam/pkg/market"
+	"github.com/umichan0621/steam/pkg/steamid"
+	"github.com/umichan0621/steam/pkg/steamtest"
+	"github.com/umichan0621/steam/pkg/trade"
+)
+
+var usd, _ = common.CurrencyByCode("USD")
+
+// Keep the audit records in memory
+type records []*audit.Record
+
+func (r *records) Append(record *audit.Record) error {
+	*r = append(*r, record)
+	return nil
+}
+
+func TestDryRun(t *testing.T) {
+	server, core, steamID := steamtest.NewLoggedIn(t, steamtest.Account{Wallet: 100000})
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Case", LowestPrice: 100, MedianPrice: 95, Volume: 10,
+		SellOrders: []steamtest.OrderLevel{{Price: 100, Quantity: 2}, {Price: 120, Quantity: 5}},
+		BuyOrders:  []steamtest.OrderLevel{{Price: 150, Quantity: 3}}})
+	sim := dryrun.New(1000)
+	sim.SetClock(core.Clock())
+	core.SetDryRun(sim)
+	sink := &records{}
+	log, _ := audit.New(sink)
+	core.SetAuditLog(log)
+	marketCore := &market.Core{}
+	marketCore.Init()
+	marketCore.SetCountry("US")
+
+	// 4 at 1.10, the sell orders at 1.00 fill 2 of them
+	order, err := market.CreateBuyOrder(core, "730", common.MoneyFromCents(440, usd), 4, "Case")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := market.CreateBuyOrder(core, "730", common.MoneyFromCents(440, usd), 4, "Case"); err == nil {
+		t.Fatal("a second buy order for the item was accepted")
+	}
+	if sim.Available() != 1000-440 {
+		t.Fatalf("available = %d, the order total is not reserved", sim.Available())
+	}
+	fills, err := marketCore.SimulateFills(core)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 1 || fills[0].Kind != dryrun.FillBuy || fills[0].Quantity != 2 || fills[0].Price != 100 {
+		t.Fatalf("fills = %+v", fills)
+	}
+	if sim.Wallet() != 1000-200 || len(sim.Inventory()) == 0 {
+		t.Fatalf("wallet = %d, inventory = %+v", sim.Wallet(), sim.Inventory())
+	}
+	if err := market.CancelBuyOrder(core, order.OrderID); err != nil {
+		t.Fatal(err)
+	}
+	if sim.Available() != sim.Wallet() {
+		t.Fatalf("available = %d, wallet = %d after the cancel", sim.Available(), sim.Wallet())
+	}
+
+	// A listing waits for its confirmation, then the buy orders at 1.50 take it
+	asset := sim.Inventory()[0].AssetID
+	if _, err := market.CreateSellOrder(core, "730", "2", asset, 1, common.MoneyFromCents(120, usd)); err != nil {
+		t.Fatal(err)
+	}
+	confirmations, err := confirm.GetConfirmations(core)
+	if err != nil || len(confirmations) != 1 {
+		t.Fatalf("confirmations = %+v, %v", confirmations, err)
+	}
+	if err := confirm.AnswerConfirmation(core, confirmations[0], "allow"); err != nil {
+		t.Fatal(err)
+	}
+	fills, err = marketCore.SimulateFills(core)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 1 || fills[0].Kind != dryrun.FillSell || fills[0].Price != 120 {
+		t.Fatalf("fills = %+v", fills)
+	}
+	if sim.Wallet() != 1000-200+120 {
+		t.Fatalf("wallet = %d after the sale", sim.Wallet())
+	}
+
+	partner := steamid.FromAccountID(5)
+	offerID := server.AddTradeOffer(steamID, steamtest.Offer{PartnerAccount: partner.AccountID(),
+		ItemsToReceive: []*steamtest.Item{{AppID: 730, ContextID: 2, ClassID: 9, MarketHashName: "Key"}}})
+	if err := trade.AcceptTradeOffer(core, strconv.FormatUint(offerID, 10), partner); err != nil {
+		t.Fatal(err)
+	}
+	if trades := sim.Trades(); len(trades) != 1 || trades[0].Action != dryrun.TradeAccept || len(trades[0].Received) != 1 {
+		t.Fatalf("trades = %+v", trades)
+	}
+
+	// Every simulated call is audited as such
+	operations := []string{}
+	for _, record := range *sink {
+		if !record.DryRun {
+			t.Fatalf("record %+v not marked as dry run", record)
+		}
+		operations = append(operations, record.Operation)
+	}
+	want := "market.CreateBuyOrder market.CreateBuyOrder market.CancelBuyOrder market.CreateSellOrder confirm.AnswerConfirmation trade.AcceptTradeOffer"
+	if strings.Join(operations, " ") != want {
+		t.Fatalf("audited %v, want %s", operations, want)
+	}
+
+	// Nothing reached steam
+	if len(server.BuyOrders(steamID)) != 0 || len(server.Listings(steamID)) != 0 || server.Wallet(steamID) != 100000 {
+		t.Fatal("a simulated call reached the server")
+	}
+	if offer, _ := server.Offer(steamID, offerID); offer.State != steamtest.OfferStateActive {
+		t.Fatalf("offer state = %d, the simulated accept reached the server", offer.State)
+	}
+}
@@ -1,26 +1,162 @@
 package err
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"regexp"
 	"strconv"
 )
 
+// Error of a failed steam call, match it with errors.Is against an EResult or a sentinel,
+// or get the details with errors.As
+type Error struct {
+	EResult  EResult
+	Endpoint string // request path, e.g. /market/createbuyorder/
+	Status   int    // http status code, 0 while unknown
+	Message  string // message returned by steam, e.g. strError
+	Err      error  // underlying cause, may be nil
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("steam error: %s (%d)", e.EResult.String(), int(e.EResult))
+	if e.Endpoint != "" {
+		msg += ", endpoint: " + e.Endpoint
+	}
+	if e.Status != 0 {
+		msg += fmt.Sprintf(", status code = %d", e.Status)
+	}
+	if e.Message != "" {
+		msg += ", message: " + e.Message
+	}
+	if e.Err != nil {
+		msg += ", " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *Error) Is(target error) bool {
+	switch t := target.(type) {
+	case EResult:
+		return e.EResult == t
+	case *Error:
+		return e.EResult == t.EResult
+	}
+	return false
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+func New(endpoint string, status int, eresult EResult, message string) *Error {
+	return &Error{EResult: eresult, Endpoint: endpoint, Status: status, Message: message}
+}
+
+// Error code appended by steam to some messages, e.g. "... Please try again later. (28)"
+var messageCodeRegexp = regexp.MustCompile(`\((\d+)\)\s*$`)
+
+// EResult embedded in a steam message, EResultFail while there is none
+func ParseMessage(message string) EResult {
+	match := messageCodeRegexp.FindStringSubmatch(message)
+	if match == nil {
+		return EResultFail
+	}
+	code, err := strconv.Atoi(match[1])
+	if err != nil || code <= int(EResultOK) {
+		return EResultFail
+	}
+	return EResult(code)
+}
+
+// EResult matching an http status code, EResultOK for 2xx
+func StatusEResult(status int) EResult {
+	switch {
+	case status >= 200 && status < 300:
+		return EResultOK
+	case status == http.StatusTooManyRequests:
+		return EResultRateLimitExceeded
+	case status == http.StatusUnauthorized:
+		return EResultNotLoggedOn
+	case status == http.StatusForbidden:
+		return EResultAccessDenied
+	case status == http.StatusNotFound:
+		return EResultFileNotFound
+	case status == http.StatusBadRequest:
+		return EResultInvalidParam
+	case status == http.StatusServiceUnavailable:
+		return EResultServiceUnavailable
+	case status == http.StatusGatewayTimeout:
+		return EResultTimeout
+	case status == http.StatusBadGateway:
+		return EResultBusy
+	}
+	return EResultFail
+}
+
+// EResult describing any error returned by the library, EResultOK for nil
+func EResultOf(err error) EResult {
+	if err == nil {
+		return EResultOK
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.EResult
+	}
+	var eresult EResult
+	if errors.As(err, &eresult) {
+		return eresult
+	}
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.Canceled):
+		return EResultCancelled
+	case errors.Is(err, context.DeadlineExceeded):
+		return EResultTimeout
+	case errors.As(err, &netErr):
+		return EResultNoConnection
+	}
+	return EResultFail
+}
+
 func CheckHeader(header *http.Header) error {
+	return checkHeader("", 0, header)
+}
+
+// Error from the X-Eresult header and the http status of a response, nil on success
+func CheckResponse(res *http.Response) error {
+	endpoint := ""
+	if res.Request != nil && res.Request.URL != nil {
+		endpoint = res.Request.URL.Path
+	}
+	if err := checkHeader(endpoint, res.StatusCode, &res.Header); err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return New(endpoint, res.StatusCode, StatusEResult(res.StatusCode), res.Header.Get("X-Error_message"))
+	}
+	return nil
+}
+
+// Error from the "success" field of a web response, nil while success is EResultOK
+func CheckResult(endpoint string, status int, success EResult, message string) error {
+	if success == EResultOK {
+		return nil
+	}
+	return New(endpoint, status, success, message)
+}
+
+func checkHeader(endpoint string, status int, header *http.Header) error {
 	xEresultStr := header.Get("X-Eresult")
 	if xEresultStr == "" {
 		return nil
 	}
 	xEresult, err := strconv.Atoi(xEresultStr)
 	if err != nil {
-		return err
+		return &Error{EResult: EResultFail, Endpoint: endpoint, Status: status, Err: err}
 	}
-	if xEresult != 1 {
-		codeMsg, ok := codeMap[xEresult]
-		if !ok {
-			return fmt.Errorf("fail to login, error: Unkown error code %d", xEresult)
-		}
-		return fmt.Errorf("fail to login, error: %s", codeMsg)
+	if EResult(xEresult) != EResultOK {
+		return New(endpoint, status, EResult(xEresult), header.Get("X-Error_message"))
 	}
 	return nil
 }
@@ -1,6 +1,173 @@
 package err
 
-var codeMap = map[int]string{
+import "fmt"
+
+// Result code of a steam call, carried by the X-Eresult header and the "success" field of most web responses
+type EResult int
+
+const (
+	EResultOK                                      EResult = 1
+	EResultFail                                    EResult = 2
+	EResultNoConnection                            EResult = 3
+	EResultNoConnectionRetry                       EResult = 4
+	EResultInvalidPassword                         EResult = 5
+	EResultLoggedInElsewhere                       EResult = 6
+	EResultInvalidProtocolVer                      EResult = 7
+	EResultInvalidParam                            EResult = 8
+	EResultFileNotFound                            EResult = 9
+	EResultBusy                                    EResult = 10
+	EResultInvalidState                            EResult = 11
+	EResultInvalidName                             EResult = 12
+	EResultInvalidEmail                            EResult = 13
+	EResultDuplicateName                           EResult = 14
+	EResultAccessDenied                            EResult = 15
+	EResultTimeout                                 EResult = 16
+	EResultBanned                                  EResult = 17
+	EResultAccountNotFound                         EResult = 18
+	EResultInvalidSteamID                          EResult = 19
+	EResultServiceUnavailable                      EResult = 20
+	EResultNotLoggedOn                             EResult = 21
+	EResultPending                                 EResult = 22
+	EResultEncryptionFailure                       EResult = 23
+	EResultInsufficientPrivilege                   EResult = 24
+	EResultLimitExceeded                           EResult = 25
+	EResultRevoked                                 EResult = 26
+	EResultExpired                                 EResult = 27
+	EResultAlreadyRedeemed                         EResult = 28
+	EResultDuplicateRequest                        EResult = 29
+	EResultAlreadyOwned                            EResult = 30
+	EResultIPNotFound                              EResult = 31
+	EResultPersistFailed                           EResult = 32
+	EResultLockingFailed                           EResult = 33
+	EResultLogonSessionReplaced                    EResult = 34
+	EResultConnectFailed                           EResult = 35
+	EResultHandshakeFailed                         EResult = 36
+	EResultIOFailure                               EResult = 37
+	EResultRemoteDisconnect                        EResult = 38
+	EResultShoppingCartNotFound                    EResult = 39
+	EResultBlocked                                 EResult = 40
+	EResultIgnored                                 EResult = 41
+	EResultNoMatch                                 EResult = 42
+	EResultAccountDisabled                         EResult = 43
+	EResultServiceReadOnly                         EResult = 44
+	EResultAccountNotFeatured                      EResult = 45
+	EResultAdministratorOK                         EResult = 46
+	EResultContentVersion                          EResult = 47
+	EResultTryAnotherCM                            EResult = 48
+	EResultPasswordRequiredToKickSession           EResult = 49
+	EResultAlreadyLoggedInElsewhere                EResult = 50
+	EResultSuspended                               EResult = 51
+	EResultCancelled                               EResult = 52
+	EResultDataCorruption                          EResult = 53
+	EResultDiskFull                                EResult = 54
+	EResultRemoteCallFailed                        EResult = 55
+	EResultPasswordUnset                           EResult = 56
+	EResultExternalAccountUnlinked                 EResult = 57
+	EResultPSNTicketInvalid                        EResult = 58
+	EResultExternalAccountAlreadyLinked            EResult = 59
+	EResultRemoteFileConflict                      EResult = 60
+	EResultIllegalPassword                         EResult = 61
+	EResultSameAsPreviousValue                     EResult = 62
+	EResultAccountLogonDenied                      EResult = 63
+	EResultCannotUseOldPassword                    EResult = 64
+	EResultInvalidLoginAuthCode                    EResult = 65
+	EResultAccountLogonDeniedNoMail                EResult = 66
+	EResultHardwareNotCapableOfIPT                 EResult = 67
+	EResultIPTInitError                            EResult = 68
+	EResultParentalControlRestricted               EResult = 69
+	EResultFacebookQueryError                      EResult = 70
+	EResultExpiredLoginAuthCode                    EResult = 71
+	EResultIPLoginRestrictionFailed                EResult = 72
+	EResultAccountLockedDown                       EResult = 73
+	EResultAccountLogonDeniedVerifiedEmailRequired EResult = 74
+	EResultNoMatchingURL                           EResult = 75
+	EResultBadResponse                             EResult = 76
+	EResultRequirePasswordReEntry                  EResult = 77
+	EResultValueOutOfRange                         EResult = 78
+	EResultUnexpectedError                         EResult = 79
+	EResultDisabled                                EResult = 80
+	EResultInvalidCEGSubmission                    EResult = 81
+	EResultRestrictedDevice                        EResult = 82
+	EResultRegionLocked                            EResult = 83
+	EResultRateLimitExceeded                       EResult = 84
+	EResultAccountLoginDeniedNeedTwoFactor         EResult = 85
+	EResultItemDeleted                             EResult = 86
+	EResultAccountLoginDeniedThrottle              EResult = 87
+	EResultTwoFactorCodeMismatch                   EResult = 88
+	EResultTwoFactorActivationCodeMismatch         EResult = 89
+	EResultAccountAssociatedToMultiplePartners     EResult = 90
+	EResultNotModified                             EResult = 91
+	EResultNoMobileDevice                          EResult = 92
+	EResultTimeNotSynced                           EResult = 93
+	EResultSmsCodeFailed                           EResult = 94
+	EResultAccountLimitExceeded                    EResult = 95
+	EResultAccountActivityLimitExceeded            EResult = 96
+	EResultPhoneActivityLimitExceeded              EResult = 97
+	EResultRefundToWallet                          EResult = 98
+	EResultEmailSendFailure                        EResult = 99
+	EResultNotSettled                              EResult = 100
+	EResultNeedCaptcha                             EResult = 101
+	EResultGSLTDenied                              EResult = 102
+	EResultGSOwnerDenied                           EResult = 103
+	EResultInvalidItemType                         EResult = 104
+	EResultIPBanned                                EResult = 105
+	EResultGSLTExpired                             EResult = 106
+	EResultInsufficientFunds                       EResult = 107
+	EResultTooManyPending                          EResult = 108
+	EResultNoSiteLicensesFound                     EResult = 109
+	EResultWGNetworkSendExceeded                   EResult = 110
+	EResultAccountNotFriends                       EResult = 111
+	EResultLimitedUserAccount                      EResult = 112
+	EResultCantRemoveItem                          EResult = 113
+	EResultAccountDeleted                          EResult = 114
+	EResultExistingUserCancelledLicense            EResult = 115
+	EResultCommunityCooldown                       EResult = 116
+	EResultNoLauncherSpecified                     EResult = 117
+	EResultMustAgreeToSSA                          EResult = 118
+	EResultLauncherMigrated                        EResult = 119
+	EResultSteamRealmMismatch                      EResult = 120
+	EResultInvalidSignature                        EResult = 121
+	EResultParseFailure                            EResult = 122
+	EResultNoVerifiedPhone                         EResult = 123
+)
+
+// Sentinels for errors.Is, e.g. errors.Is(err, errcode.ErrRateLimitExceeded)
+var (
+	ErrFail                       error = EResultFail
+	ErrInvalidPassword            error = EResultInvalidPassword
+	ErrInvalidParam               error = EResultInvalidParam
+	ErrFileNotFound               error = EResultFileNotFound
+	ErrBusy                       error = EResultBusy
+	ErrInvalidState               error = EResultInvalidState
+	ErrAccessDenied               error = EResultAccessDenied
+	ErrTimeout                    error = EResultTimeout
+	ErrServiceUnavailable         error = EResultServiceUnavailable
+	ErrNotLoggedOn                error = EResultNotLoggedOn
+	ErrPending                    error = EResultPending
+	ErrLimitExceeded              error = EResultLimitExceeded
+	ErrRevoked                    error = EResultRevoked
+	ErrExpired                    error = EResultExpired
+	ErrDuplicateRequest           error = EResultDuplicateRequest
+	ErrRateLimitExceeded          error = EResultRateLimitExceeded
+	ErrAccountLoginDeniedThrottle error = EResultAccountLoginDeniedThrottle
+	ErrItemDeleted                error = EResultItemDeleted
+	ErrTwoFactorCodeMismatch      error = EResultTwoFactorCodeMismatch
+	ErrInsufficientFunds          error = EResultInsufficientFunds
+	ErrTooManyPending             error = EResultTooManyPending
+)
+
+func (r EResult) String() string {
+	name, ok := codeMap[r]
+	if !ok {
+		return fmt.Sprintf("EResult(%d)", int(r))
+	}
+	return name
+}
+
+// EResult is an error itself, so it can be used as an errors.Is target
+func (r EResult) Error() string { return r.String() }
+
+var codeMap = map[EResult]string{
 	1:   "OK",
 	2:   "Fail",
 	3:   "NoConnection",
@@ -0,0 +1,152 @@
+package err_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	errcode "github.com/umichan0621/steam/pkg/err"
+)
+
+func TestParseMessage(t *testing.T) {
+	tests := []struct {
+		message string
+		want    errcode.EResult
+	}{
+		{"There was a problem listing your item. Please try again later. (28)", errcode.EResultAlreadyRedeemed},
+		{"You cannot buy any items until your previous action completes. (16)  ", errcode.EResultTimeout},
+		{"Too many requests (84)", errcode.EResultRateLimitExceeded},
+		{"", errcode.EResultFail},
+		{"No code at all", errcode.EResultFail},
+		{"(29) not at the end", errcode.EResultFail},
+		// OK and zero are no failure code
+		{"Strange (1)", errcode.EResultFail},
+		{"Strange (0)", errcode.EResultFail},
+		{"Too large (99999999999999999999)", errcode.EResultFail},
+	}
+	for _, tt := range tests {
+		if got := errcode.ParseMessage(tt.message); got != tt.want {
+			t.Errorf("ParseMessage(%q) = %s, want %s", tt.message, got, tt.want)
+		}
+	}
+}
+
+func TestStatusEResult(t *testing.T) {
+	tests := []struct {
+		status int
+		want   errcode.EResult
+	}{
+		{http.StatusOK, errcode.EResultOK},
+		{http.StatusNoContent, errcode.EResultOK},
+		{http.StatusTooManyRequests, errcode.EResultRateLimitExceeded},
+		{http.StatusUnauthorized, errcode.EResultNotLoggedOn},
+		{http.StatusForbidden, errcode.EResultAccessDenied},
+		{http.StatusNotFound, errcode.EResultFileNotFound},
+		{http.StatusBadRequest, errcode.EResultInvalidParam},
+		{http.StatusServiceUnavailable, errcode.EResultServiceUnavailable},
+		{http.StatusGatewayTimeout, errcode.EResultTimeout},
+		{http.StatusBadGateway, errcode.EResultBusy},
+		{http.StatusInternalServerError, errcode.EResultFail},
+		{http.StatusFound, errcode.EResultFail},
+	}
+	for _, tt := range tests {
+		if got := errcode.StatusEResult(tt.status); got != tt.want {
+			t.Errorf("StatusEResult(%d) = %s, want %s", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestEResultOf(t *testing.T) {
+	steamErr := errcode.New("/market/createbuyorder/", 200, errcode.EResultInsufficientFunds, "")
+	tests := []struct {
+		name string
+		err  error
+		want errcode.EResult
+	}{
+		{"nil", nil, errcode.EResultOK},
+		{"steam error", steamErr, errcode.EResultInsufficientFunds},
+		{"wrapped steam error", fmt.Errorf("fail to buy, %w", steamErr), errcode.EResultInsufficientFunds},
+		{"eresult", errcode.ErrRateLimitExceeded, errcode.EResultRateLimitExceeded},
+		{"canceled", fmt.Errorf("fail to send, %w", context.Canceled), errcode.EResultCancelled},
+		{"deadline", context.DeadlineExceeded, errcode.EResultTimeout},
+		{"network", &url.Error{Op: "Get", URL: "https://steamcommunity.com", Err: &net.OpError{Op: "dial", Err: errors.New("refused")}}, errcode.EResultNoConnection},
+		{"other", errors.New("fail to parse"), errcode.EResultFail},
+	}
+	for _, tt := range tests {
+		if got := errcode.EResultOf(tt.err); got != tt.want {
+			t.Errorf("%s: EResultOf = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	cause := errors.New("connection reset")
+	err := fmt.Errorf("fail to create buy order, %w", &errcode.Error{
+		EResult: errcode.EResultRateLimitExceeded, Endpoint: "/market/createbuyorder/", Status: 429, Message: "slow down", Err: cause})
+
+	if !errors.Is(err, errcode.ErrRateLimitExceeded) || !errors.Is(err, errcode.EResultRateLimitExceeded) {
+		t.Error("not matched by its EResult")
+	}
+	if !errors.Is(err, &errcode.Error{EResult: errcode.EResultRateLimitExceeded}) {
+		t.Error("not matched by an Error of the same EResult")
+	}
+	if errors.Is(err, errcode.ErrFail) || errors.Is(err, &errcode.Error{EResult: errcode.EResultBusy}) {
+		t.Error("matched by another EResult")
+	}
+	if !errors.Is(err, cause) {
+		t.Error("cause not unwrapped")
+	}
+	e := &errcode.Error{}
+	if !errors.As(err, &e) || e.Status != 429 || e.Endpoint != "/market/createbuyorder/" {
+		t.Fatalf("errors.As = %+v", e)
+	}
+	want := "steam error: RateLimitExceeded (84), endpoint: /market/createbuyorder/, status code = 429, message: slow down, connection reset"
+	if e.Error() != want {
+		t.Errorf("Error() = %q, want %q", e.Error(), want)
+	}
+	if got := errcode.EResult(9999).String(); got != "EResult(9999)" {
+		t.Errorf("unknown EResult = %q", got)
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	request := &http.Request{URL: &url.URL{Path: "/market/sellitem/"}}
+	tests := []struct {
+		name    string
+		status  int
+		header  http.Header
+		want    errcode.EResult // EResultOK while no error is expected
+		message string
+	}{
+		{"ok", 200, http.Header{}, errcode.EResultOK, ""},
+		{"ok header", 200, http.Header{"X-Eresult": {"1"}}, errcode.EResultOK, ""},
+		{"eresult header", 200, http.Header{"X-Eresult": {"15"}, "X-Error_message": {"denied"}}, errcode.EResultAccessDenied, "denied"},
+		{"malformed header", 200, http.Header{"X-Eresult": {"x"}}, errcode.EResultFail, ""},
+		{"status only", 503, http.Header{}, errcode.EResultServiceUnavailable, ""},
+		// The header wins over the status
+		{"header and status", 500, http.Header{"X-Eresult": {"84"}}, errcode.EResultRateLimitExceeded, ""},
+	}
+	for _, tt := range tests {
+		err := errcode.CheckResponse(&http.Response{StatusCode: tt.status, Header: tt.header, Request: request})
+		if tt.want == errcode.EResultOK {
+			if err != nil {
+				t.Errorf("%s: err = %v", tt.name, err)
+			}
+			continue
+		}
+		e := &errcode.Error{}
+		if !errors.As(err, &e) || e.EResult != tt.want || e.Message != tt.message || e.Endpoint != "/market/sellitem/" || e.Status != tt.status {
+			t.Errorf("%s: err = %v, want %s", tt.name, err, tt.want)
+		}
+	}
+	if err := errcode.CheckResult("/market/sellitem/", 200, errcode.EResultOK, ""); err != nil {
+		t.Errorf("CheckResult(OK) = %v", err)
+	}
+	if err := errcode.CheckResult("/market/sellitem/", 200, errcode.EResultDuplicateRequest, ""); !errors.Is(err, errcode.ErrDuplicateRequest) {
+		t.Errorf("CheckResult(DuplicateRequest) = %v", err)
+	}
+}
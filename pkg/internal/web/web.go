@@ -0,0 +1,256 @@
+// Package web executes the requests of the community and web api endpoints
+// the same way for every package: sessionid, referer, logged out detection,
+// EResult extraction and JSON decoding.
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/umichan0621/steam/pkg/common"
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/logging"
+)
+
+// The part of auth.Core used by the executor
+type Session interface {
+	HttpClient() *http.Client
+	SessionID() string
+	Endpoints() common.Endpoints
+	Logger() logging.Logger
+}
+
+type Request struct {
+	Method    string     // GET while empty
+	Path      string     // path under the community endpoint, or an absolute url
+	Query     url.Values // appended to the url
+	Form      url.Values // posted url encoded, sessionid is added under the community endpoint
+	Multipart url.Values // posted as multipart/form-data, sessionid is added under the community endpoint
+	Referer   string     // path under the community endpoint, or an absolute url
+	Header    http.Header
+	Login     bool // the endpoint requires a logged in session
+}
+
+type Response struct {
+	Endpoint string // request path, used in the errors
+	Status   int
+	Header   http.Header
+	Body     []byte
+}
+
+// Markers of the sign in page steam serves instead of the requested one
+var loggedOutMarkers = []string{"g_steamID = false;", "login_modal", "newlogindialog"}
+
+// Send the request and read the whole body, fail on network errors,
+// on a logged out session while req.Login and on a X-Eresult header other than OK;
+// the http status is left to the caller
+func Do(ctx context.Context, session Session, req *Request) (*Response, error) {
+	httpReq, err := newRequest(ctx, session, req)
+	if err != nil {
+		return nil, err
+	}
+	httpRes, err := session.HttpClient().Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpRes.Body.Close()
+	data, err := io.ReadAll(httpRes.Body)
+	if err != nil {
+		return nil, err
+	}
+	res := &Response{
+		Endpoint: httpReq.URL.Path,
+		Status:   httpRes.StatusCode,
+		Header:   httpRes.Header,
+		Body:     data,
+	}
+	logger := session.Logger()
+	logger.Debug("steam request", logging.Endpoint(res.Endpoint), logging.F("method", httpReq.Method), logging.F("status", res.Status))
+	if req.Login && res.loggedOut(httpRes) {
+		logger.Warn("session is logged out", logging.Endpoint(res.Endpoint))
+		return nil, errcode.New(res.Endpoint, res.Status, errcode.EResultNotLoggedOn, "session is logged out")
+	}
+	if err := errcode.CheckHeader(&httpRes.Header); err != nil {
+		var e *errcode.Error
+		if errors.As(err, &e) {
+			e.Endpoint, e.Status = res.Endpoint, res.Status
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// Do and require a 200 status, used for the html pages
+func Page(ctx context.Context, session Session, req *Request) (string, error) {
+	res, err := Do(ctx, session, req)
+	if err != nil {
+		return "", err
+	}
+	if err := res.CheckStatus(); err != nil {
+		return "", err
+	}
+	return string(res.Body), nil
+}
+
+// Do and decode the JSON body into v, v may be nil while only the result matters;
+// the success, strError and message fields are checked before the http status,
+// since steam often reports the cause of a 500 in the body
+func JSON(ctx context.Context, session Session, req *Request, v any) (*Response, error) {
+	res, err := Do(ctx, session, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := res.Result(); err != nil {
+		return nil, err
+	}
+	if err := res.CheckStatus(); err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return res, nil
+	}
+	if err := json.Unmarshal(res.Body, v); err != nil {
+		return nil, &errcode.Error{EResult: errcode.EResultBadResponse, Endpoint: res.Endpoint, Status: res.Status, Err: err}
+	}
+	return res, nil
+}
+
+func (res *Response) CheckStatus() error {
+	if res.Status == http.StatusOK {
+		return nil
+	}
+	return errcode.New(res.Endpoint, res.Status, errcode.StatusEResult(res.Status), res.Header.Get("X-Error_message"))
+}
+
+// Error reported in the JSON body, nil while the body is not JSON or reports no failure
+func (res *Response) Result() error {
+	if !gjson.ValidBytes(res.Body) {
+		return nil
+	}
+	body := gjson.ParseBytes(res.Body)
+	if !body.IsObject() {
+		return nil
+	}
+	message := ""
+	for _, key := range []string{"strError", "message", "error", "Error"} {
+		if tmp := body.Get(key); tmp.Type == gjson.String && tmp.String() != "" {
+			message = tmp.String()
+			break
+		}
+	}
+	if body.Get("strError").String() != "" {
+		return errcode.New(res.Endpoint, res.Status, errcode.ParseMessage(message), message)
+	}
+	success := body.Get("success")
+	switch {
+	case !success.Exists(), success.Type == gjson.True:
+		return nil
+	case success.Type == gjson.Number:
+		return errcode.CheckResult(res.Endpoint, res.Status, errcode.EResult(success.Int()), message)
+	}
+	if body.Get("needauth").Bool() {
+		return errcode.New(res.Endpoint, res.Status, errcode.EResultNotLoggedOn, message)
+	}
+	return errcode.New(res.Endpoint, res.Status, errcode.ParseMessage(message), message)
+}
+
+// Steam redirects to the sign in page, or serves it in place, while the session is invalid
+func (res *Response) loggedOut(httpRes *http.Response) bool {
+	if httpRes.Request != nil && strings.HasPrefix(httpRes.Request.URL.Path, "/login") &&
+		!strings.HasPrefix(res.Endpoint, "/login") {
+		return true
+	}
+	if !strings.HasPrefix(res.Header.Get("Content-Type"), "text/html") {
+		return false
+	}
+	for _, marker := range loggedOutMarkers {
+		if bytes.Contains(res.Body, []byte(marker)) {
+			return true
+		}
+	}
+	return false
+}
+
+func newRequest(ctx context.Context, session Session, req *Request) (*http.Request, error) {
+	endpoints := session.Endpoints()
+	reqUrl := resolve(endpoints, req.Path)
+	if len(req.Query) != 0 {
+		reqUrl += "?" + req.Query.Encode()
+	}
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	contentType := ""
+	switch {
+	case req.Form != nil:
+		form := withSessionID(req.Form, session, req.Path)
+		body = strings.NewReader(form.Encode())
+		contentType = "application/x-www-form-urlencoded; charset=UTF-8"
+	case req.Multipart != nil:
+		buf := new(bytes.Buffer)
+		multipartWriter := multipart.NewWriter(buf)
+		fields := withSessionID(req.Multipart, session, req.Path)
+		keys := make([]string, 0, len(fields))
+		for key := range fields {
+			keys = append(keys, key)
+		}
+		// Stable field order, so the recorded fixtures do not depend on the map order
+		sort.Strings(keys)
+		for _, key := range keys {
+			for _, val := range fields[key] {
+				multipartWriter.WriteField(key, val)
+			}
+		}
+		multipartWriter.Close()
+		body = buf
+		contentType = multipartWriter.FormDataContentType()
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, reqUrl, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, list := range req.Header {
+		for _, val := range list {
+			httpReq.Header.Add(key, val)
+		}
+	}
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	if req.Referer != "" {
+		httpReq.Header.Set("Referer", resolve(endpoints, req.Referer))
+	}
+	return httpReq, nil
+}
+
+func resolve(endpoints common.Endpoints, path string) string {
+	if strings.HasPrefix(path, "/") {
+		return endpoints.Community + path
+	}
+	return path
+}
+
+// The web api endpoints take no sessionid
+func withSessionID(values url.Values, session Session, path string) url.Values {
+	res := url.Values{}
+	for key, list := range values {
+		res[key] = append([]string(nil), list...)
+	}
+	if strings.HasPrefix(path, "/") && res.Get("sessionid") == "" {
+		res.Set("sessionid", session.SessionID())
+	}
+	return res
+}
@@ -0,0 +1,63 @@
+package web_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/internal/web"
+)
+
+func TestResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    errcode.EResult // EResultOK while no error is expected
+		message string
+	}{
+		{"html page", "<html>ok</html>", errcode.EResultOK, ""},
+		{"json array", `[1,2]`, errcode.EResultOK, ""},
+		{"no success", `{"total_count":3}`, errcode.EResultOK, ""},
+		{"success true", `{"success":true}`, errcode.EResultOK, ""},
+		{"success 1", `{"success":1}`, errcode.EResultOK, ""},
+		{"success number", `{"success":16,"message":"busy"}`, errcode.EResultTimeout, "busy"},
+		{"success false", `{"success":false,"message":"Please try again later. (25)"}`, errcode.EResultLimitExceeded, "Please try again later. (25)"},
+		{"success false without code", `{"success":false}`, errcode.EResultFail, ""},
+		{"needauth", `{"success":false,"needauth":true}`, errcode.EResultNotLoggedOn, ""},
+		// strError wins over success, its code is parsed from the message
+		{"strError", `{"success":true,"strError":"You have too many listings pending confirmation. (29)"}`,
+			errcode.EResultDuplicateRequest, "You have too many listings pending confirmation. (29)"},
+		{"strError without code", `{"strError":"Something went wrong"}`, errcode.EResultFail, "Something went wrong"},
+		{"error field", `{"success":false,"error":"There was an error"}`, errcode.EResultFail, "There was an error"},
+		{"Error field", `{"success":2,"Error":"Failed"}`, errcode.EResultFail, "Failed"},
+	}
+	for _, tt := range tests {
+		res := &web.Response{Endpoint: "/market/sellitem/", Status: http.StatusOK, Header: http.Header{}, Body: []byte(tt.body)}
+		err := res.Result()
+		if tt.want == errcode.EResultOK {
+			if err != nil {
+				t.Errorf("%s: err = %v", tt.name, err)
+			}
+			continue
+		}
+		e := &errcode.Error{}
+		if !errors.As(err, &e) || e.EResult != tt.want || e.Message != tt.message || e.Endpoint != "/market/sellitem/" {
+			t.Errorf("%s: err = %v, want %s with message %q", tt.name, err, tt.want, tt.message)
+		}
+	}
+}
+
+func TestCheckStatus(t *testing.T) {
+	res := &web.Response{Endpoint: "/market/mylistings", Status: http.StatusOK, Header: http.Header{}}
+	if err := res.CheckStatus(); err != nil {
+		t.Fatal(err)
+	}
+	res.Status = http.StatusTooManyRequests
+	res.Header.Set("X-Error_message", "slow down")
+	err := res.CheckStatus()
+	e := &errcode.Error{}
+	if !errors.As(err, &e) || !errors.Is(err, errcode.ErrRateLimitExceeded) || e.Message != "slow down" || e.Status != 429 {
+		t.Fatalf("err = %v", err)
+	}
+}
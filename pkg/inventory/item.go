@@ -1,37 +1,56 @@
 package inventory
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
 	"net/url"
 	"strconv"
 
 	"github.com/umichan0621/steam/pkg/auth"
 	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/retry"
 )
 
-func AllItems(auth *auth.Core, language, appID, contextID, startAssetID string, count uint64, items *[]InventoryItem) (hasMore bool, lastAssetID string, err error) {
+// The descriptions are in the language of the auth.Core
+func AllItems(auth *auth.Core, appID, contextID, startAssetID string, count uint64, items *[]InventoryItem) (hasMore bool, lastAssetID string, err error) {
+	return AllItemsContext(context.Background(), auth, appID, contextID, startAssetID, count, items)
+}
+
+func AllItemsContext(ctx context.Context, auth *auth.Core, appID, contextID, startAssetID string, count uint64, items *[]InventoryItem) (hasMore bool, lastAssetID string, err error) {
+	type page struct {
+		items       []InventoryItem
+		hasMore     bool
+		lastAssetID string
+	}
+	// Collect every attempt apart, so a failed one leaves no partial page in items
+	res, err := retry.Read(ctx, auth, retry.OpAllItems, func(ctx context.Context) (page, error) {
+		tmp := page{}
+		var err error
+		tmp.hasMore, tmp.lastAssetID, err = allItems(ctx, auth, appID, contextID, startAssetID, count, &tmp.items)
+		return tmp, err
+	})
+	if err != nil {
+		return false, "", err
+	}
+	*items = append(*items, res.items...)
+	return res.hasMore, res.lastAssetID, nil
+}
+
+func allItems(ctx context.Context, auth *auth.Core, appID, contextID, startAssetID string, count uint64, items *[]InventoryItem) (hasMore bool, lastAssetID string, err error) {
 	params := url.Values{
-		"l":     {language},
+		"l":     {auth.Language().String()},
 		"count": {strconv.FormatUint(count, 10)},
 	}
 	if startAssetID != "" {
 		params.Set("start_assetid", startAssetID)
 	}
 
-	url := fmt.Sprintf("http://steamcommunity.com/inventory/%s/%s/%s?%s", auth.SteamID(), appID, contextID, params.Encode())
-	res, err := auth.HttpClient().Get(url)
-	if err != nil {
-		return false, "", err
-	}
-	defer res.Body.Close()
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
-		return false, "", err
-	}
 	resp := Response{}
-	err = json.Unmarshal(data, &resp)
+	_, err = web.JSON(ctx, auth, &web.Request{
+		Path:  fmt.Sprintf("/inventory/%s/%s/%s", auth.SteamID(), appID, contextID),
+		Query: params,
+	}, &resp)
 	if err != nil {
 		return false, "", err
 	}
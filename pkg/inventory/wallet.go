@@ -1,40 +1,54 @@
 package inventory
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/umichan0621/steam/pkg/auth"
 	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/retry"
 )
 
+// The balances are in the wallet currency
 type WalletInfo struct {
-	WalletCurrency       int32   `json:"wallet_currency"`
-	WalletCountry        string  `json:"wallet_country"`
-	WalletBalance        float32 `json:"wallet_balance,string"`
-	WalletDelayedBalance float32 `json:"wallet_delayed_balance,string"`
-	Success              int32   `json:"success"`
+	WalletCurrency       int32
+	WalletCountry        string
+	WalletBalance        common.Money
+	WalletDelayedBalance common.Money
+	Success              int32
+}
+
+// g_rgWalletInfo of the market page, the balances in cents
+type walletJSON struct {
+	WalletCurrency       int32  `json:"wallet_currency"`
+	WalletCountry        string `json:"wallet_country"`
+	WalletBalance        int64  `json:"wallet_balance,string"`
+	WalletDelayedBalance int64  `json:"wallet_delayed_balance,string"`
+	Success              int32  `json:"success"`
 }
 
 func WalletBalance(auth *auth.Core) (*WalletInfo, error) {
-	reqUrl := fmt.Sprintf("%s/market/", common.URI_STEAM_COMMUNITY)
-	res, err := auth.HttpClient().Get(reqUrl)
-	if err != nil {
-		return nil, err
-	}
+	return WalletBalanceContext(context.Background(), auth)
+}
 
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("fail to get wallet balance, code: %d", res.StatusCode)
-	}
-	datax, err := io.ReadAll(res.Body)
+func WalletBalanceContext(ctx context.Context, auth *auth.Core) (*WalletInfo, error) {
+	return retry.Read(ctx, auth, retry.OpWalletBalance, func(ctx context.Context) (*WalletInfo, error) {
+		return walletBalance(ctx, auth)
+	})
+}
+
+func walletBalance(ctx context.Context, auth *auth.Core) (*WalletInfo, error) {
+	data, err := web.Page(ctx, auth, &web.Request{
+		Path:  "/market/",
+		Login: true,
+	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("fail to get wallet balance, %w", err)
 	}
-	data := string(datax)
 	index := strings.Index(data, "g_rgWalletInfo")
 	info := &WalletInfo{}
 	info.Success = 0
@@ -44,13 +58,40 @@ func WalletBalance(auth *auth.Core) (*WalletInfo, error) {
 		end := strings.Index(data, "}")
 		if start >= 0 && end >= 0 {
 			data = data[start : end+1]
-			err := json.Unmarshal([]byte(data), info)
+			wallet := walletJSON{}
+			err := json.Unmarshal([]byte(data), &wallet)
 			if err != nil {
 				return info, fmt.Errorf("fail to parse json: %s, data: %s", err.Error(), data)
 			}
+			currency := common.LookupCurrency(strconv.Itoa(int(wallet.WalletCurrency)))
+			info.WalletCurrency = wallet.WalletCurrency
+			info.WalletCountry = wallet.WalletCountry
+			info.WalletBalance = common.MoneyFromCents(wallet.WalletBalance, currency)
+			info.WalletDelayedBalance = common.MoneyFromCents(wallet.WalletDelayedBalance, currency)
+			info.Success = wallet.Success
+			if wallet.WalletCurrency != 0 && wallet.WalletCountry != "" {
+				auth.SetWalletLocale(currency, wallet.WalletCountry)
+			}
 		}
 	}
-	info.WalletBalance /= 100.0
-	info.WalletDelayedBalance /= 100.0
 	return info, nil
 }
+
+func WalletLocale(auth *auth.Core) (auth.WalletLocale, error) {
+	return WalletLocaleContext(context.Background(), auth)
+}
+
+// The currency and country of the wallet, the market page is read once per auth.Core
+func WalletLocaleContext(ctx context.Context, auth *auth.Core) (wallet auth.WalletLocale, err error) {
+	if wallet, ok := auth.WalletLocale(); ok {
+		return wallet, nil
+	}
+	if _, err := WalletBalanceContext(ctx, auth); err != nil {
+		return wallet, err
+	}
+	wallet, ok := auth.WalletLocale()
+	if !ok {
+		return wallet, fmt.Errorf("fail to get the wallet currency, no wallet_currency or wallet_country on the market page")
+	}
+	return wallet, nil
+}
@@ -0,0 +1,117 @@
+// Package logging is the structured logger of the library, set per account with
+// auth.Core.SetLogger. Adapters route it to log/slog or logrus.
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Field struct {
+	Key   string
+	Value any
+}
+
+type Logger interface {
+	Debug(msg string, fields ...Field)
+	Info(msg string, fields ...Field)
+	Warn(msg string, fields ...Field)
+	Error(msg string, fields ...Field)
+	With(fields ...Field) Logger // a logger adding the fields to every entry
+}
+
+func F(key string, value any) Field { return Field{Key: key, Value: value} }
+
+// The fields shared by every package
+func Account(userName string) Field { return Field{Key: "account", Value: userName} }
+func SteamID(steamID string) Field  { return Field{Key: "steamid", Value: steamID} }
+func Endpoint(path string) Field    { return Field{Key: "endpoint", Value: path} }
+func OfferID(offerID string) Field  { return Field{Key: "offer_id", Value: offerID} }
+func OrderID(orderID uint64) Field {
+	return Field{Key: "order_id", Value: strconv.FormatUint(orderID, 10)}
+}
+func Err(err error) Field { return Field{Key: "error", Value: err} }
+
+type nop struct{}
+
+// Nop discards everything
+func Nop() Logger { return nop{} }
+
+func (nop) Debug(string, ...Field) {}
+func (nop) Info(string, ...Field)  {}
+func (nop) Warn(string, ...Field)  {}
+func (nop) Error(string, ...Field) {}
+func (nop) With(...Field) Logger   { return nop{} }
+
+type slogLogger struct {
+	logger *slog.Logger // nil resolves slog.Default() on every entry
+	args   []any        // fields of With, while logger is nil
+}
+
+// nil means slog.Default() at the time of every entry, so a later slog.SetDefault is followed
+func NewSlog(logger *slog.Logger) Logger {
+	return slogLogger{logger: logger}
+}
+
+func (l slogLogger) Debug(msg string, fields ...Field) { l.log(slog.LevelDebug, msg, fields) }
+func (l slogLogger) Info(msg string, fields ...Field)  { l.log(slog.LevelInfo, msg, fields) }
+func (l slogLogger) Warn(msg string, fields ...Field)  { l.log(slog.LevelWarn, msg, fields) }
+func (l slogLogger) Error(msg string, fields ...Field) { l.log(slog.LevelError, msg, fields) }
+
+func (l slogLogger) With(fields ...Field) Logger {
+	if l.logger == nil {
+		return slogLogger{args: append(append([]any{}, l.args...), slogArgs(fields)...)}
+	}
+	return slogLogger{logger: l.logger.With(slogArgs(fields)...)}
+}
+
+func (l slogLogger) log(level slog.Level, msg string, fields []Field) {
+	logger := l.logger
+	if logger == nil {
+		logger = slog.Default().With(l.args...)
+	}
+	logger.Log(context.Background(), level, msg, slogArgs(fields)...)
+}
+
+func slogArgs(fields []Field) []any {
+	args := make([]any, len(fields))
+	for i, field := range fields {
+		args[i] = slog.Any(field.Key, field.Value)
+	}
+	return args
+}
+
+type logrusLogger struct {
+	logger logrus.FieldLogger
+}
+
+// nil means logrus.StandardLogger()
+func NewLogrus(logger logrus.FieldLogger) Logger {
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+	return logrusLogger{logger: logger}
+}
+
+func (l logrusLogger) Debug(msg string, fields ...Field) { l.entry(fields).Debug(msg) }
+func (l logrusLogger) Info(msg string, fields ...Field)  { l.entry(fields).Info(msg) }
+func (l logrusLogger) Warn(msg string, fields ...Field)  { l.entry(fields).Warn(msg) }
+func (l logrusLogger) Error(msg string, fields ...Field) { l.entry(fields).Error(msg) }
+
+func (l logrusLogger) With(fields ...Field) Logger {
+	return logrusLogger{logger: l.entry(fields)}
+}
+
+func (l logrusLogger) entry(fields []Field) logrus.FieldLogger {
+	if len(fields) == 0 {
+		return l.logger
+	}
+	tmp := logrus.Fields{}
+	for _, field := range fields {
+		tmp[field.Key] = field.Value
+	}
+	return l.logger.WithFields(tmp)
+}
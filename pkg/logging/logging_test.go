@@ -0,0 +1,104 @@
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/umichan0621/steam/pkg/logging"
+)
+
+// The JSON entries written to buf, one per line
+func entries(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	res := []map[string]any{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		entry := map[string]any{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("entry %q: %v", line, err)
+		}
+		res = append(res, entry)
+	}
+	return res
+}
+
+// Log one entry per level through a With logger, then check the fields and levels written
+func checkAdapter(t *testing.T, logger logging.Logger, buf *bytes.Buffer, levelKey, msgKey string, levels []string) {
+	t.Helper()
+	account := logger.With(logging.Account("bot"))
+	account.Debug("debug", logging.OrderID(42))
+	account.Info("info", logging.SteamID("76561197960265728"))
+	account.Warn("warn", logging.Endpoint("/market/priceoverview"), logging.F("attempt", 2))
+	account.Error("error", logging.Err(errors.New("fail to login")), logging.OfferID("7"))
+
+	got := entries(t, buf)
+	if len(got) != 4 {
+		t.Fatalf("%d entries, want 4", len(got))
+	}
+	messages := []string{"debug", "info", "warn", "error"}
+	want := []map[string]any{
+		{"order_id": "42"},
+		{"steamid": "76561197960265728"},
+		{"endpoint": "/market/priceoverview", "attempt": float64(2)},
+		{"error": "fail to login", "offer_id": "7"},
+	}
+	for i, entry := range got {
+		if entry[levelKey] != levels[i] || entry[msgKey] != messages[i] {
+			t.Errorf("entry %d: level %v, msg %v", i, entry[levelKey], entry[msgKey])
+		}
+		if entry["account"] != "bot" {
+			t.Errorf("entry %d: account = %v", i, entry["account"])
+		}
+		for key, value := range want[i] {
+			if entry[key] != value {
+				t.Errorf("entry %d: %s = %v, want %v", i, key, entry[key], value)
+			}
+		}
+	}
+}
+
+func TestSlog(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := logging.NewSlog(slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	checkAdapter(t, logger, buf, "level", "msg", []string{"DEBUG", "INFO", "WARN", "ERROR"})
+}
+
+func TestSlogDefault(t *testing.T) {
+	previous := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	// Built before the default is set, as the Core and the Manager do
+	logger := logging.NewSlog(nil)
+	with := logger.With(logging.Account("bot"))
+	buf := &bytes.Buffer{}
+	slog.SetDefault(slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	checkAdapter(t, logger, buf, "level", "msg", []string{"DEBUG", "INFO", "WARN", "ERROR"})
+
+	buf.Reset()
+	with.Info("login", logging.F("step", 1))
+	got := entries(t, buf)
+	if len(got) != 1 || got[0]["account"] != "bot" || got[0]["step"] != float64(1) || got[0]["msg"] != "login" {
+		t.Fatalf("entries = %v", got)
+	}
+}
+
+func TestLogrus(t *testing.T) {
+	buf := &bytes.Buffer{}
+	base := logrus.New()
+	base.SetOutput(buf)
+	base.SetLevel(logrus.DebugLevel)
+	base.SetFormatter(&logrus.JSONFormatter{})
+	checkAdapter(t, logging.NewLogrus(base), buf, "level", "msg", []string{"debug", "info", "warning", "error"})
+}
+
+func TestNop(t *testing.T) {
+	logger := logging.Nop().With(logging.Account("bot"))
+	logger.Error("discarded", logging.Err(errors.New("fail")))
+	if logger != logging.Nop() {
+		t.Fatal("With of Nop is not Nop")
+	}
+}
@@ -1,16 +1,17 @@
 package market
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
-	"strings"
 
 	"github.com/umichan0621/steam/pkg/auth"
 	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/retry"
 )
 
 // Success while Code == 1
@@ -20,46 +21,64 @@ type BuyOrderResponse struct {
 	OrderID uint64 `json:"buy_orderid,string"`
 }
 
-func CreateBuyOrder(auth *auth.Core, appID string, paymentPrice float64, quantity uint64, currencyID, hashName string) (*BuyOrderResponse, error) {
-	reqUrl := fmt.Sprintf("%s/market/createbuyorder/", common.URI_STEAM_COMMUNITY)
-	reqHeader := http.Header{}
-	referer := strings.ReplaceAll(hashName, " ", "%20")
-	referer = strings.ReplaceAll(referer, "#", "%23")
-	referer = fmt.Sprintf("%s/market/listings/%s/%s", common.URI_STEAM_COMMUNITY, appID, referer)
-	reqHeader.Add("Referer", referer)
-	reqHeader.Add("Content-Type", "application/x-www-form-urlencoded")
-	reqBody := url.Values{
-		"appid":            {appID},
-		"currency":         {currencyID},
-		"market_hash_name": {hashName},
-		"price_total":      {strconv.FormatUint(uint64(paymentPrice*100), 10)},
-		"quantity":         {strconv.FormatUint(quantity, 10)},
-		"sessionid":        {auth.SessionID()},
-	}
+func CreateBuyOrder(auth *auth.Core, appID string, priceTotal common.Money, quantity uint64, hashName string) (*BuyOrderResponse, error) {
+	return CreateBuyOrderContext(context.Background(), auth, appID, priceTotal, quantity, hashName)
+}
 
-	req, err := http.NewRequest(http.MethodPost, reqUrl, strings.NewReader(reqBody.Encode()))
+// priceTotal pays for the whole quantity, in the wallet currency of the account.
+// Refused with a *policy.Violation by the policy of auth, if any
+func CreateBuyOrderContext(ctx context.Context, auth *auth.Core, appID string, priceTotal common.Money, quantity uint64, hashName string) (*BuyOrderResponse, error) {
+	params := map[string]any{
+		"appid": appID, "price": priceTotal, "quantity": quantity, "hash_name": hashName,
+	}
+	release, err := checkBuyPolicy(ctx, auth, appID, priceTotal, quantity, hashName)
 	if err != nil {
+		auth.Audit(ctx, retry.OpCreateBuyOrder.Name, params, nil, err)
+		auth.Logger().Warn("buy order refused", logging.F("hash_name", hashName), logging.Err(err))
 		return nil, err
 	}
-
-	req.Header = reqHeader
-
-	res, err := auth.HttpClient().Do(req)
+	if sim := auth.DryRun(); sim != nil {
+		response, err := simulatedBuyOrder(auth, sim, appID, priceTotal, quantity, hashName)
+		auth.Audit(ctx, retry.OpCreateBuyOrder.Name, params, response, err)
+		if err != nil {
+			release()
+		}
+		return response, err
+	}
+	// An order already open for the item is not mistaken for the one of a lost response
+	before, err := buyOrderIDs(ctx, auth, appID, hashName)
 	if err != nil {
-		return nil, err
+		auth.Logger().Warn("fail to list the buy orders before creating one", logging.F("hash_name", hashName), logging.Err(err))
 	}
-	defer res.Body.Close()
-
-	data, err := io.ReadAll(res.Body)
+	response, err := retry.Write(ctx, auth, retry.OpCreateBuyOrder, func(ctx context.Context) (*BuyOrderResponse, error) {
+		return createBuyOrder(ctx, auth, appID, priceTotal, quantity, hashName)
+	}, reconcileBuyOrder(auth, appID, hashName, before))
+	auth.Audit(ctx, retry.OpCreateBuyOrder.Name, params, response, err)
 	if err != nil {
+		release()
+		auth.Logger().Warn("fail to create buy order", logging.F("hash_name", hashName), logging.Err(err))
 		return nil, err
 	}
+	auth.Logger().Info("buy order created", logging.OrderID(response.OrderID), logging.F("hash_name", hashName),
+		logging.F("price", priceTotal.String()), logging.F("quantity", quantity))
+	return response, nil
+}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http error: %d, %s", res.StatusCode, string(data))
-	}
+func createBuyOrder(ctx context.Context, auth *auth.Core, appID string, priceTotal common.Money, quantity uint64, hashName string) (*BuyOrderResponse, error) {
 	response := &BuyOrderResponse{}
-	err = json.Unmarshal(data, response)
+	_, err := web.JSON(ctx, auth, &web.Request{
+		Method: http.MethodPost,
+		Path:   "/market/createbuyorder/",
+		Form: url.Values{
+			"appid":            {appID},
+			"currency":         {priceTotal.Currency.IDString()},
+			"market_hash_name": {hashName},
+			"price_total":      {strconv.FormatInt(priceTotal.Cents(), 10)},
+			"quantity":         {strconv.FormatUint(quantity, 10)},
+		},
+		Referer: fmt.Sprintf("/market/listings/%s/%s", appID, url.PathEscape(hashName)),
+		Login:   true,
+	}, response)
 	if err != nil {
 		return nil, err
 	}
@@ -67,32 +86,39 @@ func CreateBuyOrder(auth *auth.Core, appID string, paymentPrice float64, quantit
 }
 
 func CancelBuyOrder(auth *auth.Core, orderID uint64) error {
-	reqUrl := fmt.Sprintf("%s/market/cancelbuyorder/", common.URI_STEAM_COMMUNITY)
-	reqHeader := http.Header{}
-	reqHeader.Add("Referer", fmt.Sprintf("%s/market", common.URI_STEAM_COMMUNITY))
-	reqHeader.Add("Content-Type", "application/x-www-form-urlencoded")
-	reqBody := url.Values{
-		"sessionid":   {auth.SessionID()},
-		"buy_orderid": {strconv.FormatUint(orderID, 10)},
-	}
+	return CancelBuyOrderContext(context.Background(), auth, orderID)
+}
 
-	req, err := http.NewRequest(http.MethodPost, reqUrl, strings.NewReader(reqBody.Encode()))
-	if err != nil {
+func CancelBuyOrderContext(ctx context.Context, auth *auth.Core, orderID uint64) error {
+	if sim := auth.DryRun(); sim != nil {
+		err := simulatedCancelBuyOrder(auth, sim, orderID)
+		auth.Audit(ctx, retry.OpCancelBuyOrder.Name, map[string]any{"order_id": orderID}, nil, err)
 		return err
 	}
-
-	req.Header = reqHeader
-
-	res, err := auth.HttpClient().Do(req)
-	if res != nil {
-		res.Body.Close()
-	}
+	_, err := retry.Write(ctx, auth, retry.OpCancelBuyOrder, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, cancelBuyOrder(ctx, auth, orderID)
+	}, reconcileCancelBuyOrder(auth, orderID))
+	auth.Audit(ctx, retry.OpCancelBuyOrder.Name, map[string]any{"order_id": orderID}, nil, err)
 	if err != nil {
+		auth.Logger().Warn("fail to cancel buy order", logging.OrderID(orderID), logging.Err(err))
 		return err
 	}
+	auth.Logger().Info("buy order canceled", logging.OrderID(orderID))
+	return nil
+}
 
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("cannot cancel %d: %d", orderID, res.StatusCode)
+func cancelBuyOrder(ctx context.Context, auth *auth.Core, orderID uint64) error {
+	_, err := web.JSON(ctx, auth, &web.Request{
+		Method: http.MethodPost,
+		Path:   "/market/cancelbuyorder/",
+		Form: url.Values{
+			"buy_orderid": {strconv.FormatUint(orderID, 10)},
+		},
+		Referer: "/market",
+		Login:   true,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("cannot cancel %d, %w", orderID, err)
 	}
 	return nil
 }
@@ -6,26 +6,27 @@ import (
 	"github.com/umichan0621/steam/pkg/common"
 )
 
+// The currency and country the market is read in, set them to the wallet of the account
 type Core struct {
-	language string
-	currency string
+	currency common.Currency
 	country  string
 }
 
 func (core *Core) Init() {
-	core.language = "english"
-	core.currency = common.CurrencyUSD
+	core.currency = common.LookupCurrency(common.CurrencyUSD)
 	core.country = "CN"
 }
 
-func (core *Core) SetLanguage(language string) { core.language = language }
+func (core *Core) Currency() common.Currency { return core.currency }
 
-func (core *Core) SetCurrency(currency string) { core.currency = currency }
+func (core *Core) Country() string { return core.country }
+
+func (core *Core) SetCurrency(currency common.Currency) { core.currency = currency }
 
 func (core *Core) SetCountry(country string) { core.country = country }
 
-func ReceivedPrice(payment float64) float64 {
-	received := payment / 1.15
-	received = math.Round(received*100.0) / 100.0
-	return received
+// What the seller gets of a payment, the fees of about 15% removed
+func ReceivedPrice(payment common.Money) common.Money {
+	received := int64(math.Round(float64(payment.Cents()) / 1.15))
+	return common.MoneyFromCents(received, payment.Currency)
 }
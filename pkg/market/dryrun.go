@@ -0,0 +1,134 @@
+package market
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/dryrun"
+	"github.com/umichan0621/steam/pkg/logging"
+)
+
+func simulatedBuyOrder(auth *auth.Core, sim *dryrun.Simulator, appID string, priceTotal common.Money, quantity uint64, hashName string) (*BuyOrderResponse, error) {
+	orderID, err := sim.CreateBuyOrder(appID, hashName, priceTotal.Currency.IDString(), priceTotal.Cents(), quantity)
+	if err != nil {
+		auth.Logger().Warn("fail to create simulated buy order", logging.F("hash_name", hashName), logging.Err(err))
+		return nil, err
+	}
+	auth.Logger().Info("simulated buy order created", logging.OrderID(orderID), logging.F("hash_name", hashName),
+		logging.F("price", priceTotal.String()), logging.F("quantity", quantity))
+	return &BuyOrderResponse{Code: 1, OrderID: orderID}, nil
+}
+
+func simulatedCancelBuyOrder(auth *auth.Core, sim *dryrun.Simulator, orderID uint64) error {
+	if err := sim.CancelBuyOrder(orderID); err != nil {
+		auth.Logger().Warn("fail to cancel simulated buy order", logging.OrderID(orderID), logging.Err(err))
+		return fmt.Errorf("cannot cancel %d, %w", orderID, err)
+	}
+	auth.Logger().Info("simulated buy order canceled", logging.OrderID(orderID))
+	return nil
+}
+
+// Like steam the listing waits for its mobile confirmation, answer it with confirm.AnswerConfirmation
+func simulatedSellOrder(auth *auth.Core, sim *dryrun.Simulator, appID, contextID, assetID string, amount uint64, receivedPrice common.Money) (*MarketSellResponse, error) {
+	confirmation, err := sim.CreateSellOrder(appID, contextID, assetID, amount, receivedPrice.Cents())
+	if err != nil {
+		auth.Logger().Warn("fail to create simulated sell order", logging.F("asset_id", assetID), logging.Err(err))
+		return nil, err
+	}
+	auth.Logger().Info("simulated sell order created", logging.F("asset_id", assetID), logging.F("received_price", receivedPrice.String()),
+		logging.F("confirmation_id", confirmation.ID))
+	return &MarketSellResponse{Success: true, RequiresConfirmation: 1, MobileConfirmationRequired: true}, nil
+}
+
+func (core *Core) SimulateFills(auth *auth.Core) ([]dryrun.Fill, error) {
+	return core.SimulateFillsContext(context.Background(), auth)
+}
+
+// Fill the simulated orders of auth from the live order graphs: a buy order takes the sell orders
+// at or below its price, a confirmed listing is sold once the buy orders cover it.
+// The graphs are fetched in the country and currency of the Core and the language of auth
+func (core *Core) SimulateFillsContext(ctx context.Context, auth *auth.Core) ([]dryrun.Fill, error) {
+	sim := auth.DryRun()
+	if sim == nil {
+		return nil, fmt.Errorf("dry run is disabled, account: %s", auth.UserName())
+	}
+	fills := []dryrun.Fill{}
+	for _, order := range sim.BuyOrders() {
+		graph, err := core.orderGraph(ctx, auth, order.AppID, order.HashName)
+		if err != nil {
+			return fills, err
+		}
+		if len(graph.SellOrderGraph) == 0 {
+			continue
+		}
+		// The quantity of each level is cumulative
+		available := uint64(0)
+		for _, level := range graph.SellOrderGraph {
+			if level.Price.Cents() <= order.Price {
+				available = max(available, uint64(level.Quantity))
+			}
+		}
+		if available == 0 {
+			continue
+		}
+		appID, _ := strconv.ParseUint(order.AppID, 10, 32)
+		fill, err := sim.FillBuyOrder(order.ID, available, graph.SellOrderGraph[0].Price.Cents(), uint32(appID), marketContextID(order.AppID))
+		if err != nil {
+			continue // canceled meanwhile
+		}
+		auth.Logger().Info("simulated buy order filled", logging.OrderID(order.ID), logging.F("hash_name", order.HashName),
+			logging.F("quantity", fill.Quantity), logging.F("price", fill.Price))
+		fills = append(fills, fill)
+	}
+	for _, listing := range sim.Listings() {
+		if !listing.Confirmed {
+			continue
+		}
+		graph, err := core.orderGraph(ctx, auth, strconv.FormatUint(uint64(listing.Item.AppID), 10), listing.Item.HashName)
+		if err != nil {
+			return fills, err
+		}
+		payment := int64(math.Round(float64(listing.ReceivedPrice) * 1.15))
+		covered := uint64(0)
+		for _, level := range graph.BuyOrderGraph {
+			if level.Price.Cents() >= payment {
+				covered = max(covered, uint64(level.Quantity))
+			}
+		}
+		if covered < listing.Item.Amount {
+			continue
+		}
+		fill, err := sim.FillListing(listing.ID)
+		if err != nil {
+			continue
+		}
+		auth.Logger().Info("simulated listing sold", logging.F("listing_id", listing.ID), logging.F("hash_name", listing.Item.HashName),
+			logging.F("quantity", fill.Quantity), logging.F("received_price", fill.Price))
+		fills = append(fills, fill)
+	}
+	return fills, nil
+}
+
+func (core *Core) orderGraph(ctx context.Context, auth *auth.Core, appID, hashName string) (*OrderGraph, error) {
+	nameID, err := ItemNameIDContext(ctx, auth, appID, hashName)
+	if err != nil {
+		return nil, fmt.Errorf("fail to simulate the fills of %s, %w", hashName, err)
+	}
+	graph, err := ItemOrderGraphContext(ctx, auth, core.country, core.currency, appID, nameID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to simulate the fills of %s, %w", hashName, err)
+	}
+	return graph, nil
+}
+
+// Context of the items bought on the market, 6 for the steam community items
+func marketContextID(appID string) uint64 {
+	if appID == "753" {
+		return 6
+	}
+	return 2
+}
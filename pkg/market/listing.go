@@ -0,0 +1,156 @@
+package market
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/retry"
+)
+
+type MyListingAsset struct {
+	AppID          uint32 `json:"appid"`
+	ContextID      string `json:"contextid"`
+	AssetID        string `json:"id"`
+	Amount         string `json:"amount"`
+	MarketHashName string `json:"market_hash_name"`
+}
+
+type MyListing struct {
+	ListingID string         `json:"listingid"`
+	Price     int64          `json:"price"` // received by the seller, in cents
+	Fee       int64          `json:"fee"`   // in cents
+	Status    int            `json:"status"`
+	Created   int64          `json:"time_created"`
+	Asset     MyListingAsset `json:"asset"`
+}
+
+type MyBuyOrder struct {
+	OrderID           uint64 `json:"buy_orderid,string"`
+	AppID             uint32 `json:"appid"`
+	HashName          string `json:"hash_name"`
+	Currency          int    `json:"wallet_currency"`
+	Price             int64  `json:"price,string"` // per item, in cents
+	Quantity          uint64 `json:"quantity,string"`
+	QuantityRemaining uint64 `json:"quantity_remaining,string"`
+}
+
+type MyListingsResponse struct {
+	Success           bool          `json:"success"`
+	ActiveCount       int           `json:"num_active_listings"`
+	Listings          []*MyListing  `json:"listings"`
+	ListingsOnHold    []*MyListing  `json:"listings_on_hold"`
+	ListingsToConfirm []*MyListing  `json:"listings_to_confirm"`
+	BuyOrders         []*MyBuyOrder `json:"buy_orders"` // every active buy order, whatever the page
+	TotalCount        int           `json:"total_count"`
+}
+
+// Active listings and buy orders of the account, the listings are paged by start and count (max 100)
+func MyListings(auth *auth.Core, start, count uint64) (*MyListingsResponse, error) {
+	return MyListingsContext(context.Background(), auth, start, count)
+}
+
+func MyListingsContext(ctx context.Context, auth *auth.Core, start, count uint64) (*MyListingsResponse, error) {
+	return retry.Read(ctx, auth, retry.OpMyListings, func(ctx context.Context) (*MyListingsResponse, error) {
+		return myListings(ctx, auth, start, count)
+	})
+}
+
+func myListings(ctx context.Context, auth *auth.Core, start, count uint64) (*MyListingsResponse, error) {
+	response := &MyListingsResponse{}
+	_, err := web.JSON(ctx, auth, &web.Request{
+		Path: "/market/mylistings",
+		Query: url.Values{
+			"norender": {"1"},
+			"start":    {strconv.FormatUint(start, 10)},
+			"count":    {strconv.FormatUint(count, 10)},
+		},
+		Login: true,
+	}, response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// The IDs of the buy orders open for the item
+func buyOrderIDs(ctx context.Context, auth *auth.Core, appID, hashName string) (map[uint64]bool, error) {
+	listings, err := MyListingsContext(ctx, auth, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	ids := map[uint64]bool{}
+	for _, order := range listings.BuyOrders {
+		if strconv.FormatUint(uint64(order.AppID), 10) == appID && order.HashName == hashName {
+			ids[order.OrderID] = true
+		}
+	}
+	return ids, nil
+}
+
+// before holds the orders open for the item before the first attempt, only a new one is ours;
+// nil while they are not known, the outcome is then unknown
+func reconcileBuyOrder(auth *auth.Core, appID, hashName string, before map[uint64]bool) retry.Reconcile[*BuyOrderResponse] {
+	return func(ctx context.Context) (*BuyOrderResponse, retry.Outcome, error) {
+		if before == nil {
+			return nil, retry.Unknown, nil
+		}
+		ids, err := buyOrderIDs(ctx, auth, appID, hashName)
+		if err != nil {
+			return nil, retry.Unknown, err
+		}
+		for id := range ids {
+			if !before[id] {
+				return &BuyOrderResponse{Code: 1, OrderID: id}, retry.Applied, nil
+			}
+		}
+		return nil, retry.NotApplied, nil
+	}
+}
+
+func reconcileCancelBuyOrder(auth *auth.Core, orderID uint64) retry.Reconcile[struct{}] {
+	return func(ctx context.Context) (struct{}, retry.Outcome, error) {
+		listings, err := MyListingsContext(ctx, auth, 0, 1)
+		if err != nil {
+			return struct{}{}, retry.Unknown, err
+		}
+		for _, order := range listings.BuyOrders {
+			if order.OrderID == orderID {
+				return struct{}{}, retry.NotApplied, nil
+			}
+		}
+		return struct{}{}, retry.Applied, nil
+	}
+}
+
+// Look for the asset in every page of the listings, the pending ones included
+func reconcileSellOrder(auth *auth.Core, appID, assetID string) retry.Reconcile[*MarketSellResponse] {
+	return func(ctx context.Context) (*MarketSellResponse, retry.Outcome, error) {
+		const pageSize = 100
+		for start := 0; ; start += pageSize {
+			listings, err := MyListingsContext(ctx, auth, uint64(start), pageSize)
+			if err != nil {
+				return nil, retry.Unknown, err
+			}
+			lists := [][]*MyListing{listings.ListingsToConfirm, listings.Listings, listings.ListingsOnHold}
+			for i, list := range lists {
+				for _, listing := range list {
+					if listing.Asset.AssetID != assetID || strconv.FormatUint(uint64(listing.Asset.AppID), 10) != appID {
+						continue
+					}
+					response := &MarketSellResponse{Success: true}
+					if i == 0 {
+						response.RequiresConfirmation = 1
+						response.MobileConfirmationRequired = true
+					}
+					return response, retry.Applied, nil
+				}
+			}
+			if start+pageSize >= listings.TotalCount {
+				return nil, retry.NotApplied, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package market_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/market"
+	"github.com/umichan0621/steam/pkg/steamtest"
+)
+
+var usd, _ = common.CurrencyByCode("USD")
+
+// A logged in account with 100.00 in its wallet and a case on the market
+func newBot(t *testing.T) (*steamtest.Server, *auth.Core, uint64) {
+	t.Helper()
+	server, core, steamID := steamtest.NewLoggedIn(t, steamtest.Account{Wallet: 10000})
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Case", LowestPrice: 100, MedianPrice: 95, Volume: 10})
+	return server, core, steamID
+}
+
+// Lose the response of the next request to path after steam has handled it,
+// like a connection reset on the way back
+type dropResponse struct {
+	mu        sync.Mutex
+	path      string
+	remaining int
+}
+
+func (d *dropResponse) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.remaining > 0 && strings.HasPrefix(req.URL.Path, d.path) {
+		d.remaining--
+		io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+		return nil, io.ErrUnexpectedEOF
+	}
+	return res, nil
+}
+
+func TestCreateBuyOrderReconcile(t *testing.T) {
+	server, core, steamID := newBot(t)
+	core.SetTransport(&dropResponse{path: "/market/createbuyorder/", remaining: 1})
+
+	response, err := market.CreateBuyOrder(core, "730", common.MoneyFromCents(80, usd), 1, "Case")
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	orders := server.BuyOrders(steamID)
+	if len(orders) != 1 {
+		t.Fatalf("%d buy orders, want the lost one only", len(orders))
+	}
+	if response.OrderID != orders[0].ID {
+		t.Fatalf("order id = %d, want %d", response.OrderID, orders[0].ID)
+	}
+}
+
+func TestCancelBuyOrderReconcile(t *testing.T) {
+	server, core, steamID := newBot(t)
+	response, err := market.CreateBuyOrder(core, "730", common.MoneyFromCents(80, usd), 1, "Case")
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	core.SetTransport(&dropResponse{path: "/market/cancelbuyorder/", remaining: 1})
+	if err := market.CancelBuyOrder(core, response.OrderID); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	if orders := server.BuyOrders(steamID); len(orders) != 0 {
+		t.Fatalf("buy orders = %+v", orders)
+	}
+}
+
+func TestCreateBuyOrderReconcileOpenOrder(t *testing.T) {
+	server, core, steamID := newBot(t)
+	open, err := market.CreateBuyOrder(core, "730", common.MoneyFromCents(80, usd), 1, "Case")
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	// Steam refuses the second order but the answer is lost, the open one is not ours
+	core.SetTransport(&dropResponse{path: "/market/createbuyorder/", remaining: 1})
+	response, err := market.CreateBuyOrder(core, "730", common.MoneyFromCents(90, usd), 1, "Case")
+	if err == nil {
+		t.Fatalf("order %d taken for the lost one, the open order is %d", response.OrderID, open.OrderID)
+	}
+	if orders := server.BuyOrders(steamID); len(orders) != 1 || orders[0].ID != open.OrderID {
+		t.Fatalf("buy orders = %+v", orders)
+	}
+}
+
+// Rewrite the bodies of path, like the pages of another steam locale
+type rewriteBody struct {
+	path     string
+	old, new string
+}
+
+func (r *rewriteBody) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil || !strings.HasPrefix(req.URL.Path, r.path) {
+		return res, err
+	}
+	data, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	data = []byte(strings.ReplaceAll(string(data), r.old, r.new))
+	res.Body = io.NopCloser(strings.NewReader(string(data)))
+	res.ContentLength = int64(len(data))
+	res.Header.Del("Content-Length")
+	return res, nil
+}
+
+func TestHistoryOrderWalletCurrency(t *testing.T) {
+	cny, _ := common.CurrencyByCode("CNY")
+	server, core, steamID := steamtest.NewLoggedIn(t, steamtest.Account{Currency: cny.ID, Country: "CN"})
+	server.AddHistory(steamID, steamtest.HistoryEntry{Item: &steamtest.Item{AppID: 730, ContextID: 2, ClassID: 1, Name: "Case", MarketHashName: "Case"},
+		Price: 4500, Purchased: true})
+	// "¥ 45" alone reads as JPY, the wallet tells it is yuan
+	core.SetTransport(&rewriteBody{path: "/market/myhistory", old: "¥ 45.00", new: "¥ 45"})
+	history, err := market.HistoryOrder(core, "730", "2", 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("%d history rows, want 1", len(history))
+	}
+	if history[0].Price != common.MoneyFromCents(4500, cny) {
+		t.Fatalf("price = %+v, want 45 CNY", history[0].Price)
+	}
+}
+
+// The history and the order graph are read in the currency of the market Core
+func TestMarketCurrency(t *testing.T) {
+	server, core, _ := steamtest.NewLoggedIn(t, steamtest.Account{})
+	jpy, _ := common.CurrencyByCode("JPY")
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Case", LowestPrice: 100, MedianPrice: 95, Volume: 10,
+		BuyOrders:    []steamtest.OrderLevel{{Price: 4500, Quantity: 1}},
+		PriceHistory: []steamtest.PricePoint{{Time: steamtest.TestTime.AddDate(0, 0, -1), Price: 45.4, Volume: 3}}})
+	marketCore := &market.Core{}
+	marketCore.Init()
+	marketCore.SetCurrency(jpy)
+
+	history, err := marketCore.PriceHistory(core, "730", "Case", 30)
+	if err != nil || len(history) != 1 {
+		t.Fatalf("history = %+v, %v", history, err)
+	}
+	if history[0].Price != common.NewMoney(45, jpy) {
+		t.Fatalf("price = %+v, want 45 JPY", history[0].Price)
+	}
+	nameID, err := market.ItemNameID(core, "730", "Case")
+	if err != nil {
+		t.Fatal(err)
+	}
+	graph, err := market.ItemOrderGraph(core, marketCore.Country(), marketCore.Currency(), "730", nameID)
+	if err != nil || len(graph.BuyOrderGraph) != 1 || graph.BuyOrderGraph[0].Price != common.NewMoney(45, jpy) {
+		t.Fatalf("graph = %+v, %v", graph, err)
+	}
+}
+
+// A history entry without a readable time fails the call instead of being dated now
+func TestPriceHistoryMalformed(t *testing.T) {
+	server, core, _ := steamtest.NewLoggedIn(t, steamtest.Account{})
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Case", LowestPrice: 100, MedianPrice: 95, Volume: 10})
+	marketCore := &market.Core{}
+	marketCore.Init()
+	for _, body := range []string{
+		`{"success":true,"prices":[["Foo 01 2024 13: +0",1.5,"3"]]}`,
+		`{"success":true,"prices":[["Mar 01 2024 13: +0",1.5]]}`,
+	} {
+		server.Fail("/market/pricehistory", steamtest.Failure{Status: 200, Body: body, Times: 1})
+		if history, err := marketCore.PriceHistory(core, "730", "Case", 30); err == nil || !strings.Contains(err.Error(), "fail to parse") {
+			t.Fatalf("history = %+v, %v from %s", history, err, body)
+		}
+	}
+}
+
+// Count the requests of path, failing them while fail is set
+type countPath struct {
+	mu    sync.Mutex
+	path  string
+	fail  bool
+	count int
+}
+
+func (c *countPath) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	match, fail := req.URL.Path == c.path, c.fail
+	if match {
+		c.count++
+	}
+	c.mu.Unlock()
+	if match && fail {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestHistoryOrderWalletOnce(t *testing.T) {
+	server, core, steamID := steamtest.NewLoggedIn(t, steamtest.Account{})
+	server.AddHistory(steamID, steamtest.HistoryEntry{Item: &steamtest.Item{AppID: 730, ContextID: 2, ClassID: 1, Name: "Case", MarketHashName: "Case"},
+		Price: 123, Purchased: true})
+
+	// The history is still read without the wallet, in the currency of its symbols
+	page := &countPath{path: "/market/", fail: true}
+	core.SetTransport(page)
+	history, err := market.HistoryOrder(core, "730", "2", 0, 10)
+	if err != nil || len(history) != 1 || history[0].Price != common.MoneyFromCents(123, usd) {
+		t.Fatalf("history = %+v, %v", history, err)
+	}
+
+	// then the market page is read by the first call only
+	page.mu.Lock()
+	page.fail, page.count = false, 0
+	page.mu.Unlock()
+	for i := 0; i < 3; i++ {
+		if _, err := market.HistoryOrder(core, "730", "2", 0, 10); err != nil {
+			t.Fatal(err)
+		}
+	}
+	page.mu.Lock()
+	defer page.mu.Unlock()
+	if page.count != 1 {
+		t.Fatalf("market page read %d times for 3 pages of history", page.count)
+	}
+}
@@ -1,17 +1,22 @@
 package market
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/tidwall/gjson"
 	"github.com/umichan0621/steam/pkg/auth"
 	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/inventory"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/retry"
+	"github.com/umichan0621/steam/pkg/utils"
 	"golang.org/x/net/html"
 )
 
@@ -21,37 +26,46 @@ type SteamOrder struct {
 	MarketHashName string `json:"market_hash_name"`
 	Commodity      uint64 `json:"commodity"`
 	Seq            uint64
-	Price          float64
+	Price          common.Money // zero while the row shows no price
 	DateString     string
+	Date           time.Time // day of DateString, zero while it fails to parse
 }
 
-func HistoryOrder(auth *auth.Core, language, appID, contextID string, start, count uint64) ([]*SteamOrder, error) {
-	params := url.Values{
-		"l":     {language},
-		"start": {strconv.FormatUint(start, 10)},
-		"count": {strconv.FormatUint(count, 10)},
-	}
-	reqUrl := fmt.Sprintf("%s/market/myhistory?%s", common.URI_STEAM_COMMUNITY, params.Encode())
-	res, err := auth.HttpClient().Get(reqUrl)
+// The rows are read in the language of the auth.Core, their prices in its wallet currency;
+// the market page is read for it by the first call of the Core only. While the wallet is
+// unknown the currency is told by the symbols of the prices
+func HistoryOrder(auth *auth.Core, appID, contextID string, start, count uint64) ([]*SteamOrder, error) {
+	return HistoryOrderContext(context.Background(), auth, appID, contextID, start, count)
+}
+
+func HistoryOrderContext(ctx context.Context, auth *auth.Core, appID, contextID string, start, count uint64) ([]*SteamOrder, error) {
+	wallet, err := inventory.WalletLocaleContext(ctx, auth)
 	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("fail to get market history, code: %d", res.StatusCode)
+		auth.Logger().Warn("fail to get the wallet currency of the market history, reading it from the prices", logging.Err(err))
 	}
+	currency := wallet.Currency
+	return retry.Read(ctx, auth, retry.OpHistoryOrder, func(ctx context.Context) ([]*SteamOrder, error) {
+		return historyOrder(ctx, auth, currency, appID, contextID, start, count)
+	})
+}
 
-	data, err := io.ReadAll(res.Body)
+func historyOrder(ctx context.Context, auth *auth.Core, currency common.Currency, appID, contextID string, start, count uint64) ([]*SteamOrder, error) {
+	language := auth.Language()
+	res, err := web.JSON(ctx, auth, &web.Request{
+		Path: "/market/myhistory",
+		Query: url.Values{
+			"l":     {language.String()},
+			"start": {strconv.FormatUint(start, 10)},
+			"count": {strconv.FormatUint(count, 10)},
+		},
+		Login: true,
+	}, nil)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("fail to get market history, %w", err)
 	}
 
-	jsonData := string(data)
-	success := gjson.Get(jsonData, "success").Bool()
+	jsonData := string(res.Body)
 	totalCount := gjson.Get(jsonData, "total_count").Uint()
-	if !success {
-		return nil, fmt.Errorf("fail to get market history")
-	}
 	assets := gjson.Get(jsonData, "assets")
 	assetsList := assets.Get(appID).Get(contextID)
 
@@ -71,11 +85,11 @@ func HistoryOrder(auth *auth.Core, language, appID, contextID string, start, cou
 	htmlNode, _ := html.Parse(strings.NewReader(htmlData))
 
 	historyRow2AssetID := generateHistoryRow2AssetIDMap(hoversData)
-	historyRow2Price := map[string]float64{}
+	historyRow2Price := map[string]common.Money{}
 	historyRow2DateString := map[string]string{}
-	assetID2Price := map[string]float64{}
+	assetID2Price := map[string]common.Money{}
 	assetID2DateString := map[string]string{}
-	generateHistoryRow2PriceMap(htmlNode, &historyRow2Price, "empty")
+	generateHistoryRow2PriceMap(htmlNode, currency, &historyRow2Price, "empty")
 	generateHistoryRow2DateStringMap(htmlNode, &historyRow2DateString, "empty")
 	for historyRow, assetID := range historyRow2AssetID {
 		price, ok := historyRow2Price[historyRow]
@@ -96,6 +110,7 @@ func HistoryOrder(auth *auth.Core, language, appID, contextID string, start, cou
 		dateString, ok := assetID2DateString[assetID]
 		if ok {
 			order.DateString = dateString
+			order.Date, _ = utils.ParseSteamDate(dateString, language, auth.Clock().Now())
 		}
 		order.Seq = totalCount - start - uint64(i)
 	}
@@ -125,7 +140,7 @@ func generateHistoryRow2AssetIDMap(hovers string) map[string]string {
 	return res
 }
 
-func generateHistoryRow2PriceMap(n *html.Node, priceMap *map[string]float64, historyRow string) {
+func generateHistoryRow2PriceMap(n *html.Node, currency common.Currency, priceMap *map[string]common.Money, historyRow string) {
 	if n.Type == html.ElementNode {
 		class := ""
 		id := ""
@@ -140,22 +155,21 @@ func generateHistoryRow2PriceMap(n *html.Node, priceMap *map[string]float64, his
 			case "market_listing_row market_recent_listing_row":
 				historyRow = id
 			case "market_listing_price":
-				priceStr := strings.ReplaceAll(n.FirstChild.Data, "\t", "")
-				index := strings.Index(priceStr, " ")
-				if index >= 0 {
-					priceStr = priceStr[index+1:]
-					price, err := strconv.ParseFloat(priceStr, 64)
-					if err == nil {
-						(*priceMap)[historyRow] = price
-					} else {
-						(*priceMap)[historyRow] = -0.1
-					}
+				if n.FirstChild == nil {
+					break
+				}
+				parse := common.ParsePrice
+				if currency.ID != 0 {
+					parse = func(text string) (common.Money, error) { return common.ParsePriceIn(text, currency) }
+				}
+				if price, err := parse(n.FirstChild.Data); err == nil {
+					(*priceMap)[historyRow] = price
 				}
 			}
 		}
 	}
 	for c := n.FirstChild; c != nil; c = c.NextSibling {
-		generateHistoryRow2PriceMap(c, priceMap, historyRow)
+		generateHistoryRow2PriceMap(c, currency, priceMap, historyRow)
 	}
 }
 
@@ -0,0 +1,127 @@
+package market
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/inventory"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/policy"
+)
+
+// Check a buy order against the policy of the Core, release gives back the reserved spend
+// while the order is not placed
+func checkBuyPolicy(ctx context.Context, auth *auth.Core, appID string, priceTotal common.Money, quantity uint64, hashName string) (release func(), err error) {
+	engine := auth.Policy()
+	if engine == nil {
+		return func() {}, nil
+	}
+	order := policy.BuyOrder{
+		HashName:   hashName,
+		Currency:   priceTotal.Currency,
+		PriceTotal: priceTotal.Cents(),
+		Quantity:   quantity,
+		OpenOrders: -1,
+	}
+	overridden := engine.Overridden(ctx)
+	if overridden {
+		auth.Logger().Warn("policy overridden for a buy order", logging.F("hash_name", hashName), logging.F("price", priceTotal.String()))
+	} else {
+		if err := engine.CheckItem(ctx, auth.UserName(), hashName); err != nil {
+			return nil, err
+		}
+		if engine.NeedsMedian() {
+			if order.Median, err = medianPrice(ctx, auth, appID, priceTotal.Currency, hashName); err != nil {
+				return nil, err
+			}
+		}
+		if engine.NeedsOpenOrders() {
+			if order.OpenOrders, err = openOrders(ctx, auth); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return engine.CheckBuy(ctx, auth.UserName(), order)
+}
+
+func checkSellPolicy(ctx context.Context, auth *auth.Core, appID, contextID, assetID string, receivedPrice common.Money) error {
+	engine := auth.Policy()
+	if engine == nil {
+		return nil
+	}
+	if engine.Overridden(ctx) {
+		auth.Logger().Warn("policy overridden for a sell order", logging.F("asset_id", assetID), logging.F("received_price", receivedPrice.String()))
+		return nil
+	}
+	order := policy.SellOrder{Currency: receivedPrice.Currency, ReceivedPrice: receivedPrice.Cents(), OpenOrders: -1}
+	var err error
+	if engine.NeedsItemNames() {
+		if order.HashName, err = assetHashName(ctx, auth, appID, contextID, assetID); err != nil {
+			return err
+		}
+	}
+	if engine.NeedsOpenOrders() {
+		if order.OpenOrders, err = openOrders(ctx, auth); err != nil {
+			return err
+		}
+	}
+	return engine.CheckSell(ctx, auth.UserName(), order)
+}
+
+// Median of the price overview in cents, the lowest price while nothing was sold lately, 0 while unknown;
+// asked in the wallet country of the account like the prices it pays, the wallet is read once per Core
+func medianPrice(ctx context.Context, auth *auth.Core, appID string, currency common.Currency, hashName string) (int64, error) {
+	wallet, err := inventory.WalletLocaleContext(ctx, auth)
+	if err != nil {
+		return 0, fmt.Errorf("fail to get the wallet country for the policy, %w", err)
+	}
+	overview, err := priceOverviewContext(ctx, auth, appID, wallet.Country, currency, hashName)
+	if err != nil {
+		return 0, fmt.Errorf("fail to get the median price for the policy, %w", err)
+	}
+	for _, price := range []common.Money{overview.Median, overview.Lowest} {
+		if !price.IsZero() {
+			return price.Cents(), nil
+		}
+	}
+	return 0, nil
+}
+
+// Buy orders and listings of the account, the ones waiting for a confirmation included
+func openOrders(ctx context.Context, auth *auth.Core) (int, error) {
+	if sim := auth.DryRun(); sim != nil {
+		return sim.OpenOrders(), nil
+	}
+	listings, err := MyListingsContext(ctx, auth, 0, 1)
+	if err != nil {
+		return 0, fmt.Errorf("fail to count the open orders for the policy, %w", err)
+	}
+	return listings.ActiveCount + len(listings.ListingsToConfirm) + len(listings.BuyOrders), nil
+}
+
+func assetHashName(ctx context.Context, auth *auth.Core, appID, contextID, assetID string) (string, error) {
+	if sim := auth.DryRun(); sim != nil {
+		if item, ok := sim.Item(assetID); ok {
+			return item.HashName, nil
+		}
+	}
+	startAssetID := ""
+	for {
+		items := []inventory.InventoryItem{}
+		hasMore, lastAssetID, err := inventory.AllItemsContext(ctx, auth, appID, contextID, startAssetID, 2000, &items)
+		if err != nil {
+			return "", fmt.Errorf("fail to find the sold item for the policy, %w", err)
+		}
+		for _, item := range items {
+			if item.AssetID == assetID && item.Desc != nil {
+				return item.Desc.MarketHashName, nil
+			}
+		}
+		if !hasMore || lastAssetID == "" {
+			return "", fmt.Errorf("fail to find the sold item for the policy, asset ID: %s", assetID)
+		}
+		startAssetID = lastAssetID
+	}
+}
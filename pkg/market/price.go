@@ -1,10 +1,9 @@
 package market
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -12,25 +11,33 @@ import (
 
 	"github.com/tidwall/gjson"
 	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/cache"
 	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/retry"
 	"github.com/umichan0621/steam/pkg/utils"
 )
 
+// Price is the median of the period in the currency of the market.Core, rounded to its minor unit
 type PriceInfo struct {
 	Time  time.Time
-	Price float64
+	Price common.Money
 	Count int
 }
 
+// LowestPrice and MedianPrice are rendered by steam, Lowest and Median are parsed from them
+// and zero while steam shows no price
 type PriceOverviewInfo struct {
-	Success     bool   `json:"success"`
-	LowestPrice string `json:"lowest_price"`
-	MedianPrice string `json:"median_price"`
-	Volume      string `json:"volume"`
+	Success     bool         `json:"success"`
+	LowestPrice string       `json:"lowest_price"`
+	MedianPrice string       `json:"median_price"`
+	Volume      string       `json:"volume"`
+	Lowest      common.Money `json:"lowest"`
+	Median      common.Money `json:"median"`
 }
 
 type OrderInfo struct {
-	Price    float64
+	Price    common.Money
 	Quantity int32
 }
 
@@ -41,21 +48,26 @@ type OrderGraph struct {
 
 // Get the name ID by hash name, which is used to query history price
 func ItemNameID(auth *auth.Core, appID, hashName string) (string, error) {
-	reqUrl := fmt.Sprintf("%s/market/listings/%s/%s", common.URI_STEAM_COMMUNITY, appID, url.PathEscape(hashName))
-	res, err := auth.HttpClient().Get(reqUrl)
-	if err != nil {
-		return "", err
-	}
-	defer res.Body.Close()
+	return ItemNameIDContext(context.Background(), auth, appID, hashName)
+}
 
-	if res.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("fail to get price, hash name: %s, code: %d", hashName, res.StatusCode)
-	}
-	data, err := io.ReadAll(res.Body)
+// The name ID never changes, it is cached forever by the auth.Core cache
+func ItemNameIDContext(ctx context.Context, auth *auth.Core, appID, hashName string) (string, error) {
+	key := appID + "/" + hashName
+	return cache.Fetch(ctx, auth.Cache(), cache.EndpointItemNameID, key, func(ctx context.Context) (string, error) {
+		return retry.Read(ctx, auth, retry.OpItemNameID, func(ctx context.Context) (string, error) {
+			return itemNameID(ctx, auth, appID, hashName)
+		})
+	})
+}
+
+func itemNameID(ctx context.Context, auth *auth.Core, appID, hashName string) (string, error) {
+	htmlString, err := web.Page(ctx, auth, &web.Request{
+		Path: fmt.Sprintf("/market/listings/%s/%s", appID, url.PathEscape(hashName)),
+	})
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("fail to get item name ID, hash name: %s, %w", hashName, err)
 	}
-	htmlString := string(data)
 	index := strings.Index(htmlString, "Market_LoadOrderSpread")
 	if index >= 0 {
 		htmlString = htmlString[index:]
@@ -69,36 +81,40 @@ func ItemNameID(auth *auth.Core, appID, hashName string) (string, error) {
 	return "", fmt.Errorf("fail to get item name ID")
 }
 
-func ItemOrderGraph(auth *auth.Core, language, country, currency, appID, itemNameID string) (*OrderGraph, error) {
-	reqBody := url.Values{
-		"item_nameid": {itemNameID},
-		"language":    {language},
-		"country":     {country},
-		"currency":    {currency},
-	}
-	reqUrl := fmt.Sprintf("%s/market/itemordershistogram?%s", common.URI_STEAM_COMMUNITY, reqBody.Encode())
-	res, err := auth.HttpClient().Get(reqUrl)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
+func ItemOrderGraph(auth *auth.Core, country string, currency common.Currency, appID, itemNameID string) (*OrderGraph, error) {
+	return ItemOrderGraphContext(context.Background(), auth, country, currency, appID, itemNameID)
+}
+
+func ItemOrderGraphContext(ctx context.Context, auth *auth.Core, country string, currency common.Currency, appID, itemNameID string) (*OrderGraph, error) {
+	key := strings.Join([]string{itemNameID, auth.Language().String(), country, currency.IDString()}, "/")
+	return cache.Fetch(ctx, auth.Cache(), cache.EndpointOrderHistogram, key, func(ctx context.Context) (*OrderGraph, error) {
+		return retry.Read(ctx, auth, retry.OpItemOrderGraph, func(ctx context.Context) (*OrderGraph, error) {
+			return itemOrderGraph(ctx, auth, country, currency, appID, itemNameID)
+		})
+	})
+}
 
-	data, err := io.ReadAll(res.Body)
+func itemOrderGraph(ctx context.Context, auth *auth.Core, country string, currency common.Currency, appID, itemNameID string) (*OrderGraph, error) {
+	res, err := web.JSON(ctx, auth, &web.Request{
+		Path: "/market/itemordershistogram",
+		Query: url.Values{
+			"item_nameid": {itemNameID},
+			"language":    {auth.Language().String()},
+			"country":     {country},
+			"currency":    {currency.IDString()},
+		},
+	}, nil)
 	if err != nil {
-		return nil, err
-	}
-	jsonData := string(data)
-	success := gjson.Get(jsonData, "success").Int()
-	if success != 1 {
-		return nil, fmt.Errorf("fail to get order graph")
+		return nil, fmt.Errorf("fail to get order graph, %w", err)
 	}
+	jsonData := string(res.Body)
 	orderGraph := &OrderGraph{}
 	for _, buyOrders := range gjson.Get(jsonData, "buy_order_graph").Array() {
 		buyOrdersInfo := buyOrders.Array()
 		if len(buyOrdersInfo) == 3 {
 			orderGraph.BuyOrderGraph = append(orderGraph.BuyOrderGraph,
 				OrderInfo{
-					Price:    buyOrdersInfo[0].Float(),
+					Price:    common.MoneyFromFloat(buyOrdersInfo[0].Float(), currency),
 					Quantity: int32(buyOrdersInfo[1].Int()),
 				})
 		}
@@ -109,7 +125,7 @@ func ItemOrderGraph(auth *auth.Core, language, country, currency, appID, itemNam
 		if len(sellOrdersInfo) == 3 {
 			orderGraph.SellOrderGraph = append(orderGraph.SellOrderGraph,
 				OrderInfo{
-					Price:    sellOrdersInfo[0].Float(),
+					Price:    common.MoneyFromFloat(sellOrdersInfo[0].Float(), currency),
 					Quantity: int32(sellOrdersInfo[1].Int()),
 				})
 		}
@@ -118,83 +134,121 @@ func ItemOrderGraph(auth *auth.Core, language, country, currency, appID, itemNam
 }
 
 func (core *Core) PriceHistory(auth *auth.Core, appID, hashName string, lastNDays int) ([]*PriceInfo, error) {
-	reqBody := url.Values{
-		"appid":            {appID},
-		"market_hash_name": {hashName},
-	}
-	reqUrl := fmt.Sprintf("%s/market/pricehistory/?%s", common.URI_STEAM_COMMUNITY, reqBody.Encode())
-	res, err := auth.HttpClient().Get(reqUrl)
-	if err != nil {
-		return nil, err
-	}
+	return core.PriceHistoryContext(context.Background(), auth, appID, hashName, lastNDays)
+}
 
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("fail to get item [%s]'s price history, appID: %s, code: %d", hashName, appID, res.StatusCode)
-	}
-	data, err := io.ReadAll(res.Body)
+// The whole history is cached, in the wallet currency of the account, and cut to the last days on every call.
+// Steam renders it in the wallet currency, the Core must be set to it
+func (core *Core) PriceHistoryContext(ctx context.Context, auth *auth.Core, appID, hashName string, lastNDays int) ([]*PriceInfo, error) {
+	key := strings.Join([]string{auth.SteamID().String(), appID, hashName, core.currency.IDString()}, "/")
+	history, err := cache.Fetch(ctx, auth.Cache(), cache.EndpointPriceHistory, key, func(ctx context.Context) ([]*PriceInfo, error) {
+		return retry.Read(ctx, auth, retry.OpPriceHistory, func(ctx context.Context) ([]*PriceInfo, error) {
+			return core.priceHistory(ctx, auth, appID, hashName)
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
+	priceInfoList := []*PriceInfo{}
+	now := auth.Clock().Now()
+	for _, priceInfo := range history {
+		if utils.DeltaDay(priceInfo.Time, now) > float64(lastNDays) {
+			continue
+		}
+		priceInfoList = append(priceInfoList, priceInfo)
+	}
+	return priceInfoList, nil
+}
 
-	jsonData := string(data)
-
-	success := gjson.Get(jsonData, "success").Bool()
-	if !success {
-		return nil, fmt.Errorf("fail to get item [%s]'s price history, appID: %s", hashName, appID)
+func (core *Core) priceHistory(ctx context.Context, auth *auth.Core, appID, hashName string) ([]*PriceInfo, error) {
+	res, err := web.JSON(ctx, auth, &web.Request{
+		Path: "/market/pricehistory/",
+		Query: url.Values{
+			"appid":            {appID},
+			"market_hash_name": {hashName},
+		},
+		Login: true,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get item [%s]'s price history, appID: %s, %w", hashName, appID, err)
 	}
+	jsonData := string(res.Body)
 
 	priceInfoList := []*PriceInfo{}
-	now := time.Now()
 	for _, priceData := range gjson.Get(jsonData, "prices").Array() {
 		list := priceData.Array()
-		tm, err := utils.ParseSteamTimestamp(list[0].String())
-		if err != nil {
-			return nil, err
+		if len(list) < 3 {
+			return nil, fmt.Errorf("fail to parse item [%s]'s price history, appID: %s, entry: %s", hashName, appID, priceData.Raw)
 		}
-		deltaDay := utils.DeltaDay(tm, now)
-		if deltaDay > float64(lastNDays) {
-			continue
+		tm, err := utils.ParseSteamTimestamp(list[0].String(), auth.Language())
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse item [%s]'s price history, appID: %s, %w", hashName, appID, err)
 		}
 		count, err := strconv.Atoi(list[2].String())
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("fail to parse item [%s]'s price history, appID: %s, %w", hashName, appID, err)
 		}
-		price := list[1].Float()
 		priceInfoList = append(priceInfoList,
 			&PriceInfo{
 				Time:  tm,
-				Price: price,
+				Price: common.MoneyFromFloat(list[1].Float(), core.currency),
 				Count: count,
 			})
 	}
 	return priceInfoList, nil
 }
 
-func (core *Core) PriceOverview(auth *auth.Core, appID, country, currencyID, marketHashName string) (*PriceOverviewInfo, error) {
-	reqBody := url.Values{
-		"appid":            {appID},
-		"country":          {country},
-		"currencyID":       {currencyID},
-		"market_hash_name": {marketHashName},
-	}
-	reqUrl := fmt.Sprintf("%s/market/priceoverview/?%s", common.URI_STEAM_COMMUNITY, reqBody.Encode())
-	res, err := auth.HttpClient().Get(reqUrl)
+func (core *Core) PriceOverview(auth *auth.Core, appID, country string, currency common.Currency, marketHashName string) (*PriceOverviewInfo, error) {
+	return core.PriceOverviewContext(context.Background(), auth, appID, country, currency, marketHashName)
+}
+
+func (core *Core) PriceOverviewContext(ctx context.Context, auth *auth.Core, appID, country string, currency common.Currency, marketHashName string) (*PriceOverviewInfo, error) {
+	return priceOverviewContext(ctx, auth, appID, country, currency, marketHashName)
+}
+
+func priceOverviewContext(ctx context.Context, auth *auth.Core, appID, country string, currency common.Currency, marketHashName string) (*PriceOverviewInfo, error) {
+	key := strings.Join([]string{appID, country, currency.IDString(), marketHashName}, "/")
+	return cache.Fetch(ctx, auth.Cache(), cache.EndpointPriceOverview, key, func(ctx context.Context) (*PriceOverviewInfo, error) {
+		return retry.Read(ctx, auth, retry.OpPriceOverview, func(ctx context.Context) (*PriceOverviewInfo, error) {
+			return priceOverview(ctx, auth, appID, country, currency, marketHashName)
+		})
+	})
+}
+
+func priceOverview(ctx context.Context, auth *auth.Core, appID, country string, currency common.Currency, marketHashName string) (*PriceOverviewInfo, error) {
+	response := &PriceOverviewInfo{}
+	_, err := web.JSON(ctx, auth, &web.Request{
+		Path: "/market/priceoverview/",
+		Query: url.Values{
+			"appid":            {appID},
+			"country":          {country},
+			"currency":         {currency.IDString()},
+			"market_hash_name": {marketHashName},
+		},
+	}, response)
 	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("fail to get item [%s]'s price overview, appID: %s, code: %d", marketHashName, appID, res.StatusCode)
+		return nil, fmt.Errorf("fail to get item [%s]'s price overview, appID: %s, %w", marketHashName, appID, err)
 	}
-	data, err := io.ReadAll(res.Body)
-	if err != nil {
+	if response.Lowest, err = parseOverviewPrice(response.LowestPrice, currency); err != nil {
 		return nil, err
 	}
-	response := &PriceOverviewInfo{}
-	err = json.Unmarshal(data, response)
-	if err != nil {
+	if response.Median, err = parseOverviewPrice(response.MedianPrice, currency); err != nil {
 		return nil, err
 	}
 	return response, nil
 }
+
+// A price missing from the overview or shown as "--" is zero
+func parseOverviewPrice(text string, currency common.Currency) (common.Money, error) {
+	if text == "" {
+		return common.Money{Currency: currency}, nil
+	}
+	money, err := common.ParsePriceIn(text, currency)
+	if errors.Is(err, common.ErrNoPrice) {
+		return money, nil
+	}
+	if err != nil {
+		return money, fmt.Errorf("fail to parse the price overview, %w", err)
+	}
+	return money, nil
+}
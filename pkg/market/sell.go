@@ -1,16 +1,17 @@
 package market
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
-	"strings"
 
 	"github.com/umichan0621/steam/pkg/auth"
 	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/retry"
 )
 
 type MarketSellResponse struct {
@@ -19,47 +20,57 @@ type MarketSellResponse struct {
 	MobileConfirmationRequired bool   `json:"needs_mobile_confirmation"`
 	EmailConfirmationRequired  bool   `json:"needs_email_confirmation"`
 	EmailDomain                string `json:"email_domain"`
+	Message                    string `json:"message"`
 }
 
-func CreateSellOrder(auth *auth.Core, appID, contextID, assetID string, amount, receivedPrice uint64) (*MarketSellResponse, error) {
-	reqUrl := fmt.Sprintf("%s/market/sellitem/", common.URI_STEAM_COMMUNITY)
-	referUrl := fmt.Sprintf("%s/profiles/%s/inventory/", common.URI_STEAM_COMMUNITY, auth.SteamID())
-	reqHeader := http.Header{}
-	reqHeader.Add("Content-Type", "application/x-www-form-urlencoded")
-	reqHeader.Add("Referer", referUrl)
-	reqBody := url.Values{
-		"appid":     {appID},
-		"contextid": {contextID},
-		"assetid":   {assetID},
-		"sessionid": {auth.SessionID()},
-		"amount":    {strconv.FormatUint(amount, 10)},
-		"price":     {strconv.FormatUint(receivedPrice, 10)},
-	}
+func CreateSellOrder(auth *auth.Core, appID, contextID, assetID string, amount uint64, receivedPrice common.Money) (*MarketSellResponse, error) {
+	return CreateSellOrderContext(context.Background(), auth, appID, contextID, assetID, amount, receivedPrice)
+}
 
-	req, err := http.NewRequest(http.MethodPost, reqUrl, strings.NewReader(reqBody.Encode()))
-	if err != nil {
-		return nil, err
+// receivedPrice is what the account gets after the fees, see ReceivedPrice.
+// Refused with a *policy.Violation by the policy of auth, if any
+func CreateSellOrderContext(ctx context.Context, auth *auth.Core, appID, contextID, assetID string, amount uint64, receivedPrice common.Money) (*MarketSellResponse, error) {
+	params := map[string]any{
+		"appid": appID, "contextid": contextID, "assetid": assetID, "amount": amount, "received_price": receivedPrice,
 	}
-
-	req.Header = reqHeader
-
-	res, err := auth.HttpClient().Do(req)
-	if err != nil {
+	if err := checkSellPolicy(ctx, auth, appID, contextID, assetID, receivedPrice); err != nil {
+		auth.Audit(ctx, retry.OpCreateSellOrder.Name, params, nil, err)
+		auth.Logger().Warn("sell order refused", logging.F("asset_id", assetID), logging.Err(err))
 		return nil, err
 	}
-	defer res.Body.Close()
-
-	data, err := io.ReadAll(res.Body)
+	if sim := auth.DryRun(); sim != nil {
+		response, err := simulatedSellOrder(auth, sim, appID, contextID, assetID, amount, receivedPrice)
+		auth.Audit(ctx, retry.OpCreateSellOrder.Name, params, response, err)
+		return response, err
+	}
+	response, err := retry.Write(ctx, auth, retry.OpCreateSellOrder, func(ctx context.Context) (*MarketSellResponse, error) {
+		return createSellOrder(ctx, auth, appID, contextID, assetID, amount, receivedPrice)
+	}, reconcileSellOrder(auth, appID, assetID))
+	auth.Audit(ctx, retry.OpCreateSellOrder.Name, params, response, err)
 	if err != nil {
+		auth.Logger().Warn("fail to create sell order", logging.F("asset_id", assetID), logging.Err(err))
 		return nil, err
 	}
+	auth.Logger().Info("sell order created", logging.F("asset_id", assetID), logging.F("received_price", receivedPrice.String()),
+		logging.F("needs_confirmation", response.RequiresConfirmation != 0))
+	return response, nil
+}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http error: %d, %s", res.StatusCode, string(data))
-	}
-
+func createSellOrder(ctx context.Context, auth *auth.Core, appID, contextID, assetID string, amount uint64, receivedPrice common.Money) (*MarketSellResponse, error) {
 	response := &MarketSellResponse{}
-	err = json.Unmarshal(data, response)
+	_, err := web.JSON(ctx, auth, &web.Request{
+		Method: http.MethodPost,
+		Path:   "/market/sellitem/",
+		Form: url.Values{
+			"appid":     {appID},
+			"contextid": {contextID},
+			"assetid":   {assetID},
+			"amount":    {strconv.FormatUint(amount, 10)},
+			"price":     {strconv.FormatInt(receivedPrice.Cents(), 10)},
+		},
+		Referer: fmt.Sprintf("/profiles/%s/inventory/", auth.SteamID()),
+		Login:   true,
+	}, response)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,163 @@
+package observe
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Latency buckets in seconds
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// Metrics counts the requests and operations by endpoint and account, and exposes them
+// in the Prometheus text format, e.g. http.Handle("/metrics", metrics).
+// It is safe for concurrent use and may be shared between cores
+type Metrics struct {
+	NopHooks
+	mu         sync.Mutex
+	buckets    []float64
+	requests   map[string]uint64 // by series labels
+	bytes      map[string]uint64
+	reqLatency map[string]*histogram
+	operations map[string]uint64
+	retries    map[string]uint64
+	opLatency  map[string]*histogram
+}
+
+type histogram struct {
+	counts []uint64 // per bucket, not cumulative, the last one is +Inf
+	sum    float64
+	count  uint64
+}
+
+// nil buckets means DefaultBuckets
+func NewMetrics(buckets []float64) *Metrics {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64{}, buckets...)
+	sort.Float64s(buckets)
+	return &Metrics{
+		buckets:    buckets,
+		requests:   map[string]uint64{},
+		bytes:      map[string]uint64{},
+		reqLatency: map[string]*histogram{},
+		operations: map[string]uint64{},
+		retries:    map[string]uint64{},
+		opLatency:  map[string]*histogram{},
+	}
+}
+
+func (m *Metrics) EndRequest(_ context.Context, req *Request) {
+	status := "error"
+	if req.Err == nil {
+		status = strconv.Itoa(req.Status)
+	}
+	endpoint := labels("endpoint", req.Endpoint, "account", req.Account)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[labels("endpoint", req.Endpoint, "account", req.Account, "status", status, "eresult", req.EResult.String())]++
+	m.bytes[labels("endpoint", req.Endpoint, "account", req.Account, "direction", "sent")] += uint64(req.BytesSent)
+	m.bytes[labels("endpoint", req.Endpoint, "account", req.Account, "direction", "received")] += uint64(req.BytesReceived)
+	m.observe(m.reqLatency, endpoint, req.Duration)
+}
+
+func (m *Metrics) EndOperation(_ context.Context, op *Operation) {
+	operation := labels("operation", op.Name, "account", op.Account)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.operations[labels("operation", op.Name, "account", op.Account, "eresult", op.EResult.String())]++
+	if op.Attempts > 1 {
+		m.retries[operation] += uint64(op.Attempts - 1)
+	}
+	m.observe(m.opLatency, operation, op.Duration)
+}
+
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteText(w)
+}
+
+// Write every metric in the Prometheus text exposition format
+func (m *Metrics) WriteText(w io.Writer) error {
+	buf := bufio.NewWriter(w)
+	m.mu.Lock()
+	writeCounter(buf, "steam_requests_total", "Http requests sent to steam.", m.requests)
+	writeCounter(buf, "steam_request_bytes_total", "Bytes sent to and received from steam.", m.bytes)
+	m.writeHistogram(buf, "steam_request_duration_seconds", "Latency of the http requests until the body is read.", m.reqLatency)
+	writeCounter(buf, "steam_operations_total", "Library operations by final EResult.", m.operations)
+	writeCounter(buf, "steam_operation_retries_total", "Attempts of the operations after the first one.", m.retries)
+	m.writeHistogram(buf, "steam_operation_duration_seconds", "Latency of the operations including the retries.", m.opLatency)
+	m.mu.Unlock()
+	return buf.Flush()
+}
+
+// m.mu must be held
+func (m *Metrics) observe(series map[string]*histogram, key string, d time.Duration) {
+	h, ok := series[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(m.buckets)+1)}
+		series[key] = h
+	}
+	seconds := d.Seconds()
+	i := sort.SearchFloat64s(m.buckets, seconds)
+	h.counts[i]++
+	h.sum += seconds
+	h.count++
+}
+
+func writeCounter(w io.Writer, name, help string, series map[string]uint64) {
+	if len(series) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+	for _, key := range sortedKeys(series) {
+		fmt.Fprintf(w, "%s{%s} %d\n", name, key, series[key])
+	}
+}
+
+// m.mu must be held
+func (m *Metrics) writeHistogram(w io.Writer, name, help string, series map[string]*histogram) {
+	if len(series) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+	for _, key := range sortedKeys(series) {
+		h := series[key]
+		cumulative := uint64(0)
+		for i, bound := range m.buckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(w, "%s_bucket{%s,le=\"%s\"} %d\n", name, key, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(w, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, key, h.count)
+		fmt.Fprintf(w, "%s_sum{%s} %s\n", name, key, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(w, "%s_count{%s} %d\n", name, key, h.count)
+	}
+}
+
+func sortedKeys[V any](series map[string]V) []string {
+	keys := make([]string, 0, len(series))
+	for key := range series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// Label pairs of a series, e.g. endpoint="market",account="bot1"
+func labels(pairs ...string) string {
+	res := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		res = append(res, pairs[i]+`="`+labelEscaper.Replace(pairs[i+1])+`"`)
+	}
+	return strings.Join(res, ",")
+}
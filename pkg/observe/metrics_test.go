@@ -0,0 +1,80 @@
+package observe_test
+
+import (
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/umichan0621/steam/pkg/market"
+	"github.com/umichan0621/steam/pkg/observe"
+	"github.com/umichan0621/steam/pkg/steamtest"
+)
+
+// Series of the scrape by name and labels, the comments skipped
+func scrape(t *testing.T, metrics *observe.Metrics) map[string]string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if contentType := rec.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/plain; version=0.0.4") {
+		t.Fatalf("content type = %q", contentType)
+	}
+	series := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(rec.Body.String()), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		i := strings.LastIndexByte(line, ' ')
+		if i < 0 {
+			t.Fatalf("malformed line %q", line)
+		}
+		series[line[:i]] = line[i+1:]
+	}
+	return series
+}
+
+func TestMetricsScrape(t *testing.T) {
+	server, core, _ := steamtest.NewLoggedIn(t, steamtest.Account{})
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Case", LowestPrice: 100, MedianPrice: 95, Volume: 10})
+	metrics := observe.NewMetrics(nil)
+	core.SetHooks(metrics)
+	marketCore := &market.Core{}
+	marketCore.Init()
+
+	// The first attempt fails, the retry succeeds: two requests for one operation
+	server.Fail("/market/priceoverview", steamtest.Failure{Status: 500, Times: 1})
+	if _, err := marketCore.PriceOverview(core, "730", "US", marketCore.Currency(), "Case"); err != nil {
+		t.Fatal(err)
+	}
+	series := scrape(t, metrics)
+	want := map[string]int{
+		`steam_requests_total{endpoint="priceoverview",account="bot",status="200",eresult="OK"}`:            1,
+		`steam_requests_total{endpoint="priceoverview",account="bot",status="500",eresult="Fail"}`:          1,
+		`steam_request_bytes_total{endpoint="priceoverview",account="bot",direction="sent"}`:                0,
+		`steam_request_duration_seconds_count{endpoint="priceoverview",account="bot"}`:                      2,
+		`steam_request_duration_seconds_bucket{endpoint="priceoverview",account="bot",le="+Inf"}`:           2,
+		`steam_operations_total{operation="market.PriceOverview",account="bot",eresult="OK"}`:               1,
+		`steam_operation_retries_total{operation="market.PriceOverview",account="bot"}`:                     1,
+		`steam_operation_duration_seconds_count{operation="market.PriceOverview",account="bot"}`:            1,
+		`steam_operation_duration_seconds_bucket{operation="market.PriceOverview",account="bot",le="+Inf"}`: 1,
+	}
+	for name, value := range want {
+		if got, ok := series[name]; !ok || got != strconv.Itoa(value) {
+			t.Errorf("%s = %q, want %d", name, got, value)
+		}
+	}
+	received, _ := strconv.Atoi(series[`steam_request_bytes_total{endpoint="priceoverview",account="bot",direction="received"}`])
+	if received == 0 {
+		t.Error("no byte received")
+	}
+	// The buckets are cumulative up to the +Inf one
+	prev := 0
+	for _, bound := range observe.DefaultBuckets {
+		count, err := strconv.Atoi(series[`steam_request_duration_seconds_bucket{endpoint="priceoverview",account="bot",le="`+
+			strconv.FormatFloat(bound, 'g', -1, 64)+`"}`])
+		if err != nil || count < prev || count > 2 {
+			t.Fatalf("bucket %g = %d, %v", bound, count, err)
+		}
+		prev = count
+	}
+}
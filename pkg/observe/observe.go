@@ -0,0 +1,113 @@
+// Package observe reports every http request sent through auth.Core and every library
+// operation to Hooks: endpoint, account, status, EResult, duration, retries and bytes.
+// Metrics collects them for Prometheus, Tracing turns them into spans.
+package observe
+
+import (
+	"context"
+	"time"
+
+	errcode "github.com/umichan0621/steam/pkg/err"
+)
+
+// One http exchange with steam, the retries of an operation are distinct requests
+type Request struct {
+	Operation     string // library operation, e.g. market.PriceOverview, empty outside of one
+	Attempt       int    // attempt of the operation, 1 for the first one
+	Endpoint      string // endpoint class, e.g. priceoverview
+	Account       string // user name of the auth.Core
+	Method        string
+	Path          string
+	Status        int             // 0 on network errors
+	EResult       errcode.EResult // from the X-Eresult header, or else the http status
+	Duration      time.Duration   // until the body is read or closed
+	BytesSent     int64
+	BytesReceived int64
+	Err           error // network error, nil once a response is received
+}
+
+// One call of the library, e.g. market.CreateBuyOrder, with its retries
+type Operation struct {
+	Name     string
+	Write    bool // the operation changes the account
+	Account  string
+	Attempts int
+	Duration time.Duration
+	EResult  errcode.EResult // EResultOK on success
+	Err      error
+}
+
+// Hooks are called from the requesting goroutine and must not block.
+// The context returned by a Start method is used for the request or the operation
+// and given back to the matching End method, e.g. to carry a span
+type Hooks interface {
+	StartRequest(ctx context.Context, req *Request) context.Context
+	EndRequest(ctx context.Context, req *Request)
+	StartOperation(ctx context.Context, op *Operation) context.Context
+	EndOperation(ctx context.Context, op *Operation)
+}
+
+// Embed NopHooks to implement only some of the methods
+type NopHooks struct{}
+
+func (NopHooks) StartRequest(ctx context.Context, _ *Request) context.Context     { return ctx }
+func (NopHooks) EndRequest(context.Context, *Request)                             {}
+func (NopHooks) StartOperation(ctx context.Context, _ *Operation) context.Context { return ctx }
+func (NopHooks) EndOperation(context.Context, *Operation)                         {}
+
+type multi []Hooks
+
+// Call every hooks in order, the nil ones are skipped
+func Multi(hooks ...Hooks) Hooks {
+	res := multi{}
+	for _, h := range hooks {
+		if h != nil {
+			res = append(res, h)
+		}
+	}
+	return res
+}
+
+func (m multi) StartRequest(ctx context.Context, req *Request) context.Context {
+	for _, h := range m {
+		ctx = h.StartRequest(ctx, req)
+	}
+	return ctx
+}
+
+func (m multi) EndRequest(ctx context.Context, req *Request) {
+	for i := len(m) - 1; i >= 0; i-- {
+		m[i].EndRequest(ctx, req)
+	}
+}
+
+func (m multi) StartOperation(ctx context.Context, op *Operation) context.Context {
+	for _, h := range m {
+		ctx = h.StartOperation(ctx, op)
+	}
+	return ctx
+}
+
+func (m multi) EndOperation(ctx context.Context, op *Operation) {
+	for i := len(m) - 1; i >= 0; i-- {
+		m[i].EndOperation(ctx, op)
+	}
+}
+
+type operationKey struct{}
+
+type operationValue struct {
+	name    string
+	attempt int
+}
+
+// Label the requests sent with ctx, used by the retry package
+func WithOperation(ctx context.Context, name string, attempt int) context.Context {
+	return context.WithValue(ctx, operationKey{}, operationValue{name: name, attempt: attempt})
+}
+
+// Operation and attempt of the requests sent with ctx, empty outside of an operation
+func OperationFrom(ctx context.Context) (string, int) {
+	value, _ := ctx.Value(operationKey{}).(operationValue)
+	return value.name, value.attempt
+}
@@ -0,0 +1,66 @@
+package observe
+
+import (
+	"context"
+)
+
+// Span of a tracing backend, e.g. a thin wrapper of an OpenTelemetry span
+type Span interface {
+	SetAttribute(key string, value any)
+	End(err error)
+}
+
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+type tracing struct {
+	tracer Tracer
+}
+
+type spanKey struct{}
+
+// Hooks starting a span per operation, with a child span per request
+func Tracing(tracer Tracer) Hooks {
+	return &tracing{tracer: tracer}
+}
+
+func (t *tracing) StartOperation(ctx context.Context, op *Operation) context.Context {
+	ctx, span := t.tracer.Start(ctx, op.Name)
+	span.SetAttribute("steam.account", op.Account)
+	span.SetAttribute("steam.write", op.Write)
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+func (t *tracing) EndOperation(ctx context.Context, op *Operation) {
+	span, ok := ctx.Value(spanKey{}).(Span)
+	if !ok {
+		return
+	}
+	span.SetAttribute("steam.attempts", op.Attempts)
+	span.SetAttribute("steam.eresult", op.EResult.String())
+	span.End(op.Err)
+}
+
+func (t *tracing) StartRequest(ctx context.Context, req *Request) context.Context {
+	ctx, span := t.tracer.Start(ctx, req.Method+" "+req.Endpoint)
+	span.SetAttribute("steam.account", req.Account)
+	span.SetAttribute("http.method", req.Method)
+	span.SetAttribute("url.path", req.Path)
+	if req.Operation != "" {
+		span.SetAttribute("steam.attempt", req.Attempt)
+	}
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+func (t *tracing) EndRequest(ctx context.Context, req *Request) {
+	span, ok := ctx.Value(spanKey{}).(Span)
+	if !ok {
+		return
+	}
+	span.SetAttribute("http.status_code", req.Status)
+	span.SetAttribute("steam.eresult", req.EResult.String())
+	span.SetAttribute("steam.bytes_sent", req.BytesSent)
+	span.SetAttribute("steam.bytes_received", req.BytesReceived)
+	span.End(req.Err)
+}
@@ -0,0 +1,93 @@
+package observe
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/ratelimit"
+)
+
+type transport struct {
+	base    http.RoundTripper
+	hooks   Hooks
+	account string
+}
+
+// Report every request of base to hooks, nil base means http.DefaultTransport.
+// The durations are measured with the wall clock, not the Core clock
+func Transport(base http.RoundTripper, hooks Hooks, account string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base, hooks: hooks, account: account}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	operation, attempt := OperationFrom(req.Context())
+	info := &Request{
+		Operation: operation,
+		Attempt:   attempt,
+		Endpoint:  string(ratelimit.Classify(req)),
+		Account:   t.account,
+		Method:    req.Method,
+		Path:      req.URL.Path,
+		BytesSent: max(req.ContentLength, 0),
+	}
+	start := time.Now()
+	ctx := t.hooks.StartRequest(req.Context(), info)
+	if ctx != req.Context() {
+		req = req.WithContext(ctx)
+	}
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		info.Err = err
+		info.EResult = errcode.EResultOf(err)
+		info.Duration = time.Since(start)
+		t.hooks.EndRequest(ctx, info)
+		return nil, err
+	}
+	info.Status = res.StatusCode
+	info.EResult = errcode.StatusEResult(res.StatusCode)
+	if code, err := strconv.Atoi(res.Header.Get("X-Eresult")); err == nil {
+		info.EResult = errcode.EResult(code)
+	}
+	res.Body = &body{ReadCloser: res.Body, ctx: ctx, start: start, info: info, hooks: t.hooks}
+	return res, nil
+}
+
+// Count the bytes received and report the request once the body is read or closed
+type body struct {
+	io.ReadCloser
+	ctx   context.Context
+	start time.Time
+	info  *Request
+	hooks Hooks
+	once  sync.Once
+}
+
+func (b *body) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.info.BytesReceived += int64(n)
+	if err == io.EOF {
+		b.done()
+	}
+	return n, err
+}
+
+func (b *body) Close() error {
+	err := b.ReadCloser.Close()
+	b.done()
+	return err
+}
+
+func (b *body) done() {
+	b.once.Do(func() {
+		b.info.Duration = time.Since(b.start)
+		b.hooks.EndRequest(b.ctx, b.info)
+	})
+}
@@ -0,0 +1,328 @@
+// Package policy guards the calls moving money or items: a daily spend cap per account,
+// a max buy price relative to the median, a max number of open orders, forbidden items
+// and a sell floor. The market and trade packages check it before sending anything,
+// a refused call returns a *Violation.
+//
+// The amounts of the rules are in a single currency, an Engine is bound to the wallet
+// currency of the accounts sharing it. The daily spend is only kept in memory,
+// a restart of the process resets the cap of the day.
+package policy
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+// The amounts are in cents of Currency, the orders of another currency are refused
+// while DailySpendCap, MaxOverMedian, SellFloor or SellFloors is set
+type Rules struct {
+	Currency       common.Currency
+	DailySpendCap  int64            // cents committed to buy orders per account and UTC day, 0 means no cap
+	MaxOverMedian  float64          // max buy price per item over the PriceOverview median, e.g. 1.2; 0 disables
+	MaxOpenOrders  int              // buy orders and listings open at once, 0 means no limit
+	ForbiddenItems []string         // market hash names never bought, sold or given in a trade
+	SellFloor      int64            // min received price of any sell order in cents, 0 means none
+	SellFloors     map[string]int64 // min received price by market hash name, over SellFloor
+}
+
+// True while a rule compares amounts
+func (r *Rules) hasAmounts() bool {
+	return r.DailySpendCap > 0 || r.MaxOverMedian > 0 || r.SellFloor > 0 || len(r.SellFloors) > 0
+}
+
+type Rule string
+
+const (
+	RuleDailySpend    Rule = "daily_spend"
+	RuleMaxPrice      Rule = "max_price"
+	RuleMaxOpenOrders Rule = "max_open_orders"
+	RuleForbiddenItem Rule = "forbidden_item"
+	RuleSellFloor     Rule = "sell_floor"
+	RuleUnknownMedian Rule = "unknown_median" // MaxOverMedian is set but the item has no median price
+	RuleCurrency      Rule = "currency"       // the order is not in the currency of the rules
+)
+
+// Matched by errors.Is on every Violation
+var ErrViolation = errors.New("policy violation")
+
+type Violation struct {
+	Rule     Rule
+	Account  string
+	HashName string // empty for the rules not bound to an item
+	Limit    int64  // cents, count, the max price for RuleMaxPrice or the currency ID of the rules for RuleCurrency
+	Actual   int64
+}
+
+func (v *Violation) Error() string {
+	switch v.Rule {
+	case RuleForbiddenItem:
+		return fmt.Sprintf("policy violation: %s is a forbidden item, account: %s", v.HashName, v.Account)
+	case RuleMaxOpenOrders:
+		return fmt.Sprintf("policy violation: %d open orders, limit %d, account: %s", v.Actual, v.Limit, v.Account)
+	case RuleUnknownMedian:
+		return fmt.Sprintf("policy violation: no median price to check the max price, item: %s, account: %s", v.HashName, v.Account)
+	case RuleCurrency:
+		return fmt.Sprintf("policy violation: order in currency %d, the rules are in currency %d, item: %s, account: %s",
+			v.Actual, v.Limit, v.HashName, v.Account)
+	}
+	return fmt.Sprintf("policy violation: %s, %d exceeds the limit %d, item: %s, account: %s",
+		v.Rule, v.Actual, v.Limit, v.HashName, v.Account)
+}
+
+func (v *Violation) Is(target error) bool { return target == ErrViolation }
+
+type BuyOrder struct {
+	HashName   string
+	Currency   common.Currency // of PriceTotal and Median
+	PriceTotal int64           // for every item, in cents
+	Quantity   uint64
+	Median     int64 // last median price in cents, 0 while unknown
+	OpenOrders int   // -1 while unknown
+}
+
+type SellOrder struct {
+	HashName      string          // empty while unknown
+	Currency      common.Currency // of ReceivedPrice
+	ReceivedPrice int64           // per item, in cents
+	OpenOrders    int             // -1 while unknown
+}
+
+// Engine is safe for concurrent use, install it with auth.Core.SetPolicy
+type Engine struct {
+	mu       sync.Mutex
+	rules    Rules
+	clock    utils.Clock
+	override string
+	spent    map[string]*spending // by account, in memory only
+}
+
+type spending struct {
+	day   string
+	cents int64
+}
+
+func NewEngine(rules Rules) *Engine {
+	return &Engine{rules: rules, clock: utils.SystemClock{}, spent: map[string]*spending{}}
+}
+
+func (e *Engine) Rules() Rules {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.rules
+}
+
+// The spending of the day is kept, unless the currency of the rules changes
+func (e *Engine) SetRules(rules Rules) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if rules.Currency != e.rules.Currency {
+		e.spent = map[string]*spending{}
+	}
+	e.rules = rules
+}
+
+// Share it with the Core, the day of the spend cap is read from it
+func (e *Engine) SetClock(clock utils.Clock) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.clock = clock
+}
+
+// A context carrying the token with WithOverride skips the rules, "" disables the overrides
+func (e *Engine) SetOverrideToken(token string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.override = token
+}
+
+// Cents committed to buy orders by the account today, since the process started
+func (e *Engine) Spent(account string) int64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.spending(account).cents
+}
+
+// True while ctx carries the override token, the caller should log the manual operation
+func (e *Engine) Overridden(ctx context.Context) bool {
+	token, _ := ctx.Value(overrideKey{}).(string)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.override != "" && subtle.ConstantTimeCompare([]byte(token), []byte(e.override)) == 1
+}
+
+// Check the order and reserve its total against the daily cap,
+// call release when the order is not placed so the amount is given back
+func (e *Engine) CheckBuy(ctx context.Context, account string, order BuyOrder) (release func(), err error) {
+	overridden := e.Overridden(ctx)
+	total := order.PriceTotal
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	// An overridden order of another currency is not counted in the cents of the cap
+	if order.Currency != e.rules.Currency {
+		total = 0
+	}
+	if !overridden {
+		if e.forbidden(order.HashName) {
+			return nil, &Violation{Rule: RuleForbiddenItem, Account: account, HashName: order.HashName}
+		}
+		if err := e.checkCurrency(account, order.HashName, order.Currency); err != nil {
+			return nil, err
+		}
+		// Without a median the max price can not be checked, the order is refused
+		if e.rules.MaxOverMedian > 0 && order.Median <= 0 {
+			return nil, &Violation{Rule: RuleUnknownMedian, Account: account, HashName: order.HashName}
+		}
+		if e.rules.MaxOverMedian > 0 && order.Quantity > 0 {
+			limit := int64(float64(order.Median) * e.rules.MaxOverMedian)
+			if price := order.PriceTotal / int64(order.Quantity); price > limit {
+				return nil, &Violation{Rule: RuleMaxPrice, Account: account, HashName: order.HashName, Limit: limit, Actual: price}
+			}
+		}
+		if err := e.checkOpenOrders(account, order.OpenOrders); err != nil {
+			return nil, err
+		}
+		spent := e.spending(account)
+		if e.rules.DailySpendCap > 0 && spent.cents+total > e.rules.DailySpendCap {
+			return nil, &Violation{Rule: RuleDailySpend, Account: account, HashName: order.HashName,
+				Limit: e.rules.DailySpendCap, Actual: spent.cents + total}
+		}
+	}
+	spent := e.spending(account)
+	spent.cents += total
+	day := spent.day
+	once := sync.Once{}
+	return func() {
+		once.Do(func() {
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			if spent := e.spending(account); spent.day == day {
+				spent.cents -= total
+			}
+		})
+	}, nil
+}
+
+func (e *Engine) CheckSell(ctx context.Context, account string, order SellOrder) error {
+	if e.Overridden(ctx) {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.forbidden(order.HashName) {
+		return &Violation{Rule: RuleForbiddenItem, Account: account, HashName: order.HashName}
+	}
+	if err := e.checkCurrency(account, order.HashName, order.Currency); err != nil {
+		return err
+	}
+	floor := e.rules.SellFloor
+	if itemFloor, ok := e.rules.SellFloors[order.HashName]; ok && itemFloor > floor {
+		floor = itemFloor
+	}
+	if floor > 0 && order.ReceivedPrice < floor {
+		return &Violation{Rule: RuleSellFloor, Account: account, HashName: order.HashName, Limit: floor, Actual: order.ReceivedPrice}
+	}
+	return e.checkOpenOrders(account, order.OpenOrders)
+}
+
+// Check only the forbidden items, before fetching what the other rules need
+func (e *Engine) CheckItem(ctx context.Context, account, hashName string) error {
+	if e.Overridden(ctx) {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.forbidden(hashName) {
+		return &Violation{Rule: RuleForbiddenItem, Account: account, HashName: hashName}
+	}
+	return nil
+}
+
+// Check the items given away by accepting a trade offer
+func (e *Engine) CheckTrade(ctx context.Context, account string, given []string) error {
+	if e.Overridden(ctx) {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, hashName := range given {
+		if e.forbidden(hashName) {
+			return &Violation{Rule: RuleForbiddenItem, Account: account, HashName: hashName}
+		}
+	}
+	return nil
+}
+
+// True while the rules need the hash name of the sold or given items
+func (e *Engine) NeedsItemNames() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.rules.ForbiddenItems) > 0 || len(e.rules.SellFloors) > 0
+}
+
+// True while the rules need the number of open orders
+func (e *Engine) NeedsOpenOrders() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.rules.MaxOpenOrders > 0
+}
+
+// True while the rules need the median price of the bought item
+func (e *Engine) NeedsMedian() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.rules.MaxOverMedian > 0
+}
+
+// e.mu must be held
+func (e *Engine) checkCurrency(account, hashName string, currency common.Currency) error {
+	if e.rules.hasAmounts() && currency != e.rules.Currency {
+		return &Violation{Rule: RuleCurrency, Account: account, HashName: hashName, Limit: int64(e.rules.Currency.ID), Actual: int64(currency.ID)}
+	}
+	return nil
+}
+
+// e.mu must be held
+func (e *Engine) checkOpenOrders(account string, open int) error {
+	if e.rules.MaxOpenOrders > 0 && open >= e.rules.MaxOpenOrders {
+		return &Violation{Rule: RuleMaxOpenOrders, Account: account, Limit: int64(e.rules.MaxOpenOrders), Actual: int64(open) + 1}
+	}
+	return nil
+}
+
+// e.mu must be held
+func (e *Engine) forbidden(hashName string) bool {
+	if hashName == "" {
+		return false
+	}
+	for _, name := range e.rules.ForbiddenItems {
+		if name == hashName {
+			return true
+		}
+	}
+	return false
+}
+
+// Spending of the current UTC day, e.mu must be held
+func (e *Engine) spending(account string) *spending {
+	day := e.clock.Now().UTC().Format(time.DateOnly)
+	spent, ok := e.spent[account]
+	if !ok || spent.day != day {
+		spent = &spending{day: day}
+		e.spent[account] = spent
+	}
+	return spent
+}
+
+type overrideKey struct{}
+
+// Carry the override token of a manual operation, the rules are skipped for the calls made with ctx
+func WithOverride(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, overrideKey{}, token)
+}
@@ -0,0 +1,211 @@
+package policy_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/inventory"
+	"github.com/umichan0621/steam/pkg/market"
+	"github.com/umichan0621/steam/pkg/policy"
+	"github.com/umichan0621/steam/pkg/steamid"
+	"github.com/umichan0621/steam/pkg/steamtest"
+	"github.com/umichan0621/steam/pkg/trade"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+var usd, _ = common.CurrencyByCode("USD")
+
+// A logged in account holding a knife and a case, with engine checking its operations
+func newBot(t *testing.T, engine *policy.Engine) (*steamtest.Server, *auth.Core, uint64) {
+	t.Helper()
+	server, core, steamID := steamtest.NewLoggedIn(t, steamtest.Account{Wallet: 100000,
+		Inventory: []*steamtest.Item{
+			{AppID: 730, ContextID: 2, ClassID: 5, MarketHashName: "Knife", Tradable: true, Marketable: true},
+			{AppID: 730, ContextID: 2, ClassID: 6, MarketHashName: "Case", Tradable: true, Marketable: true},
+		}})
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Case", LowestPrice: 100, MedianPrice: 95, Volume: 10})
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Key", LowestPrice: 100, MedianPrice: 95, Volume: 10})
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Knife", LowestPrice: 100, MedianPrice: 95, Volume: 10})
+	engine.SetClock(core.Clock())
+	core.SetPolicy(engine)
+	return server, core, steamID
+}
+
+func wantRule(t *testing.T, err error, rule policy.Rule) {
+	t.Helper()
+	var violation *policy.Violation
+	if !errors.As(err, &violation) || violation.Rule != rule {
+		t.Fatalf("err = %v, want a %s violation", err, rule)
+	}
+	if !errors.Is(err, policy.ErrViolation) {
+		t.Fatalf("%v does not match ErrViolation", err)
+	}
+}
+
+func TestEngineDailySpend(t *testing.T) {
+	engine := policy.NewEngine(policy.Rules{DailySpendCap: 300})
+	clock := utils.NewManualClock(time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC))
+	engine.SetClock(clock)
+	ctx := context.Background()
+
+	release, err := engine.CheckBuy(ctx, "bot", policy.BuyOrder{HashName: "Case", PriceTotal: 200, Quantity: 1, OpenOrders: -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = engine.CheckBuy(ctx, "bot", policy.BuyOrder{HashName: "Case", PriceTotal: 200, Quantity: 1, OpenOrders: -1})
+	wantRule(t, err, policy.RuleDailySpend)
+	if _, err := engine.CheckBuy(ctx, "other", policy.BuyOrder{HashName: "Case", PriceTotal: 200, Quantity: 1, OpenOrders: -1}); err != nil {
+		t.Fatalf("the cap is per account: %v", err)
+	}
+
+	// A released reservation is given back, and the cap starts again on the next UTC day
+	release()
+	if spent := engine.Spent("bot"); spent != 0 {
+		t.Fatalf("spent = %d after the release", spent)
+	}
+	engine.CheckBuy(ctx, "bot", policy.BuyOrder{HashName: "Case", PriceTotal: 300, Quantity: 1, OpenOrders: -1})
+	clock.Advance(2 * time.Hour)
+	if _, err := engine.CheckBuy(ctx, "bot", policy.BuyOrder{HashName: "Case", PriceTotal: 300, Quantity: 1, OpenOrders: -1}); err != nil {
+		t.Fatalf("next day: %v", err)
+	}
+}
+
+// The amounts of the rules are only compared to orders of their currency
+func TestEngineCurrency(t *testing.T) {
+	eur, _ := common.CurrencyByCode("EUR")
+	engine := policy.NewEngine(policy.Rules{Currency: usd, DailySpendCap: 300, SellFloor: 50})
+	ctx := context.Background()
+	if _, err := engine.CheckBuy(ctx, "bot", policy.BuyOrder{HashName: "Case", Currency: usd, PriceTotal: 200, Quantity: 1, OpenOrders: -1}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := engine.CheckBuy(ctx, "bot", policy.BuyOrder{HashName: "Case", Currency: eur, PriceTotal: 50, Quantity: 1, OpenOrders: -1})
+	wantRule(t, err, policy.RuleCurrency)
+	err = engine.CheckSell(ctx, "bot", policy.SellOrder{HashName: "Case", Currency: eur, ReceivedPrice: 100, OpenOrders: -1})
+	wantRule(t, err, policy.RuleCurrency)
+
+	// An overridden order of another currency is not counted in the cap
+	engine.SetOverrideToken("sesame")
+	if _, err := engine.CheckBuy(policy.WithOverride(ctx, "sesame"), "bot", policy.BuyOrder{HashName: "Case", Currency: eur, PriceTotal: 5000, Quantity: 1, OpenOrders: -1}); err != nil {
+		t.Fatal(err)
+	}
+	if spent := engine.Spent("bot"); spent != 200 {
+		t.Fatalf("spent = %d, want the 200 cents of USD", spent)
+	}
+
+	// Rules without amounts take any currency, a new currency starts the spending again
+	engine.SetRules(policy.Rules{Currency: eur, ForbiddenItems: []string{"Knife"}})
+	if spent := engine.Spent("bot"); spent != 0 {
+		t.Fatalf("spent = %d in the new currency", spent)
+	}
+	if err := engine.CheckSell(ctx, "bot", policy.SellOrder{HashName: "Case", Currency: usd, ReceivedPrice: 1, OpenOrders: -1}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPolicyMarket(t *testing.T) {
+	engine := policy.NewEngine(policy.Rules{Currency: usd, DailySpendCap: 300, MaxOverMedian: 1.2, MaxOpenOrders: 3, ForbiddenItems: []string{"Knife"}, SellFloor: 50})
+	engine.SetOverrideToken("sesame")
+	server, core, steamID := newBot(t, engine)
+
+	_, err := market.CreateBuyOrder(core, "730", common.MoneyFromCents(200, usd), 1, "Case")
+	wantRule(t, err, policy.RuleMaxPrice)
+	if _, err := market.CreateBuyOrder(core, "730", common.MoneyFromCents(100, usd), 1, "Case"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = market.CreateBuyOrder(core, "730", common.MoneyFromCents(220, usd), 2, "Key")
+	wantRule(t, err, policy.RuleDailySpend)
+	_, err = market.CreateBuyOrder(core, "730", common.MoneyFromCents(100, usd), 1, "Knife")
+	wantRule(t, err, policy.RuleForbiddenItem)
+	if len(server.BuyOrders(steamID)) != 1 {
+		t.Fatalf("buy orders = %+v, the refused ones were sent", server.BuyOrders(steamID))
+	}
+
+	// The override token skips every rule
+	ctx := policy.WithOverride(context.Background(), "sesame")
+	if _, err := market.CreateBuyOrderContext(ctx, core, "730", common.MoneyFromCents(500, usd), 1, "Key"); err != nil {
+		t.Fatalf("override: %v", err)
+	}
+
+	items := []inventory.InventoryItem{}
+	if _, _, err := inventory.AllItems(core, "730", "2", "", 100, &items); err != nil {
+		t.Fatal(err)
+	}
+	assets := map[string]string{}
+	for _, item := range items {
+		assets[item.Desc.MarketHashName] = item.AssetID
+	}
+	_, err = market.CreateSellOrder(core, "730", "2", assets["Knife"], 1, common.MoneyFromCents(80, usd))
+	wantRule(t, err, policy.RuleForbiddenItem)
+	_, err = market.CreateSellOrder(core, "730", "2", assets["Case"], 1, common.MoneyFromCents(20, usd))
+	wantRule(t, err, policy.RuleSellFloor)
+	if _, err := market.CreateSellOrder(core, "730", "2", assets["Case"], 1, common.MoneyFromCents(80, usd)); err != nil {
+		t.Fatal(err)
+	}
+	// Two buy orders and a listing are open
+	_, err = market.CreateBuyOrder(core, "730", common.MoneyFromCents(50, usd), 1, "Case")
+	wantRule(t, err, policy.RuleMaxOpenOrders)
+
+	partner := steamid.FromAccountID(5)
+	offerID := server.AddTradeOffer(steamID, steamtest.Offer{PartnerAccount: partner.AccountID(),
+		ItemsToGive: []*steamtest.Item{{AppID: 730, ContextID: 2, ClassID: 5, MarketHashName: "Knife"}}})
+	err = trade.AcceptTradeOffer(core, strconv.FormatUint(offerID, 10), partner)
+	wantRule(t, err, policy.RuleForbiddenItem)
+	if offer, _ := server.Offer(steamID, offerID); offer.State != steamtest.OfferStateActive {
+		t.Fatalf("offer state = %d, the refused accept was sent", offer.State)
+	}
+}
+
+// Remember the country asked for each price overview, and count the reads of the market page
+type countryTransport struct {
+	mu        sync.Mutex
+	countries []string
+	pages     int
+}
+
+func (c *countryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	if strings.HasPrefix(req.URL.Path, "/market/priceoverview") {
+		c.countries = append(c.countries, req.URL.Query().Get("country"))
+	}
+	if req.URL.Path == "/market/" {
+		c.pages++
+	}
+	c.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestPolicyMedian(t *testing.T) {
+	engine := policy.NewEngine(policy.Rules{Currency: usd, MaxOverMedian: 1.2})
+	_, err := engine.CheckBuy(context.Background(), "bot", policy.BuyOrder{HashName: "Case", Currency: usd, PriceTotal: 100, Quantity: 1, OpenOrders: -1})
+	wantRule(t, err, policy.RuleUnknownMedian)
+
+	server, core, _ := newBot(t, engine)
+	transport := &countryTransport{}
+	core.SetTransport(transport)
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Unsold"})
+	_, err = market.CreateBuyOrder(core, "730", common.MoneyFromCents(100, usd), 1, "Unsold")
+	wantRule(t, err, policy.RuleUnknownMedian)
+	if _, err := market.CreateBuyOrder(core, "730", common.MoneyFromCents(100, usd), 1, "Case"); err != nil {
+		t.Fatal(err)
+	}
+	// The median is asked in the wallet country of the account
+	for _, country := range transport.countries {
+		if country != "US" {
+			t.Fatalf("countries = %v, want the wallet country", transport.countries)
+		}
+	}
+	if len(transport.countries) != 2 {
+		t.Fatalf("%d price overviews, want 2", len(transport.countries))
+	}
+	if transport.pages != 1 {
+		t.Fatalf("market page read %d times, want once for the wallet", transport.pages)
+	}
+}
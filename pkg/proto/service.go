@@ -0,0 +1,74 @@
+// Package proto holds the messages of the steam web api services, generated from the
+// definitions of the proto directory; the services are found by their web api interface name.
+package proto
+
+//go:generate go run ../../cmd/protogen -proto ../../proto -out .
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Every generated file, registered by its init
+var files = []*protoreflect.FileDescriptor{
+	&File_steammessages_auth_steamclient_proto,
+	&File_steammessages_econ_steamclient_proto,
+	&File_steammessages_inventory_steamclient_proto,
+	&File_steammessages_player_steamclient_proto,
+	&File_steammessages_twofactor_steamclient_proto,
+}
+
+// Web api interface of a service, e.g. IAuthenticationService for Authentication
+func Interface(service protoreflect.ServiceDescriptor) string {
+	return "I" + string(service.Name()) + "Service"
+}
+
+// The services by web api interface
+func Services() map[string]protoreflect.ServiceDescriptor {
+	res := map[string]protoreflect.ServiceDescriptor{}
+	for _, file := range files {
+		services := (*file).Services()
+		for i := 0; i < services.Len(); i++ {
+			res[Interface(services.Get(i))] = services.Get(i)
+		}
+	}
+	return res
+}
+
+// Method of a web api interface, e.g. FindMethod("ITwoFactorService", "QueryTime")
+func FindMethod(iface, method string) (protoreflect.MethodDescriptor, error) {
+	service, ok := Services()[iface]
+	if !ok {
+		return nil, fmt.Errorf("unknown service interface %s", iface)
+	}
+	res := service.Methods().ByName(protoreflect.Name(method))
+	if res == nil {
+		return nil, fmt.Errorf("unknown method %s.%s", iface, method)
+	}
+	return res, nil
+}
+
+// Methods of every service as interface.method, sorted
+func Methods() []string {
+	res := []string{}
+	for iface, service := range Services() {
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			res = append(res, iface+"."+string(methods.Get(i).Name()))
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// An empty request or response of a method, e.g. NewMessage(method.Input())
+func NewMessage(desc protoreflect.MessageDescriptor) (protoreflect.ProtoMessage, error) {
+	res, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, err
+	}
+	return res.New().Interface(), nil
+}
@@ -0,0 +1,2895 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: steammessages_auth.steamclient.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EAuthTokenPlatformType int32
+
+const (
+	EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown     EAuthTokenPlatformType = 0
+	EAuthTokenPlatformType_k_EAuthTokenPlatformType_SteamClient EAuthTokenPlatformType = 1
+	EAuthTokenPlatformType_k_EAuthTokenPlatformType_WebBrowser  EAuthTokenPlatformType = 2
+	EAuthTokenPlatformType_k_EAuthTokenPlatformType_MobileApp   EAuthTokenPlatformType = 3
+)
+
+// Enum value maps for EAuthTokenPlatformType.
+var (
+	EAuthTokenPlatformType_name = map[int32]string{
+		0: "k_EAuthTokenPlatformType_Unknown",
+		1: "k_EAuthTokenPlatformType_SteamClient",
+		2: "k_EAuthTokenPlatformType_WebBrowser",
+		3: "k_EAuthTokenPlatformType_MobileApp",
+	}
+	EAuthTokenPlatformType_value = map[string]int32{
+		"k_EAuthTokenPlatformType_Unknown":     0,
+		"k_EAuthTokenPlatformType_SteamClient": 1,
+		"k_EAuthTokenPlatformType_WebBrowser":  2,
+		"k_EAuthTokenPlatformType_MobileApp":   3,
+	}
+)
+
+func (x EAuthTokenPlatformType) Enum() *EAuthTokenPlatformType {
+	p := new(EAuthTokenPlatformType)
+	*p = x
+	return p
+}
+
+func (x EAuthTokenPlatformType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAuthTokenPlatformType) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[0].Descriptor()
+}
+
+func (EAuthTokenPlatformType) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[0]
+}
+
+func (x EAuthTokenPlatformType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EAuthTokenPlatformType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EAuthTokenPlatformType(num)
+	return nil
+}
+
+// Deprecated: Use EAuthTokenPlatformType.Descriptor instead.
+func (EAuthTokenPlatformType) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+type EAuthTokenAppType int32
+
+const (
+	EAuthTokenAppType_k_EAuthTokenAppType_Unknown         EAuthTokenAppType = 0
+	EAuthTokenAppType_k_EAuthTokenAppType_Mobile_SteamApp EAuthTokenAppType = 1
+	EAuthTokenAppType_k_EAuthTokenAppType_Mobile_ChatApp  EAuthTokenAppType = 2
+)
+
+// Enum value maps for EAuthTokenAppType.
+var (
+	EAuthTokenAppType_name = map[int32]string{
+		0: "k_EAuthTokenAppType_Unknown",
+		1: "k_EAuthTokenAppType_Mobile_SteamApp",
+		2: "k_EAuthTokenAppType_Mobile_ChatApp",
+	}
+	EAuthTokenAppType_value = map[string]int32{
+		"k_EAuthTokenAppType_Unknown":         0,
+		"k_EAuthTokenAppType_Mobile_SteamApp": 1,
+		"k_EAuthTokenAppType_Mobile_ChatApp":  2,
+	}
+)
+
+func (x EAuthTokenAppType) Enum() *EAuthTokenAppType {
+	p := new(EAuthTokenAppType)
+	*p = x
+	return p
+}
+
+func (x EAuthTokenAppType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAuthTokenAppType) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[1].Descriptor()
+}
+
+func (EAuthTokenAppType) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[1]
+}
+
+func (x EAuthTokenAppType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EAuthTokenAppType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EAuthTokenAppType(num)
+	return nil
+}
+
+// Deprecated: Use EAuthTokenAppType.Descriptor instead.
+func (EAuthTokenAppType) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+type EAuthSessionGuardType int32
+
+const (
+	EAuthSessionGuardType_k_EAuthSessionGuardType_Unknown            EAuthSessionGuardType = 0
+	EAuthSessionGuardType_k_EAuthSessionGuardType_None               EAuthSessionGuardType = 1
+	EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode          EAuthSessionGuardType = 2
+	EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode         EAuthSessionGuardType = 3
+	EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceConfirmation EAuthSessionGuardType = 4
+	EAuthSessionGuardType_k_EAuthSessionGuardType_EmailConfirmation  EAuthSessionGuardType = 5
+	EAuthSessionGuardType_k_EAuthSessionGuardType_MachineToken       EAuthSessionGuardType = 6
+	EAuthSessionGuardType_k_EAuthSessionGuardType_LegacyMachineAuth  EAuthSessionGuardType = 7
+)
+
+// Enum value maps for EAuthSessionGuardType.
+var (
+	EAuthSessionGuardType_name = map[int32]string{
+		0: "k_EAuthSessionGuardType_Unknown",
+		1: "k_EAuthSessionGuardType_None",
+		2: "k_EAuthSessionGuardType_EmailCode",
+		3: "k_EAuthSessionGuardType_DeviceCode",
+		4: "k_EAuthSessionGuardType_DeviceConfirmation",
+		5: "k_EAuthSessionGuardType_EmailConfirmation",
+		6: "k_EAuthSessionGuardType_MachineToken",
+		7: "k_EAuthSessionGuardType_LegacyMachineAuth",
+	}
+	EAuthSessionGuardType_value = map[string]int32{
+		"k_EAuthSessionGuardType_Unknown":            0,
+		"k_EAuthSessionGuardType_None":               1,
+		"k_EAuthSessionGuardType_EmailCode":          2,
+		"k_EAuthSessionGuardType_DeviceCode":         3,
+		"k_EAuthSessionGuardType_DeviceConfirmation": 4,
+		"k_EAuthSessionGuardType_EmailConfirmation":  5,
+		"k_EAuthSessionGuardType_MachineToken":       6,
+		"k_EAuthSessionGuardType_LegacyMachineAuth":  7,
+	}
+)
+
+func (x EAuthSessionGuardType) Enum() *EAuthSessionGuardType {
+	p := new(EAuthSessionGuardType)
+	*p = x
+	return p
+}
+
+func (x EAuthSessionGuardType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAuthSessionGuardType) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[2].Descriptor()
+}
+
+func (EAuthSessionGuardType) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[2]
+}
+
+func (x EAuthSessionGuardType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EAuthSessionGuardType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EAuthSessionGuardType(num)
+	return nil
+}
+
+// Deprecated: Use EAuthSessionGuardType.Descriptor instead.
+func (EAuthSessionGuardType) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+type EAuthSessionSecurityHistory int32
+
+const (
+	EAuthSessionSecurityHistory_k_EAuthSessionSecurityHistory_Invalid        EAuthSessionSecurityHistory = 0
+	EAuthSessionSecurityHistory_k_EAuthSessionSecurityHistory_UsedPreviously EAuthSessionSecurityHistory = 1
+	EAuthSessionSecurityHistory_k_EAuthSessionSecurityHistory_NoPriorHistory EAuthSessionSecurityHistory = 2
+)
+
+// Enum value maps for EAuthSessionSecurityHistory.
+var (
+	EAuthSessionSecurityHistory_name = map[int32]string{
+		0: "k_EAuthSessionSecurityHistory_Invalid",
+		1: "k_EAuthSessionSecurityHistory_UsedPreviously",
+		2: "k_EAuthSessionSecurityHistory_NoPriorHistory",
+	}
+	EAuthSessionSecurityHistory_value = map[string]int32{
+		"k_EAuthSessionSecurityHistory_Invalid":        0,
+		"k_EAuthSessionSecurityHistory_UsedPreviously": 1,
+		"k_EAuthSessionSecurityHistory_NoPriorHistory": 2,
+	}
+)
+
+func (x EAuthSessionSecurityHistory) Enum() *EAuthSessionSecurityHistory {
+	p := new(EAuthSessionSecurityHistory)
+	*p = x
+	return p
+}
+
+func (x EAuthSessionSecurityHistory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAuthSessionSecurityHistory) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[3].Descriptor()
+}
+
+func (EAuthSessionSecurityHistory) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[3]
+}
+
+func (x EAuthSessionSecurityHistory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EAuthSessionSecurityHistory) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EAuthSessionSecurityHistory(num)
+	return nil
+}
+
+// Deprecated: Use EAuthSessionSecurityHistory.Descriptor instead.
+func (EAuthSessionSecurityHistory) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+type ETokenRenewalType int32
+
+const (
+	ETokenRenewalType_k_ETokenRenewalType_None  ETokenRenewalType = 0
+	ETokenRenewalType_k_ETokenRenewalType_Allow ETokenRenewalType = 1
+)
+
+// Enum value maps for ETokenRenewalType.
+var (
+	ETokenRenewalType_name = map[int32]string{
+		0: "k_ETokenRenewalType_None",
+		1: "k_ETokenRenewalType_Allow",
+	}
+	ETokenRenewalType_value = map[string]int32{
+		"k_ETokenRenewalType_None":  0,
+		"k_ETokenRenewalType_Allow": 1,
+	}
+)
+
+func (x ETokenRenewalType) Enum() *ETokenRenewalType {
+	p := new(ETokenRenewalType)
+	*p = x
+	return p
+}
+
+func (x ETokenRenewalType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ETokenRenewalType) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[4].Descriptor()
+}
+
+func (ETokenRenewalType) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[4]
+}
+
+func (x ETokenRenewalType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ETokenRenewalType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ETokenRenewalType(num)
+	return nil
+}
+
+// Deprecated: Use ETokenRenewalType.Descriptor instead.
+func (ETokenRenewalType) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+type EAuthTokenRevokeAction int32
+
+const (
+	EAuthTokenRevokeAction_k_EAuthTokenRevokeLogout                 EAuthTokenRevokeAction = 0
+	EAuthTokenRevokeAction_k_EAuthTokenRevokePermanent              EAuthTokenRevokeAction = 1
+	EAuthTokenRevokeAction_k_EAuthTokenRevokeReplaced               EAuthTokenRevokeAction = 2
+	EAuthTokenRevokeAction_k_EAuthTokenRevokeSupport                EAuthTokenRevokeAction = 3
+	EAuthTokenRevokeAction_k_EAuthTokenRevokeConsume                EAuthTokenRevokeAction = 4
+	EAuthTokenRevokeAction_k_EAuthTokenRevokeNonRememberedLogout    EAuthTokenRevokeAction = 5
+	EAuthTokenRevokeAction_k_EAuthTokenRevokeNonRememberedPermanent EAuthTokenRevokeAction = 6
+	EAuthTokenRevokeAction_k_EAuthTokenRevokeAutomatic              EAuthTokenRevokeAction = 7
+)
+
+// Enum value maps for EAuthTokenRevokeAction.
+var (
+	EAuthTokenRevokeAction_name = map[int32]string{
+		0: "k_EAuthTokenRevokeLogout",
+		1: "k_EAuthTokenRevokePermanent",
+		2: "k_EAuthTokenRevokeReplaced",
+		3: "k_EAuthTokenRevokeSupport",
+		4: "k_EAuthTokenRevokeConsume",
+		5: "k_EAuthTokenRevokeNonRememberedLogout",
+		6: "k_EAuthTokenRevokeNonRememberedPermanent",
+		7: "k_EAuthTokenRevokeAutomatic",
+	}
+	EAuthTokenRevokeAction_value = map[string]int32{
+		"k_EAuthTokenRevokeLogout":                 0,
+		"k_EAuthTokenRevokePermanent":              1,
+		"k_EAuthTokenRevokeReplaced":               2,
+		"k_EAuthTokenRevokeSupport":                3,
+		"k_EAuthTokenRevokeConsume":                4,
+		"k_EAuthTokenRevokeNonRememberedLogout":    5,
+		"k_EAuthTokenRevokeNonRememberedPermanent": 6,
+		"k_EAuthTokenRevokeAutomatic":              7,
+	}
+)
+
+func (x EAuthTokenRevokeAction) Enum() *EAuthTokenRevokeAction {
+	p := new(EAuthTokenRevokeAction)
+	*p = x
+	return p
+}
+
+func (x EAuthTokenRevokeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAuthTokenRevokeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[5].Descriptor()
+}
+
+func (EAuthTokenRevokeAction) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[5]
+}
+
+func (x EAuthTokenRevokeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EAuthTokenRevokeAction) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EAuthTokenRevokeAction(num)
+	return nil
+}
+
+// Deprecated: Use EAuthTokenRevokeAction.Descriptor instead.
+func (EAuthTokenRevokeAction) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{5}
+}
+
+type EAuthTokenState int32
+
+const (
+	EAuthTokenState_k_EAuthTokenState_Invalid   EAuthTokenState = 0
+	EAuthTokenState_k_EAuthTokenState_New       EAuthTokenState = 1
+	EAuthTokenState_k_EAuthTokenState_Confirmed EAuthTokenState = 2
+	EAuthTokenState_k_EAuthTokenState_Issued    EAuthTokenState = 3
+	EAuthTokenState_k_EAuthTokenState_Denied    EAuthTokenState = 4
+	EAuthTokenState_k_EAuthTokenState_LoggedOut EAuthTokenState = 5
+	EAuthTokenState_k_EAuthTokenState_Consumed  EAuthTokenState = 6
+	EAuthTokenState_k_EAuthTokenState_Revoked   EAuthTokenState = 99
+)
+
+// Enum value maps for EAuthTokenState.
+var (
+	EAuthTokenState_name = map[int32]string{
+		0:  "k_EAuthTokenState_Invalid",
+		1:  "k_EAuthTokenState_New",
+		2:  "k_EAuthTokenState_Confirmed",
+		3:  "k_EAuthTokenState_Issued",
+		4:  "k_EAuthTokenState_Denied",
+		5:  "k_EAuthTokenState_LoggedOut",
+		6:  "k_EAuthTokenState_Consumed",
+		99: "k_EAuthTokenState_Revoked",
+	}
+	EAuthTokenState_value = map[string]int32{
+		"k_EAuthTokenState_Invalid":   0,
+		"k_EAuthTokenState_New":       1,
+		"k_EAuthTokenState_Confirmed": 2,
+		"k_EAuthTokenState_Issued":    3,
+		"k_EAuthTokenState_Denied":    4,
+		"k_EAuthTokenState_LoggedOut": 5,
+		"k_EAuthTokenState_Consumed":  6,
+		"k_EAuthTokenState_Revoked":   99,
+	}
+)
+
+func (x EAuthTokenState) Enum() *EAuthTokenState {
+	p := new(EAuthTokenState)
+	*p = x
+	return p
+}
+
+func (x EAuthTokenState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAuthTokenState) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[6].Descriptor()
+}
+
+func (EAuthTokenState) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[6]
+}
+
+func (x EAuthTokenState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EAuthTokenState) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EAuthTokenState(num)
+	return nil
+}
+
+// Deprecated: Use EAuthTokenState.Descriptor instead.
+func (EAuthTokenState) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{6}
+}
+
+type CAuthentication_GetPasswordRSAPublicKey_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   *string                `protobuf:"bytes,1,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Request) Reset() {
+	*x = CAuthentication_GetPasswordRSAPublicKey_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_GetPasswordRSAPublicKey_Request) ProtoMessage() {}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_GetPasswordRSAPublicKey_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_GetPasswordRSAPublicKey_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Request) GetAccountName() string {
+	if x != nil && x.AccountName != nil {
+		return *x.AccountName
+	}
+	return ""
+}
+
+type CAuthentication_GetPasswordRSAPublicKey_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublickeyMod  *string                `protobuf:"bytes,1,opt,name=publickey_mod,json=publickeyMod" json:"publickey_mod,omitempty"`
+	PublickeyExp  *string                `protobuf:"bytes,2,opt,name=publickey_exp,json=publickeyExp" json:"publickey_exp,omitempty"`
+	Timestamp     *uint64                `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) Reset() {
+	*x = CAuthentication_GetPasswordRSAPublicKey_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_GetPasswordRSAPublicKey_Response) ProtoMessage() {}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_GetPasswordRSAPublicKey_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_GetPasswordRSAPublicKey_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) GetPublickeyMod() string {
+	if x != nil && x.PublickeyMod != nil {
+		return *x.PublickeyMod
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) GetPublickeyExp() string {
+	if x != nil && x.PublickeyExp != nil {
+		return *x.PublickeyExp
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) GetTimestamp() uint64 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+type CAuthentication_DeviceDetails struct {
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	DeviceFriendlyName *string                 `protobuf:"bytes,1,opt,name=device_friendly_name,json=deviceFriendlyName" json:"device_friendly_name,omitempty"`
+	PlatformType       *EAuthTokenPlatformType `protobuf:"varint,2,opt,name=platform_type,json=platformType,enum=EAuthTokenPlatformType,def=0" json:"platform_type,omitempty"`
+	OsType             *int32                  `protobuf:"varint,3,opt,name=os_type,json=osType" json:"os_type,omitempty"`
+	GamingDeviceType   *uint32                 `protobuf:"varint,4,opt,name=gaming_device_type,json=gamingDeviceType" json:"gaming_device_type,omitempty"`
+	ClientCount        *uint32                 `protobuf:"varint,5,opt,name=client_count,json=clientCount" json:"client_count,omitempty"`
+	MachineId          []byte                  `protobuf:"bytes,6,opt,name=machine_id,json=machineId" json:"machine_id,omitempty"`
+	AppType            *EAuthTokenAppType      `protobuf:"varint,7,opt,name=app_type,json=appType,enum=EAuthTokenAppType,def=0" json:"app_type,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_DeviceDetails fields.
+const (
+	Default_CAuthentication_DeviceDetails_PlatformType = EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown
+	Default_CAuthentication_DeviceDetails_AppType      = EAuthTokenAppType_k_EAuthTokenAppType_Unknown
+)
+
+func (x *CAuthentication_DeviceDetails) Reset() {
+	*x = CAuthentication_DeviceDetails{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_DeviceDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_DeviceDetails) ProtoMessage() {}
+
+func (x *CAuthentication_DeviceDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_DeviceDetails.ProtoReflect.Descriptor instead.
+func (*CAuthentication_DeviceDetails) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CAuthentication_DeviceDetails) GetDeviceFriendlyName() string {
+	if x != nil && x.DeviceFriendlyName != nil {
+		return *x.DeviceFriendlyName
+	}
+	return ""
+}
+
+func (x *CAuthentication_DeviceDetails) GetPlatformType() EAuthTokenPlatformType {
+	if x != nil && x.PlatformType != nil {
+		return *x.PlatformType
+	}
+	return Default_CAuthentication_DeviceDetails_PlatformType
+}
+
+func (x *CAuthentication_DeviceDetails) GetOsType() int32 {
+	if x != nil && x.OsType != nil {
+		return *x.OsType
+	}
+	return 0
+}
+
+func (x *CAuthentication_DeviceDetails) GetGamingDeviceType() uint32 {
+	if x != nil && x.GamingDeviceType != nil {
+		return *x.GamingDeviceType
+	}
+	return 0
+}
+
+func (x *CAuthentication_DeviceDetails) GetClientCount() uint32 {
+	if x != nil && x.ClientCount != nil {
+		return *x.ClientCount
+	}
+	return 0
+}
+
+func (x *CAuthentication_DeviceDetails) GetMachineId() []byte {
+	if x != nil {
+		return x.MachineId
+	}
+	return nil
+}
+
+func (x *CAuthentication_DeviceDetails) GetAppType() EAuthTokenAppType {
+	if x != nil && x.AppType != nil {
+		return *x.AppType
+	}
+	return Default_CAuthentication_DeviceDetails_AppType
+}
+
+type CAuthentication_BeginAuthSessionViaQR_Request struct {
+	state              protoimpl.MessageState         `protogen:"open.v1"`
+	DeviceFriendlyName *string                        `protobuf:"bytes,1,opt,name=device_friendly_name,json=deviceFriendlyName" json:"device_friendly_name,omitempty"`
+	PlatformType       *EAuthTokenPlatformType        `protobuf:"varint,2,opt,name=platform_type,json=platformType,enum=EAuthTokenPlatformType,def=0" json:"platform_type,omitempty"`
+	DeviceDetails      *CAuthentication_DeviceDetails `protobuf:"bytes,3,opt,name=device_details,json=deviceDetails" json:"device_details,omitempty"`
+	WebsiteId          *string                        `protobuf:"bytes,4,opt,name=website_id,json=websiteId,def=Unknown" json:"website_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_BeginAuthSessionViaQR_Request fields.
+const (
+	Default_CAuthentication_BeginAuthSessionViaQR_Request_PlatformType = EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown
+	Default_CAuthentication_BeginAuthSessionViaQR_Request_WebsiteId    = string("Unknown")
+)
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) Reset() {
+	*x = CAuthentication_BeginAuthSessionViaQR_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_BeginAuthSessionViaQR_Request) ProtoMessage() {}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_BeginAuthSessionViaQR_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_BeginAuthSessionViaQR_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) GetDeviceFriendlyName() string {
+	if x != nil && x.DeviceFriendlyName != nil {
+		return *x.DeviceFriendlyName
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) GetPlatformType() EAuthTokenPlatformType {
+	if x != nil && x.PlatformType != nil {
+		return *x.PlatformType
+	}
+	return Default_CAuthentication_BeginAuthSessionViaQR_Request_PlatformType
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) GetDeviceDetails() *CAuthentication_DeviceDetails {
+	if x != nil {
+		return x.DeviceDetails
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) GetWebsiteId() string {
+	if x != nil && x.WebsiteId != nil {
+		return *x.WebsiteId
+	}
+	return Default_CAuthentication_BeginAuthSessionViaQR_Request_WebsiteId
+}
+
+type CAuthentication_AllowedConfirmation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ConfirmationType  *EAuthSessionGuardType `protobuf:"varint,1,opt,name=confirmation_type,json=confirmationType,enum=EAuthSessionGuardType,def=0" json:"confirmation_type,omitempty"`
+	AssociatedMessage *string                `protobuf:"bytes,2,opt,name=associated_message,json=associatedMessage" json:"associated_message,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_AllowedConfirmation fields.
+const (
+	Default_CAuthentication_AllowedConfirmation_ConfirmationType = EAuthSessionGuardType_k_EAuthSessionGuardType_Unknown
+)
+
+func (x *CAuthentication_AllowedConfirmation) Reset() {
+	*x = CAuthentication_AllowedConfirmation{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_AllowedConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_AllowedConfirmation) ProtoMessage() {}
+
+func (x *CAuthentication_AllowedConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_AllowedConfirmation.ProtoReflect.Descriptor instead.
+func (*CAuthentication_AllowedConfirmation) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CAuthentication_AllowedConfirmation) GetConfirmationType() EAuthSessionGuardType {
+	if x != nil && x.ConfirmationType != nil {
+		return *x.ConfirmationType
+	}
+	return Default_CAuthentication_AllowedConfirmation_ConfirmationType
+}
+
+func (x *CAuthentication_AllowedConfirmation) GetAssociatedMessage() string {
+	if x != nil && x.AssociatedMessage != nil {
+		return *x.AssociatedMessage
+	}
+	return ""
+}
+
+type CAuthentication_BeginAuthSessionViaQR_Response struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	ClientId             *uint64                                `protobuf:"varint,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	ChallengeUrl         *string                                `protobuf:"bytes,2,opt,name=challenge_url,json=challengeUrl" json:"challenge_url,omitempty"`
+	RequestId            []byte                                 `protobuf:"bytes,3,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	Interval             *float32                               `protobuf:"fixed32,4,opt,name=interval" json:"interval,omitempty"`
+	AllowedConfirmations []*CAuthentication_AllowedConfirmation `protobuf:"bytes,5,rep,name=allowed_confirmations,json=allowedConfirmations" json:"allowed_confirmations,omitempty"`
+	Version              *int32                                 `protobuf:"varint,6,opt,name=version" json:"version,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) Reset() {
+	*x = CAuthentication_BeginAuthSessionViaQR_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_BeginAuthSessionViaQR_Response) ProtoMessage() {}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_BeginAuthSessionViaQR_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_BeginAuthSessionViaQR_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetChallengeUrl() string {
+	if x != nil && x.ChallengeUrl != nil {
+		return *x.ChallengeUrl
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetInterval() float32 {
+	if x != nil && x.Interval != nil {
+		return *x.Interval
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetAllowedConfirmations() []*CAuthentication_AllowedConfirmation {
+	if x != nil {
+		return x.AllowedConfirmations
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type CAuthentication_BeginAuthSessionViaCredentials_Request struct {
+	state               protoimpl.MessageState         `protogen:"open.v1"`
+	DeviceFriendlyName  *string                        `protobuf:"bytes,1,opt,name=device_friendly_name,json=deviceFriendlyName" json:"device_friendly_name,omitempty"`
+	AccountName         *string                        `protobuf:"bytes,2,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+	EncryptedPassword   *string                        `protobuf:"bytes,3,opt,name=encrypted_password,json=encryptedPassword" json:"encrypted_password,omitempty"`
+	EncryptionTimestamp *uint64                        `protobuf:"varint,4,opt,name=encryption_timestamp,json=encryptionTimestamp" json:"encryption_timestamp,omitempty"`
+	RememberLogin       *bool                          `protobuf:"varint,5,opt,name=remember_login,json=rememberLogin" json:"remember_login,omitempty"`
+	PlatformType        *EAuthTokenPlatformType        `protobuf:"varint,6,opt,name=platform_type,json=platformType,enum=EAuthTokenPlatformType,def=0" json:"platform_type,omitempty"`
+	Persistence         *ESessionPersistence           `protobuf:"varint,7,opt,name=persistence,enum=ESessionPersistence,def=1" json:"persistence,omitempty"`
+	WebsiteId           *string                        `protobuf:"bytes,8,opt,name=website_id,json=websiteId,def=Unknown" json:"website_id,omitempty"`
+	DeviceDetails       *CAuthentication_DeviceDetails `protobuf:"bytes,9,opt,name=device_details,json=deviceDetails" json:"device_details,omitempty"`
+	GuardData           *string                        `protobuf:"bytes,10,opt,name=guard_data,json=guardData" json:"guard_data,omitempty"`
+	Language            *uint32                        `protobuf:"varint,11,opt,name=language" json:"language,omitempty"`
+	QosLevel            *int32                         `protobuf:"varint,12,opt,name=qos_level,json=qosLevel,def=2" json:"qos_level,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_BeginAuthSessionViaCredentials_Request fields.
+const (
+	Default_CAuthentication_BeginAuthSessionViaCredentials_Request_PlatformType = EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown
+	Default_CAuthentication_BeginAuthSessionViaCredentials_Request_Persistence  = ESessionPersistence_k_ESessionPersistence_Persistent
+	Default_CAuthentication_BeginAuthSessionViaCredentials_Request_WebsiteId    = string("Unknown")
+	Default_CAuthentication_BeginAuthSessionViaCredentials_Request_QosLevel     = int32(2)
+)
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) Reset() {
+	*x = CAuthentication_BeginAuthSessionViaCredentials_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_BeginAuthSessionViaCredentials_Request) ProtoMessage() {}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_BeginAuthSessionViaCredentials_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_BeginAuthSessionViaCredentials_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetDeviceFriendlyName() string {
+	if x != nil && x.DeviceFriendlyName != nil {
+		return *x.DeviceFriendlyName
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetAccountName() string {
+	if x != nil && x.AccountName != nil {
+		return *x.AccountName
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetEncryptedPassword() string {
+	if x != nil && x.EncryptedPassword != nil {
+		return *x.EncryptedPassword
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetEncryptionTimestamp() uint64 {
+	if x != nil && x.EncryptionTimestamp != nil {
+		return *x.EncryptionTimestamp
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetRememberLogin() bool {
+	if x != nil && x.RememberLogin != nil {
+		return *x.RememberLogin
+	}
+	return false
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetPlatformType() EAuthTokenPlatformType {
+	if x != nil && x.PlatformType != nil {
+		return *x.PlatformType
+	}
+	return Default_CAuthentication_BeginAuthSessionViaCredentials_Request_PlatformType
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetPersistence() ESessionPersistence {
+	if x != nil && x.Persistence != nil {
+		return *x.Persistence
+	}
+	return Default_CAuthentication_BeginAuthSessionViaCredentials_Request_Persistence
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetWebsiteId() string {
+	if x != nil && x.WebsiteId != nil {
+		return *x.WebsiteId
+	}
+	return Default_CAuthentication_BeginAuthSessionViaCredentials_Request_WebsiteId
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetDeviceDetails() *CAuthentication_DeviceDetails {
+	if x != nil {
+		return x.DeviceDetails
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetGuardData() string {
+	if x != nil && x.GuardData != nil {
+		return *x.GuardData
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetLanguage() uint32 {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetQosLevel() int32 {
+	if x != nil && x.QosLevel != nil {
+		return *x.QosLevel
+	}
+	return Default_CAuthentication_BeginAuthSessionViaCredentials_Request_QosLevel
+}
+
+type CAuthentication_BeginAuthSessionViaCredentials_Response struct {
+	state                protoimpl.MessageState                 `protogen:"open.v1"`
+	ClientId             *uint64                                `protobuf:"varint,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	RequestId            []byte                                 `protobuf:"bytes,2,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	Interval             *float32                               `protobuf:"fixed32,3,opt,name=interval" json:"interval,omitempty"`
+	AllowedConfirmations []*CAuthentication_AllowedConfirmation `protobuf:"bytes,4,rep,name=allowed_confirmations,json=allowedConfirmations" json:"allowed_confirmations,omitempty"`
+	Steamid              *uint64                                `protobuf:"varint,5,opt,name=steamid" json:"steamid,omitempty"`
+	WeakToken            *string                                `protobuf:"bytes,6,opt,name=weak_token,json=weakToken" json:"weak_token,omitempty"`
+	AgreementSessionUrl  *string                                `protobuf:"bytes,7,opt,name=agreement_session_url,json=agreementSessionUrl" json:"agreement_session_url,omitempty"`
+	ExtendedErrorMessage *string                                `protobuf:"bytes,8,opt,name=extended_error_message,json=extendedErrorMessage" json:"extended_error_message,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) Reset() {
+	*x = CAuthentication_BeginAuthSessionViaCredentials_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_BeginAuthSessionViaCredentials_Response) ProtoMessage() {}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_BeginAuthSessionViaCredentials_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_BeginAuthSessionViaCredentials_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetInterval() float32 {
+	if x != nil && x.Interval != nil {
+		return *x.Interval
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetAllowedConfirmations() []*CAuthentication_AllowedConfirmation {
+	if x != nil {
+		return x.AllowedConfirmations
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetWeakToken() string {
+	if x != nil && x.WeakToken != nil {
+		return *x.WeakToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetAgreementSessionUrl() string {
+	if x != nil && x.AgreementSessionUrl != nil {
+		return *x.AgreementSessionUrl
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetExtendedErrorMessage() string {
+	if x != nil && x.ExtendedErrorMessage != nil {
+		return *x.ExtendedErrorMessage
+	}
+	return ""
+}
+
+type CAuthentication_PollAuthSessionStatus_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *uint64                `protobuf:"varint,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	RequestId     []byte                 `protobuf:"bytes,2,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	TokenToRevoke *uint64                `protobuf:"fixed64,3,opt,name=token_to_revoke,json=tokenToRevoke" json:"token_to_revoke,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) Reset() {
+	*x = CAuthentication_PollAuthSessionStatus_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_PollAuthSessionStatus_Request) ProtoMessage() {}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_PollAuthSessionStatus_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_PollAuthSessionStatus_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) GetTokenToRevoke() uint64 {
+	if x != nil && x.TokenToRevoke != nil {
+		return *x.TokenToRevoke
+	}
+	return 0
+}
+
+type CAuthentication_PollAuthSessionStatus_Response struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	NewClientId          *uint64                `protobuf:"varint,1,opt,name=new_client_id,json=newClientId" json:"new_client_id,omitempty"`
+	NewChallengeUrl      *string                `protobuf:"bytes,2,opt,name=new_challenge_url,json=newChallengeUrl" json:"new_challenge_url,omitempty"`
+	RefreshToken         *string                `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
+	AccessToken          *string                `protobuf:"bytes,4,opt,name=access_token,json=accessToken" json:"access_token,omitempty"`
+	HadRemoteInteraction *bool                  `protobuf:"varint,5,opt,name=had_remote_interaction,json=hadRemoteInteraction" json:"had_remote_interaction,omitempty"`
+	AccountName          *string                `protobuf:"bytes,6,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+	NewGuardData         *string                `protobuf:"bytes,7,opt,name=new_guard_data,json=newGuardData" json:"new_guard_data,omitempty"`
+	AgreementSessionUrl  *string                `protobuf:"bytes,8,opt,name=agreement_session_url,json=agreementSessionUrl" json:"agreement_session_url,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) Reset() {
+	*x = CAuthentication_PollAuthSessionStatus_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_PollAuthSessionStatus_Response) ProtoMessage() {}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_PollAuthSessionStatus_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_PollAuthSessionStatus_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetNewClientId() uint64 {
+	if x != nil && x.NewClientId != nil {
+		return *x.NewClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetNewChallengeUrl() string {
+	if x != nil && x.NewChallengeUrl != nil {
+		return *x.NewChallengeUrl
+	}
+	return ""
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetAccessToken() string {
+	if x != nil && x.AccessToken != nil {
+		return *x.AccessToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetHadRemoteInteraction() bool {
+	if x != nil && x.HadRemoteInteraction != nil {
+		return *x.HadRemoteInteraction
+	}
+	return false
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetAccountName() string {
+	if x != nil && x.AccountName != nil {
+		return *x.AccountName
+	}
+	return ""
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetNewGuardData() string {
+	if x != nil && x.NewGuardData != nil {
+		return *x.NewGuardData
+	}
+	return ""
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetAgreementSessionUrl() string {
+	if x != nil && x.AgreementSessionUrl != nil {
+		return *x.AgreementSessionUrl
+	}
+	return ""
+}
+
+type CAuthentication_GetAuthSessionInfo_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *uint64                `protobuf:"varint,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Request) Reset() {
+	*x = CAuthentication_GetAuthSessionInfo_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_GetAuthSessionInfo_Request) ProtoMessage() {}
+
+func (x *CAuthentication_GetAuthSessionInfo_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_GetAuthSessionInfo_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_GetAuthSessionInfo_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Request) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+type CAuthentication_GetAuthSessionInfo_Response struct {
+	state                     protoimpl.MessageState       `protogen:"open.v1"`
+	Ip                        *string                      `protobuf:"bytes,1,opt,name=ip" json:"ip,omitempty"`
+	Geoloc                    *string                      `protobuf:"bytes,2,opt,name=geoloc" json:"geoloc,omitempty"`
+	City                      *string                      `protobuf:"bytes,3,opt,name=city" json:"city,omitempty"`
+	State                     *string                      `protobuf:"bytes,4,opt,name=state" json:"state,omitempty"`
+	Country                   *string                      `protobuf:"bytes,5,opt,name=country" json:"country,omitempty"`
+	PlatformType              *EAuthTokenPlatformType      `protobuf:"varint,6,opt,name=platform_type,json=platformType,enum=EAuthTokenPlatformType,def=0" json:"platform_type,omitempty"`
+	DeviceFriendlyName        *string                      `protobuf:"bytes,7,opt,name=device_friendly_name,json=deviceFriendlyName" json:"device_friendly_name,omitempty"`
+	Version                   *int32                       `protobuf:"varint,8,opt,name=version" json:"version,omitempty"`
+	LoginHistory              *EAuthSessionSecurityHistory `protobuf:"varint,9,opt,name=login_history,json=loginHistory,enum=EAuthSessionSecurityHistory,def=0" json:"login_history,omitempty"`
+	RequestorLocationMismatch *bool                        `protobuf:"varint,10,opt,name=requestor_location_mismatch,json=requestorLocationMismatch" json:"requestor_location_mismatch,omitempty"`
+	HighUsageLogin            *bool                        `protobuf:"varint,11,opt,name=high_usage_login,json=highUsageLogin" json:"high_usage_login,omitempty"`
+	RequestedPersistence      *ESessionPersistence         `protobuf:"varint,12,opt,name=requested_persistence,json=requestedPersistence,enum=ESessionPersistence,def=-1" json:"requested_persistence,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_GetAuthSessionInfo_Response fields.
+const (
+	Default_CAuthentication_GetAuthSessionInfo_Response_PlatformType         = EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown
+	Default_CAuthentication_GetAuthSessionInfo_Response_LoginHistory         = EAuthSessionSecurityHistory_k_EAuthSessionSecurityHistory_Invalid
+	Default_CAuthentication_GetAuthSessionInfo_Response_RequestedPersistence = ESessionPersistence_k_ESessionPersistence_Invalid
+)
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) Reset() {
+	*x = CAuthentication_GetAuthSessionInfo_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_GetAuthSessionInfo_Response) ProtoMessage() {}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_GetAuthSessionInfo_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_GetAuthSessionInfo_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetIp() string {
+	if x != nil && x.Ip != nil {
+		return *x.Ip
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetGeoloc() string {
+	if x != nil && x.Geoloc != nil {
+		return *x.Geoloc
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetPlatformType() EAuthTokenPlatformType {
+	if x != nil && x.PlatformType != nil {
+		return *x.PlatformType
+	}
+	return Default_CAuthentication_GetAuthSessionInfo_Response_PlatformType
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetDeviceFriendlyName() string {
+	if x != nil && x.DeviceFriendlyName != nil {
+		return *x.DeviceFriendlyName
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetLoginHistory() EAuthSessionSecurityHistory {
+	if x != nil && x.LoginHistory != nil {
+		return *x.LoginHistory
+	}
+	return Default_CAuthentication_GetAuthSessionInfo_Response_LoginHistory
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetRequestorLocationMismatch() bool {
+	if x != nil && x.RequestorLocationMismatch != nil {
+		return *x.RequestorLocationMismatch
+	}
+	return false
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetHighUsageLogin() bool {
+	if x != nil && x.HighUsageLogin != nil {
+		return *x.HighUsageLogin
+	}
+	return false
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetRequestedPersistence() ESessionPersistence {
+	if x != nil && x.RequestedPersistence != nil {
+		return *x.RequestedPersistence
+	}
+	return Default_CAuthentication_GetAuthSessionInfo_Response_RequestedPersistence
+}
+
+type CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *int32                 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	ClientId      *uint64                `protobuf:"varint,2,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	Steamid       *uint64                `protobuf:"fixed64,3,opt,name=steamid" json:"steamid,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,4,opt,name=signature" json:"signature,omitempty"`
+	Confirm       *bool                  `protobuf:"varint,5,opt,name=confirm,def=0" json:"confirm,omitempty"`
+	Persistence   *ESessionPersistence   `protobuf:"varint,6,opt,name=persistence,enum=ESessionPersistence,def=1" json:"persistence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request fields.
+const (
+	Default_CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request_Confirm     = bool(false)
+	Default_CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request_Persistence = ESessionPersistence_k_ESessionPersistence_Persistent
+)
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) Reset() {
+	*x = CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) ProtoMessage() {}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetConfirm() bool {
+	if x != nil && x.Confirm != nil {
+		return *x.Confirm
+	}
+	return Default_CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request_Confirm
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetPersistence() ESessionPersistence {
+	if x != nil && x.Persistence != nil {
+		return *x.Persistence
+	}
+	return Default_CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request_Persistence
+}
+
+type CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response) Reset() {
+	*x = CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response) ProtoMessage() {}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{13}
+}
+
+type CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *uint64                `protobuf:"varint,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	Steamid       *uint64                `protobuf:"fixed64,2,opt,name=steamid" json:"steamid,omitempty"`
+	Code          *string                `protobuf:"bytes,3,opt,name=code" json:"code,omitempty"`
+	CodeType      *EAuthSessionGuardType `protobuf:"varint,4,opt,name=code_type,json=codeType,enum=EAuthSessionGuardType,def=0" json:"code_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request fields.
+const (
+	Default_CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request_CodeType = EAuthSessionGuardType_k_EAuthSessionGuardType_Unknown
+)
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) Reset() {
+	*x = CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) ProtoMessage() {}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) GetCodeType() EAuthSessionGuardType {
+	if x != nil && x.CodeType != nil {
+		return *x.CodeType
+	}
+	return Default_CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request_CodeType
+}
+
+type CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AgreementSessionUrl *string                `protobuf:"bytes,7,opt,name=agreement_session_url,json=agreementSessionUrl" json:"agreement_session_url,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) Reset() {
+	*x = CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) ProtoMessage() {}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) GetAgreementSessionUrl() string {
+	if x != nil && x.AgreementSessionUrl != nil {
+		return *x.AgreementSessionUrl
+	}
+	return ""
+}
+
+type CAuthentication_AccessToken_GenerateForApp_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  *string                `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
+	Steamid       *uint64                `protobuf:"fixed64,2,opt,name=steamid" json:"steamid,omitempty"`
+	RenewalType   *ETokenRenewalType     `protobuf:"varint,3,opt,name=renewal_type,json=renewalType,enum=ETokenRenewalType,def=0" json:"renewal_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_AccessToken_GenerateForApp_Request fields.
+const (
+	Default_CAuthentication_AccessToken_GenerateForApp_Request_RenewalType = ETokenRenewalType_k_ETokenRenewalType_None
+)
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) Reset() {
+	*x = CAuthentication_AccessToken_GenerateForApp_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_AccessToken_GenerateForApp_Request) ProtoMessage() {}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_AccessToken_GenerateForApp_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_AccessToken_GenerateForApp_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) GetRenewalType() ETokenRenewalType {
+	if x != nil && x.RenewalType != nil {
+		return *x.RenewalType
+	}
+	return Default_CAuthentication_AccessToken_GenerateForApp_Request_RenewalType
+}
+
+type CAuthentication_AccessToken_GenerateForApp_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   *string                `protobuf:"bytes,1,opt,name=access_token,json=accessToken" json:"access_token,omitempty"`
+	RefreshToken  *string                `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Response) Reset() {
+	*x = CAuthentication_AccessToken_GenerateForApp_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_AccessToken_GenerateForApp_Response) ProtoMessage() {}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_AccessToken_GenerateForApp_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_AccessToken_GenerateForApp_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Response) GetAccessToken() string {
+	if x != nil && x.AccessToken != nil {
+		return *x.AccessToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Response) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+type CAuthentication_RefreshToken_Enumerate_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Request) Reset() {
+	*x = CAuthentication_RefreshToken_Enumerate_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_RefreshToken_Enumerate_Request) ProtoMessage() {}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_RefreshToken_Enumerate_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_RefreshToken_Enumerate_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{18}
+}
+
+type CAuthentication_RefreshToken_Enumerate_Response struct {
+	state           protoimpl.MessageState                                                     `protogen:"open.v1"`
+	RefreshTokens   []*CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription `protobuf:"bytes,1,rep,name=refresh_tokens,json=refreshTokens" json:"refresh_tokens,omitempty"`
+	RequestingToken *uint64                                                                    `protobuf:"fixed64,2,opt,name=requesting_token,json=requestingToken" json:"requesting_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response) Reset() {
+	*x = CAuthentication_RefreshToken_Enumerate_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_RefreshToken_Enumerate_Response) ProtoMessage() {}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_RefreshToken_Enumerate_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_RefreshToken_Enumerate_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response) GetRefreshTokens() []*CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription {
+	if x != nil {
+		return x.RefreshTokens
+	}
+	return nil
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response) GetRequestingToken() uint64 {
+	if x != nil && x.RequestingToken != nil {
+		return *x.RequestingToken
+	}
+	return 0
+}
+
+type CAuthentication_GetAuthSessionsForAccount_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_GetAuthSessionsForAccount_Request) Reset() {
+	*x = CAuthentication_GetAuthSessionsForAccount_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_GetAuthSessionsForAccount_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_GetAuthSessionsForAccount_Request) ProtoMessage() {}
+
+func (x *CAuthentication_GetAuthSessionsForAccount_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_GetAuthSessionsForAccount_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_GetAuthSessionsForAccount_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{20}
+}
+
+type CAuthentication_GetAuthSessionsForAccount_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientIds     []uint64               `protobuf:"varint,1,rep,name=client_ids,json=clientIds" json:"client_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_GetAuthSessionsForAccount_Response) Reset() {
+	*x = CAuthentication_GetAuthSessionsForAccount_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_GetAuthSessionsForAccount_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_GetAuthSessionsForAccount_Response) ProtoMessage() {}
+
+func (x *CAuthentication_GetAuthSessionsForAccount_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_GetAuthSessionsForAccount_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_GetAuthSessionsForAccount_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CAuthentication_GetAuthSessionsForAccount_Response) GetClientIds() []uint64 {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+type CAuthentication_MigrateMobileSession_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Steamid       *uint64                `protobuf:"fixed64,1,opt,name=steamid" json:"steamid,omitempty"`
+	Token         *string                `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+	Signature     *string                `protobuf:"bytes,3,opt,name=signature" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_MigrateMobileSession_Request) Reset() {
+	*x = CAuthentication_MigrateMobileSession_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_MigrateMobileSession_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_MigrateMobileSession_Request) ProtoMessage() {}
+
+func (x *CAuthentication_MigrateMobileSession_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_MigrateMobileSession_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_MigrateMobileSession_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CAuthentication_MigrateMobileSession_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CAuthentication_MigrateMobileSession_Request) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *CAuthentication_MigrateMobileSession_Request) GetSignature() string {
+	if x != nil && x.Signature != nil {
+		return *x.Signature
+	}
+	return ""
+}
+
+type CAuthentication_MigrateMobileSession_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  *string                `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
+	AccessToken   *string                `protobuf:"bytes,2,opt,name=access_token,json=accessToken" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_MigrateMobileSession_Response) Reset() {
+	*x = CAuthentication_MigrateMobileSession_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_MigrateMobileSession_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_MigrateMobileSession_Response) ProtoMessage() {}
+
+func (x *CAuthentication_MigrateMobileSession_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_MigrateMobileSession_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_MigrateMobileSession_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CAuthentication_MigrateMobileSession_Response) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_MigrateMobileSession_Response) GetAccessToken() string {
+	if x != nil && x.AccessToken != nil {
+		return *x.AccessToken
+	}
+	return ""
+}
+
+type CAuthentication_Token_Revoke_Request struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Token         *string                 `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	RevokeAction  *EAuthTokenRevokeAction `protobuf:"varint,2,opt,name=revoke_action,json=revokeAction,enum=EAuthTokenRevokeAction,def=1" json:"revoke_action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_Token_Revoke_Request fields.
+const (
+	Default_CAuthentication_Token_Revoke_Request_RevokeAction = EAuthTokenRevokeAction_k_EAuthTokenRevokePermanent
+)
+
+func (x *CAuthentication_Token_Revoke_Request) Reset() {
+	*x = CAuthentication_Token_Revoke_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_Token_Revoke_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_Token_Revoke_Request) ProtoMessage() {}
+
+func (x *CAuthentication_Token_Revoke_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_Token_Revoke_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_Token_Revoke_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CAuthentication_Token_Revoke_Request) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *CAuthentication_Token_Revoke_Request) GetRevokeAction() EAuthTokenRevokeAction {
+	if x != nil && x.RevokeAction != nil {
+		return *x.RevokeAction
+	}
+	return Default_CAuthentication_Token_Revoke_Request_RevokeAction
+}
+
+type CAuthentication_Token_Revoke_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_Token_Revoke_Response) Reset() {
+	*x = CAuthentication_Token_Revoke_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_Token_Revoke_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_Token_Revoke_Response) ProtoMessage() {}
+
+func (x *CAuthentication_Token_Revoke_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_Token_Revoke_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_Token_Revoke_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{25}
+}
+
+type CAuthentication_RefreshToken_Revoke_Request struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	TokenId       *uint64                 `protobuf:"fixed64,1,opt,name=token_id,json=tokenId" json:"token_id,omitempty"`
+	Steamid       *uint64                 `protobuf:"fixed64,2,opt,name=steamid" json:"steamid,omitempty"`
+	RevokeAction  *EAuthTokenRevokeAction `protobuf:"varint,3,opt,name=revoke_action,json=revokeAction,enum=EAuthTokenRevokeAction,def=1" json:"revoke_action,omitempty"`
+	Signature     []byte                  `protobuf:"bytes,4,opt,name=signature" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_RefreshToken_Revoke_Request fields.
+const (
+	Default_CAuthentication_RefreshToken_Revoke_Request_RevokeAction = EAuthTokenRevokeAction_k_EAuthTokenRevokePermanent
+)
+
+func (x *CAuthentication_RefreshToken_Revoke_Request) Reset() {
+	*x = CAuthentication_RefreshToken_Revoke_Request{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_RefreshToken_Revoke_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_RefreshToken_Revoke_Request) ProtoMessage() {}
+
+func (x *CAuthentication_RefreshToken_Revoke_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_RefreshToken_Revoke_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_RefreshToken_Revoke_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CAuthentication_RefreshToken_Revoke_Request) GetTokenId() uint64 {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return 0
+}
+
+func (x *CAuthentication_RefreshToken_Revoke_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CAuthentication_RefreshToken_Revoke_Request) GetRevokeAction() EAuthTokenRevokeAction {
+	if x != nil && x.RevokeAction != nil {
+		return *x.RevokeAction
+	}
+	return Default_CAuthentication_RefreshToken_Revoke_Request_RevokeAction
+}
+
+func (x *CAuthentication_RefreshToken_Revoke_Request) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type CAuthentication_RefreshToken_Revoke_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_RefreshToken_Revoke_Response) Reset() {
+	*x = CAuthentication_RefreshToken_Revoke_Response{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_RefreshToken_Revoke_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_RefreshToken_Revoke_Response) ProtoMessage() {}
+
+func (x *CAuthentication_RefreshToken_Revoke_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_RefreshToken_Revoke_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_RefreshToken_Revoke_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{27}
+}
+
+type CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *uint32                `protobuf:"varint,1,opt,name=time" json:"time,omitempty"`
+	Ip            *CMsgIPAddress         `protobuf:"bytes,2,opt,name=ip" json:"ip,omitempty"`
+	Locale        *string                `protobuf:"bytes,3,opt,name=locale" json:"locale,omitempty"`
+	Country       *string                `protobuf:"bytes,4,opt,name=country" json:"country,omitempty"`
+	State         *string                `protobuf:"bytes,5,opt,name=state" json:"state,omitempty"`
+	City          *string                `protobuf:"bytes,6,opt,name=city" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) Reset() {
+	*x = CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) ProtoMessage() {}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent.ProtoReflect.Descriptor instead.
+func (*CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) GetTime() uint32 {
+	if x != nil && x.Time != nil {
+		return *x.Time
+	}
+	return 0
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) GetIp() *CMsgIPAddress {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+type CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription struct {
+	state            protoimpl.MessageState                                           `protogen:"open.v1"`
+	TokenId          *uint64                                                          `protobuf:"fixed64,1,opt,name=token_id,json=tokenId" json:"token_id,omitempty"`
+	TokenDescription *string                                                          `protobuf:"bytes,2,opt,name=token_description,json=tokenDescription" json:"token_description,omitempty"`
+	TimeUpdated      *uint32                                                          `protobuf:"varint,3,opt,name=time_updated,json=timeUpdated" json:"time_updated,omitempty"`
+	PlatformType     *EAuthTokenPlatformType                                          `protobuf:"varint,4,opt,name=platform_type,json=platformType,enum=EAuthTokenPlatformType,def=0" json:"platform_type,omitempty"`
+	LoggedIn         *bool                                                            `protobuf:"varint,5,opt,name=logged_in,json=loggedIn" json:"logged_in,omitempty"`
+	OsPlatform       *uint32                                                          `protobuf:"varint,6,opt,name=os_platform,json=osPlatform" json:"os_platform,omitempty"`
+	AuthType         *uint32                                                          `protobuf:"varint,7,opt,name=auth_type,json=authType" json:"auth_type,omitempty"`
+	GamingDeviceType *uint32                                                          `protobuf:"varint,8,opt,name=gaming_device_type,json=gamingDeviceType" json:"gaming_device_type,omitempty"`
+	FirstSeen        *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent `protobuf:"bytes,9,opt,name=first_seen,json=firstSeen" json:"first_seen,omitempty"`
+	LastSeen         *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent `protobuf:"bytes,10,opt,name=last_seen,json=lastSeen" json:"last_seen,omitempty"`
+	OsType           *int32                                                           `protobuf:"varint,11,opt,name=os_type,json=osType" json:"os_type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+// Default values for CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription fields.
+const (
+	Default_CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription_PlatformType = EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown
+)
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) Reset() {
+	*x = CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription{}
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) ProtoMessage() {}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription.ProtoReflect.Descriptor instead.
+func (*CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetTokenId() uint64 {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return 0
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetTokenDescription() string {
+	if x != nil && x.TokenDescription != nil {
+		return *x.TokenDescription
+	}
+	return ""
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetTimeUpdated() uint32 {
+	if x != nil && x.TimeUpdated != nil {
+		return *x.TimeUpdated
+	}
+	return 0
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetPlatformType() EAuthTokenPlatformType {
+	if x != nil && x.PlatformType != nil {
+		return *x.PlatformType
+	}
+	return Default_CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription_PlatformType
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetLoggedIn() bool {
+	if x != nil && x.LoggedIn != nil {
+		return *x.LoggedIn
+	}
+	return false
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetOsPlatform() uint32 {
+	if x != nil && x.OsPlatform != nil {
+		return *x.OsPlatform
+	}
+	return 0
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetAuthType() uint32 {
+	if x != nil && x.AuthType != nil {
+		return *x.AuthType
+	}
+	return 0
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetGamingDeviceType() uint32 {
+	if x != nil && x.GamingDeviceType != nil {
+		return *x.GamingDeviceType
+	}
+	return 0
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetFirstSeen() *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetLastSeen() *CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription) GetOsType() int32 {
+	if x != nil && x.OsType != nil {
+		return *x.OsType
+	}
+	return 0
+}
+
+var File_steammessages_auth_steamclient_proto protoreflect.FileDescriptor
+
+const file_steammessages_auth_steamclient_proto_rawDesc = "" +
+	"\n" +
+	"$steammessages_auth.steamclient.proto\x1a\x18steammessages_base.proto\x1a\venums.proto\"T\n" +
+	"/CAuthentication_GetPasswordRSAPublicKey_Request\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\"\x9a\x01\n" +
+	"0CAuthentication_GetPasswordRSAPublicKey_Response\x12#\n" +
+	"\rpublickey_mod\x18\x01 \x01(\tR\fpublickeyMod\x12#\n" +
+	"\rpublickey_exp\x18\x02 \x01(\tR\fpublickeyExp\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x04R\ttimestamp\"\x86\x03\n" +
+	"\x1dCAuthentication_DeviceDetails\x120\n" +
+	"\x14device_friendly_name\x18\x01 \x01(\tR\x12deviceFriendlyName\x12^\n" +
+	"\rplatform_type\x18\x02 \x01(\x0e2\x17.EAuthTokenPlatformType: k_EAuthTokenPlatformType_UnknownR\fplatformType\x12\x17\n" +
+	"\aos_type\x18\x03 \x01(\x05R\x06osType\x12,\n" +
+	"\x12gaming_device_type\x18\x04 \x01(\rR\x10gamingDeviceType\x12!\n" +
+	"\fclient_count\x18\x05 \x01(\rR\vclientCount\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x06 \x01(\fR\tmachineId\x12J\n" +
+	"\bapp_type\x18\a \x01(\x0e2\x12.EAuthTokenAppType:\x1bk_EAuthTokenAppType_UnknownR\aappType\"\xb0\x02\n" +
+	"-CAuthentication_BeginAuthSessionViaQR_Request\x120\n" +
+	"\x14device_friendly_name\x18\x01 \x01(\tR\x12deviceFriendlyName\x12^\n" +
+	"\rplatform_type\x18\x02 \x01(\x0e2\x17.EAuthTokenPlatformType: k_EAuthTokenPlatformType_UnknownR\fplatformType\x12E\n" +
+	"\x0edevice_details\x18\x03 \x01(\v2\x1e.CAuthentication_DeviceDetailsR\rdeviceDetails\x12&\n" +
+	"\n" +
+	"website_id\x18\x04 \x01(\t:\aUnknownR\twebsiteId\"\xba\x01\n" +
+	"#CAuthentication_AllowedConfirmation\x12d\n" +
+	"\x11confirmation_type\x18\x01 \x01(\x0e2\x16.EAuthSessionGuardType:\x1fk_EAuthSessionGuardType_UnknownR\x10confirmationType\x12-\n" +
+	"\x12associated_message\x18\x02 \x01(\tR\x11associatedMessage\"\xa2\x02\n" +
+	".CAuthentication_BeginAuthSessionViaQR_Response\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\x04R\bclientId\x12#\n" +
+	"\rchallenge_url\x18\x02 \x01(\tR\fchallengeUrl\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\fR\trequestId\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\x02R\binterval\x12Y\n" +
+	"\x15allowed_confirmations\x18\x05 \x03(\v2$.CAuthentication_AllowedConfirmationR\x14allowedConfirmations\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x05R\aversion\"\x9a\x05\n" +
+	"6CAuthentication_BeginAuthSessionViaCredentials_Request\x120\n" +
+	"\x14device_friendly_name\x18\x01 \x01(\tR\x12deviceFriendlyName\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12-\n" +
+	"\x12encrypted_password\x18\x03 \x01(\tR\x11encryptedPassword\x121\n" +
+	"\x14encryption_timestamp\x18\x04 \x01(\x04R\x13encryptionTimestamp\x12%\n" +
+	"\x0eremember_login\x18\x05 \x01(\bR\rrememberLogin\x12^\n" +
+	"\rplatform_type\x18\x06 \x01(\x0e2\x17.EAuthTokenPlatformType: k_EAuthTokenPlatformType_UnknownR\fplatformType\x12X\n" +
+	"\vpersistence\x18\a \x01(\x0e2\x14.ESessionPersistence: k_ESessionPersistence_PersistentR\vpersistence\x12&\n" +
+	"\n" +
+	"website_id\x18\b \x01(\t:\aUnknownR\twebsiteId\x12E\n" +
+	"\x0edevice_details\x18\t \x01(\v2\x1e.CAuthentication_DeviceDetailsR\rdeviceDetails\x12\x1d\n" +
+	"\n" +
+	"guard_data\x18\n" +
+	" \x01(\tR\tguardData\x12\x1a\n" +
+	"\blanguage\x18\v \x01(\rR\blanguage\x12\x1e\n" +
+	"\tqos_level\x18\f \x01(\x05:\x012R\bqosLevel\"\x8f\x03\n" +
+	"7CAuthentication_BeginAuthSessionViaCredentials_Response\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\x04R\bclientId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\fR\trequestId\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\x02R\binterval\x12Y\n" +
+	"\x15allowed_confirmations\x18\x04 \x03(\v2$.CAuthentication_AllowedConfirmationR\x14allowedConfirmations\x12\x18\n" +
+	"\asteamid\x18\x05 \x01(\x04R\asteamid\x12\x1d\n" +
+	"\n" +
+	"weak_token\x18\x06 \x01(\tR\tweakToken\x122\n" +
+	"\x15agreement_session_url\x18\a \x01(\tR\x13agreementSessionUrl\x124\n" +
+	"\x16extended_error_message\x18\b \x01(\tR\x14extendedErrorMessage\"\x93\x01\n" +
+	"-CAuthentication_PollAuthSessionStatus_Request\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\x04R\bclientId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\fR\trequestId\x12&\n" +
+	"\x0ftoken_to_revoke\x18\x03 \x01(\x06R\rtokenToRevoke\"\xfb\x02\n" +
+	".CAuthentication_PollAuthSessionStatus_Response\x12\"\n" +
+	"\rnew_client_id\x18\x01 \x01(\x04R\vnewClientId\x12*\n" +
+	"\x11new_challenge_url\x18\x02 \x01(\tR\x0fnewChallengeUrl\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12!\n" +
+	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x124\n" +
+	"\x16had_remote_interaction\x18\x05 \x01(\bR\x14hadRemoteInteraction\x12!\n" +
+	"\faccount_name\x18\x06 \x01(\tR\vaccountName\x12$\n" +
+	"\x0enew_guard_data\x18\a \x01(\tR\fnewGuardData\x122\n" +
+	"\x15agreement_session_url\x18\b \x01(\tR\x13agreementSessionUrl\"I\n" +
+	"*CAuthentication_GetAuthSessionInfo_Request\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\x04R\bclientId\"\x83\x05\n" +
+	"+CAuthentication_GetAuthSessionInfo_Response\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06geoloc\x18\x02 \x01(\tR\x06geoloc\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12^\n" +
+	"\rplatform_type\x18\x06 \x01(\x0e2\x17.EAuthTokenPlatformType: k_EAuthTokenPlatformType_UnknownR\fplatformType\x120\n" +
+	"\x14device_friendly_name\x18\a \x01(\tR\x12deviceFriendlyName\x12\x18\n" +
+	"\aversion\x18\b \x01(\x05R\aversion\x12h\n" +
+	"\rlogin_history\x18\t \x01(\x0e2\x1c.EAuthSessionSecurityHistory:%k_EAuthSessionSecurityHistory_InvalidR\floginHistory\x12>\n" +
+	"\x1brequestor_location_mismatch\x18\n" +
+	" \x01(\bR\x19requestorLocationMismatch\x12(\n" +
+	"\x10high_usage_login\x18\v \x01(\bR\x0ehighUsageLogin\x12h\n" +
+	"\x15requested_persistence\x18\f \x01(\x0e2\x14.ESessionPersistence:\x1dk_ESessionPersistence_InvalidR\x14requestedPersistence\"\xab\x02\n" +
+	"?CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\x04R\bclientId\x12\x18\n" +
+	"\asteamid\x18\x03 \x01(\x06R\asteamid\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\fR\tsignature\x12\x1f\n" +
+	"\aconfirm\x18\x05 \x01(\b:\x05falseR\aconfirm\x12X\n" +
+	"\vpersistence\x18\x06 \x01(\x0e2\x14.ESessionPersistence: k_ESessionPersistence_PersistentR\vpersistence\"B\n" +
+	"@CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response\"\xde\x01\n" +
+	";CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\x04R\bclientId\x12\x18\n" +
+	"\asteamid\x18\x02 \x01(\x06R\asteamid\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12T\n" +
+	"\tcode_type\x18\x04 \x01(\x0e2\x16.EAuthSessionGuardType:\x1fk_EAuthSessionGuardType_UnknownR\bcodeType\"r\n" +
+	"<CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response\x122\n" +
+	"\x15agreement_session_url\x18\a \x01(\tR\x13agreementSessionUrl\"\xc4\x01\n" +
+	"2CAuthentication_AccessToken_GenerateForApp_Request\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12\x18\n" +
+	"\asteamid\x18\x02 \x01(\x06R\asteamid\x12O\n" +
+	"\frenewal_type\x18\x03 \x01(\x0e2\x12.ETokenRenewalType:\x18k_ETokenRenewalType_NoneR\vrenewalType\"}\n" +
+	"3CAuthentication_AccessToken_GenerateForApp_Response\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"0\n" +
+	".CAuthentication_RefreshToken_Enumerate_Request\"\xba\a\n" +
+	"/CAuthentication_RefreshToken_Enumerate_Response\x12o\n" +
+	"\x0erefresh_tokens\x18\x01 \x03(\v2H.CAuthentication_RefreshToken_Enumerate_Response.RefreshTokenDescriptionR\rrefreshTokens\x12)\n" +
+	"\x10requesting_token\x18\x02 \x01(\x06R\x0frequestingToken\x1a\xa1\x01\n" +
+	"\x0fTokenUsageEvent\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\rR\x04time\x12\x1e\n" +
+	"\x02ip\x18\x02 \x01(\v2\x0e.CMsgIPAddressR\x02ip\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x1a\xc6\x04\n" +
+	"\x17RefreshTokenDescription\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\x06R\atokenId\x12+\n" +
+	"\x11token_description\x18\x02 \x01(\tR\x10tokenDescription\x12!\n" +
+	"\ftime_updated\x18\x03 \x01(\rR\vtimeUpdated\x12^\n" +
+	"\rplatform_type\x18\x04 \x01(\x0e2\x17.EAuthTokenPlatformType: k_EAuthTokenPlatformType_UnknownR\fplatformType\x12\x1b\n" +
+	"\tlogged_in\x18\x05 \x01(\bR\bloggedIn\x12\x1f\n" +
+	"\vos_platform\x18\x06 \x01(\rR\n" +
+	"osPlatform\x12\x1b\n" +
+	"\tauth_type\x18\a \x01(\rR\bauthType\x12,\n" +
+	"\x12gaming_device_type\x18\b \x01(\rR\x10gamingDeviceType\x12_\n" +
+	"\n" +
+	"first_seen\x18\t \x01(\v2@.CAuthentication_RefreshToken_Enumerate_Response.TokenUsageEventR\tfirstSeen\x12]\n" +
+	"\tlast_seen\x18\n" +
+	" \x01(\v2@.CAuthentication_RefreshToken_Enumerate_Response.TokenUsageEventR\blastSeen\x12\x17\n" +
+	"\aos_type\x18\v \x01(\x05R\x06osType\"3\n" +
+	"1CAuthentication_GetAuthSessionsForAccount_Request\"S\n" +
+	"2CAuthentication_GetAuthSessionsForAccount_Response\x12\x1d\n" +
+	"\n" +
+	"client_ids\x18\x01 \x03(\x04R\tclientIds\"|\n" +
+	",CAuthentication_MigrateMobileSession_Request\x12\x18\n" +
+	"\asteamid\x18\x01 \x01(\x06R\asteamid\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\"w\n" +
+	"-CAuthentication_MigrateMobileSession_Response\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"\x97\x01\n" +
+	"$CAuthentication_Token_Revoke_Request\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12Y\n" +
+	"\rrevoke_action\x18\x02 \x01(\x0e2\x17.EAuthTokenRevokeAction:\x1bk_EAuthTokenRevokePermanentR\frevokeAction\"'\n" +
+	"%CAuthentication_Token_Revoke_Response\"\xdb\x01\n" +
+	"+CAuthentication_RefreshToken_Revoke_Request\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\x06R\atokenId\x12\x18\n" +
+	"\asteamid\x18\x02 \x01(\x06R\asteamid\x12Y\n" +
+	"\rrevoke_action\x18\x03 \x01(\x0e2\x17.EAuthTokenRevokeAction:\x1bk_EAuthTokenRevokePermanentR\frevokeAction\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\fR\tsignature\".\n" +
+	",CAuthentication_RefreshToken_Revoke_Response*\xb9\x01\n" +
+	"\x16EAuthTokenPlatformType\x12$\n" +
+	" k_EAuthTokenPlatformType_Unknown\x10\x00\x12(\n" +
+	"$k_EAuthTokenPlatformType_SteamClient\x10\x01\x12'\n" +
+	"#k_EAuthTokenPlatformType_WebBrowser\x10\x02\x12&\n" +
+	"\"k_EAuthTokenPlatformType_MobileApp\x10\x03*\x85\x01\n" +
+	"\x11EAuthTokenAppType\x12\x1f\n" +
+	"\x1bk_EAuthTokenAppType_Unknown\x10\x00\x12'\n" +
+	"#k_EAuthTokenAppType_Mobile_SteamApp\x10\x01\x12&\n" +
+	"\"k_EAuthTokenAppType_Mobile_ChatApp\x10\x02*\xe5\x02\n" +
+	"\x15EAuthSessionGuardType\x12#\n" +
+	"\x1fk_EAuthSessionGuardType_Unknown\x10\x00\x12 \n" +
+	"\x1ck_EAuthSessionGuardType_None\x10\x01\x12%\n" +
+	"!k_EAuthSessionGuardType_EmailCode\x10\x02\x12&\n" +
+	"\"k_EAuthSessionGuardType_DeviceCode\x10\x03\x12.\n" +
+	"*k_EAuthSessionGuardType_DeviceConfirmation\x10\x04\x12-\n" +
+	")k_EAuthSessionGuardType_EmailConfirmation\x10\x05\x12(\n" +
+	"$k_EAuthSessionGuardType_MachineToken\x10\x06\x12-\n" +
+	")k_EAuthSessionGuardType_LegacyMachineAuth\x10\a*\xac\x01\n" +
+	"\x1bEAuthSessionSecurityHistory\x12)\n" +
+	"%k_EAuthSessionSecurityHistory_Invalid\x10\x00\x120\n" +
+	",k_EAuthSessionSecurityHistory_UsedPreviously\x10\x01\x120\n" +
+	",k_EAuthSessionSecurityHistory_NoPriorHistory\x10\x02*P\n" +
+	"\x11ETokenRenewalType\x12\x1c\n" +
+	"\x18k_ETokenRenewalType_None\x10\x00\x12\x1d\n" +
+	"\x19k_ETokenRenewalType_Allow\x10\x01*\xaf\x02\n" +
+	"\x16EAuthTokenRevokeAction\x12\x1c\n" +
+	"\x18k_EAuthTokenRevokeLogout\x10\x00\x12\x1f\n" +
+	"\x1bk_EAuthTokenRevokePermanent\x10\x01\x12\x1e\n" +
+	"\x1ak_EAuthTokenRevokeReplaced\x10\x02\x12\x1d\n" +
+	"\x19k_EAuthTokenRevokeSupport\x10\x03\x12\x1d\n" +
+	"\x19k_EAuthTokenRevokeConsume\x10\x04\x12)\n" +
+	"%k_EAuthTokenRevokeNonRememberedLogout\x10\x05\x12,\n" +
+	"(k_EAuthTokenRevokeNonRememberedPermanent\x10\x06\x12\x1f\n" +
+	"\x1bk_EAuthTokenRevokeAutomatic\x10\a*\x88\x02\n" +
+	"\x0fEAuthTokenState\x12\x1d\n" +
+	"\x19k_EAuthTokenState_Invalid\x10\x00\x12\x19\n" +
+	"\x15k_EAuthTokenState_New\x10\x01\x12\x1f\n" +
+	"\x1bk_EAuthTokenState_Confirmed\x10\x02\x12\x1c\n" +
+	"\x18k_EAuthTokenState_Issued\x10\x03\x12\x1c\n" +
+	"\x18k_EAuthTokenState_Denied\x10\x04\x12\x1f\n" +
+	"\x1bk_EAuthTokenState_LoggedOut\x10\x05\x12\x1e\n" +
+	"\x1ak_EAuthTokenState_Consumed\x10\x06\x12\x1d\n" +
+	"\x19k_EAuthTokenState_Revoked\x10c2\xaf\r\n" +
+	"\x0eAuthentication\x12~\n" +
+	"\x17GetPasswordRSAPublicKey\x120.CAuthentication_GetPasswordRSAPublicKey_Request\x1a1.CAuthentication_GetPasswordRSAPublicKey_Response\x12x\n" +
+	"\x15BeginAuthSessionViaQR\x12..CAuthentication_BeginAuthSessionViaQR_Request\x1a/.CAuthentication_BeginAuthSessionViaQR_Response\x12\x93\x01\n" +
+	"\x1eBeginAuthSessionViaCredentials\x127.CAuthentication_BeginAuthSessionViaCredentials_Request\x1a8.CAuthentication_BeginAuthSessionViaCredentials_Response\x12x\n" +
+	"\x15PollAuthSessionStatus\x12..CAuthentication_PollAuthSessionStatus_Request\x1a/.CAuthentication_PollAuthSessionStatus_Response\x12o\n" +
+	"\x12GetAuthSessionInfo\x12+.CAuthentication_GetAuthSessionInfo_Request\x1a,.CAuthentication_GetAuthSessionInfo_Response\x12\xae\x01\n" +
+	"'UpdateAuthSessionWithMobileConfirmation\x12@.CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request\x1aA.CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response\x12\xa2\x01\n" +
+	"#UpdateAuthSessionWithSteamGuardCode\x12<.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request\x1a=.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response\x12\x86\x01\n" +
+	"\x19GenerateAccessTokenForApp\x123.CAuthentication_AccessToken_GenerateForApp_Request\x1a4.CAuthentication_AccessToken_GenerateForApp_Response\x12t\n" +
+	"\x0fEnumerateTokens\x12/.CAuthentication_RefreshToken_Enumerate_Request\x1a0.CAuthentication_RefreshToken_Enumerate_Response\x12\x84\x01\n" +
+	"\x19GetAuthSessionsForAccount\x122.CAuthentication_GetAuthSessionsForAccount_Request\x1a3.CAuthentication_GetAuthSessionsForAccount_Response\x12u\n" +
+	"\x14MigrateMobileSession\x12-.CAuthentication_MigrateMobileSession_Request\x1a..CAuthentication_MigrateMobileSession_Response\x12\\\n" +
+	"\vRevokeToken\x12%.CAuthentication_Token_Revoke_Request\x1a&.CAuthentication_Token_Revoke_Response\x12q\n" +
+	"\x12RevokeRefreshToken\x12,.CAuthentication_RefreshToken_Revoke_Request\x1a-.CAuthentication_RefreshToken_Revoke_ResponseB\x03\x80\x01\x01"
+
+var (
+	file_steammessages_auth_steamclient_proto_rawDescOnce sync.Once
+	file_steammessages_auth_steamclient_proto_rawDescData []byte
+)
+
+func file_steammessages_auth_steamclient_proto_rawDescGZIP() []byte {
+	file_steammessages_auth_steamclient_proto_rawDescOnce.Do(func() {
+		file_steammessages_auth_steamclient_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_steammessages_auth_steamclient_proto_rawDesc), len(file_steammessages_auth_steamclient_proto_rawDesc)))
+	})
+	return file_steammessages_auth_steamclient_proto_rawDescData
+}
+
+var file_steammessages_auth_steamclient_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_steammessages_auth_steamclient_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_steammessages_auth_steamclient_proto_goTypes = []any{
+	(EAuthTokenPlatformType)(0),                                                     // 0: EAuthTokenPlatformType
+	(EAuthTokenAppType)(0),                                                          // 1: EAuthTokenAppType
+	(EAuthSessionGuardType)(0),                                                      // 2: EAuthSessionGuardType
+	(EAuthSessionSecurityHistory)(0),                                                // 3: EAuthSessionSecurityHistory
+	(ETokenRenewalType)(0),                                                          // 4: ETokenRenewalType
+	(EAuthTokenRevokeAction)(0),                                                     // 5: EAuthTokenRevokeAction
+	(EAuthTokenState)(0),                                                            // 6: EAuthTokenState
+	(*CAuthentication_GetPasswordRSAPublicKey_Request)(nil),                         // 7: CAuthentication_GetPasswordRSAPublicKey_Request
+	(*CAuthentication_GetPasswordRSAPublicKey_Response)(nil),                        // 8: CAuthentication_GetPasswordRSAPublicKey_Response
+	(*CAuthentication_DeviceDetails)(nil),                                           // 9: CAuthentication_DeviceDetails
+	(*CAuthentication_BeginAuthSessionViaQR_Request)(nil),                           // 10: CAuthentication_BeginAuthSessionViaQR_Request
+	(*CAuthentication_AllowedConfirmation)(nil),                                     // 11: CAuthentication_AllowedConfirmation
+	(*CAuthentication_BeginAuthSessionViaQR_Response)(nil),                          // 12: CAuthentication_BeginAuthSessionViaQR_Response
+	(*CAuthentication_BeginAuthSessionViaCredentials_Request)(nil),                  // 13: CAuthentication_BeginAuthSessionViaCredentials_Request
+	(*CAuthentication_BeginAuthSessionViaCredentials_Response)(nil),                 // 14: CAuthentication_BeginAuthSessionViaCredentials_Response
+	(*CAuthentication_PollAuthSessionStatus_Request)(nil),                           // 15: CAuthentication_PollAuthSessionStatus_Request
+	(*CAuthentication_PollAuthSessionStatus_Response)(nil),                          // 16: CAuthentication_PollAuthSessionStatus_Response
+	(*CAuthentication_GetAuthSessionInfo_Request)(nil),                              // 17: CAuthentication_GetAuthSessionInfo_Request
+	(*CAuthentication_GetAuthSessionInfo_Response)(nil),                             // 18: CAuthentication_GetAuthSessionInfo_Response
+	(*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request)(nil),         // 19: CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request
+	(*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response)(nil),        // 20: CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response
+	(*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request)(nil),             // 21: CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request
+	(*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response)(nil),            // 22: CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response
+	(*CAuthentication_AccessToken_GenerateForApp_Request)(nil),                      // 23: CAuthentication_AccessToken_GenerateForApp_Request
+	(*CAuthentication_AccessToken_GenerateForApp_Response)(nil),                     // 24: CAuthentication_AccessToken_GenerateForApp_Response
+	(*CAuthentication_RefreshToken_Enumerate_Request)(nil),                          // 25: CAuthentication_RefreshToken_Enumerate_Request
+	(*CAuthentication_RefreshToken_Enumerate_Response)(nil),                         // 26: CAuthentication_RefreshToken_Enumerate_Response
+	(*CAuthentication_GetAuthSessionsForAccount_Request)(nil),                       // 27: CAuthentication_GetAuthSessionsForAccount_Request
+	(*CAuthentication_GetAuthSessionsForAccount_Response)(nil),                      // 28: CAuthentication_GetAuthSessionsForAccount_Response
+	(*CAuthentication_MigrateMobileSession_Request)(nil),                            // 29: CAuthentication_MigrateMobileSession_Request
+	(*CAuthentication_MigrateMobileSession_Response)(nil),                           // 30: CAuthentication_MigrateMobileSession_Response
+	(*CAuthentication_Token_Revoke_Request)(nil),                                    // 31: CAuthentication_Token_Revoke_Request
+	(*CAuthentication_Token_Revoke_Response)(nil),                                   // 32: CAuthentication_Token_Revoke_Response
+	(*CAuthentication_RefreshToken_Revoke_Request)(nil),                             // 33: CAuthentication_RefreshToken_Revoke_Request
+	(*CAuthentication_RefreshToken_Revoke_Response)(nil),                            // 34: CAuthentication_RefreshToken_Revoke_Response
+	(*CAuthentication_RefreshToken_Enumerate_Response_TokenUsageEvent)(nil),         // 35: CAuthentication_RefreshToken_Enumerate_Response.TokenUsageEvent
+	(*CAuthentication_RefreshToken_Enumerate_Response_RefreshTokenDescription)(nil), // 36: CAuthentication_RefreshToken_Enumerate_Response.RefreshTokenDescription
+	(ESessionPersistence)(0),                                                        // 37: ESessionPersistence
+	(*CMsgIPAddress)(nil),                                                           // 38: CMsgIPAddress
+}
+var file_steammessages_auth_steamclient_proto_depIdxs = []int32{
+	0,  // 0: CAuthentication_DeviceDetails.platform_type:type_name -> EAuthTokenPlatformType
+	1,  // 1: CAuthentication_DeviceDetails.app_type:type_name -> EAuthTokenAppType
+	0,  // 2: CAuthentication_BeginAuthSessionViaQR_Request.platform_type:type_name -> EAuthTokenPlatformType
+	9,  // 3: CAuthentication_BeginAuthSessionViaQR_Request.device_details:type_name -> CAuthentication_DeviceDetails
+	2,  // 4: CAuthentication_AllowedConfirmation.confirmation_type:type_name -> EAuthSessionGuardType
+	11, // 5: CAuthentication_BeginAuthSessionViaQR_Response.allowed_confirmations:type_name -> CAuthentication_AllowedConfirmation
+	0,  // 6: CAuthentication_BeginAuthSessionViaCredentials_Request.platform_type:type_name -> EAuthTokenPlatformType
+	37, // 7: CAuthentication_BeginAuthSessionViaCredentials_Request.persistence:type_name -> ESessionPersistence
+	9,  // 8: CAuthentication_BeginAuthSessionViaCredentials_Request.device_details:type_name -> CAuthentication_DeviceDetails
+	11, // 9: CAuthentication_BeginAuthSessionViaCredentials_Response.allowed_confirmations:type_name -> CAuthentication_AllowedConfirmation
+	0,  // 10: CAuthentication_GetAuthSessionInfo_Response.platform_type:type_name -> EAuthTokenPlatformType
+	3,  // 11: CAuthentication_GetAuthSessionInfo_Response.login_history:type_name -> EAuthSessionSecurityHistory
+	37, // 12: CAuthentication_GetAuthSessionInfo_Response.requested_persistence:type_name -> ESessionPersistence
+	37, // 13: CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request.persistence:type_name -> ESessionPersistence
+	2,  // 14: CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request.code_type:type_name -> EAuthSessionGuardType
+	4,  // 15: CAuthentication_AccessToken_GenerateForApp_Request.renewal_type:type_name -> ETokenRenewalType
+	36, // 16: CAuthentication_RefreshToken_Enumerate_Response.refresh_tokens:type_name -> CAuthentication_RefreshToken_Enumerate_Response.RefreshTokenDescription
+	5,  // 17: CAuthentication_Token_Revoke_Request.revoke_action:type_name -> EAuthTokenRevokeAction
+	5,  // 18: CAuthentication_RefreshToken_Revoke_Request.revoke_action:type_name -> EAuthTokenRevokeAction
+	38, // 19: CAuthentication_RefreshToken_Enumerate_Response.TokenUsageEvent.ip:type_name -> CMsgIPAddress
+	0,  // 20: CAuthentication_RefreshToken_Enumerate_Response.RefreshTokenDescription.platform_type:type_name -> EAuthTokenPlatformType
+	35, // 21: CAuthentication_RefreshToken_Enumerate_Response.RefreshTokenDescription.first_seen:type_name -> CAuthentication_RefreshToken_Enumerate_Response.TokenUsageEvent
+	35, // 22: CAuthentication_RefreshToken_Enumerate_Response.RefreshTokenDescription.last_seen:type_name -> CAuthentication_RefreshToken_Enumerate_Response.TokenUsageEvent
+	7,  // 23: Authentication.GetPasswordRSAPublicKey:input_type -> CAuthentication_GetPasswordRSAPublicKey_Request
+	10, // 24: Authentication.BeginAuthSessionViaQR:input_type -> CAuthentication_BeginAuthSessionViaQR_Request
+	13, // 25: Authentication.BeginAuthSessionViaCredentials:input_type -> CAuthentication_BeginAuthSessionViaCredentials_Request
+	15, // 26: Authentication.PollAuthSessionStatus:input_type -> CAuthentication_PollAuthSessionStatus_Request
+	17, // 27: Authentication.GetAuthSessionInfo:input_type -> CAuthentication_GetAuthSessionInfo_Request
+	19, // 28: Authentication.UpdateAuthSessionWithMobileConfirmation:input_type -> CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request
+	21, // 29: Authentication.UpdateAuthSessionWithSteamGuardCode:input_type -> CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request
+	23, // 30: Authentication.GenerateAccessTokenForApp:input_type -> CAuthentication_AccessToken_GenerateForApp_Request
+	25, // 31: Authentication.EnumerateTokens:input_type -> CAuthentication_RefreshToken_Enumerate_Request
+	27, // 32: Authentication.GetAuthSessionsForAccount:input_type -> CAuthentication_GetAuthSessionsForAccount_Request
+	29, // 33: Authentication.MigrateMobileSession:input_type -> CAuthentication_MigrateMobileSession_Request
+	31, // 34: Authentication.RevokeToken:input_type -> CAuthentication_Token_Revoke_Request
+	33, // 35: Authentication.RevokeRefreshToken:input_type -> CAuthentication_RefreshToken_Revoke_Request
+	8,  // 36: Authentication.GetPasswordRSAPublicKey:output_type -> CAuthentication_GetPasswordRSAPublicKey_Response
+	12, // 37: Authentication.BeginAuthSessionViaQR:output_type -> CAuthentication_BeginAuthSessionViaQR_Response
+	14, // 38: Authentication.BeginAuthSessionViaCredentials:output_type -> CAuthentication_BeginAuthSessionViaCredentials_Response
+	16, // 39: Authentication.PollAuthSessionStatus:output_type -> CAuthentication_PollAuthSessionStatus_Response
+	18, // 40: Authentication.GetAuthSessionInfo:output_type -> CAuthentication_GetAuthSessionInfo_Response
+	20, // 41: Authentication.UpdateAuthSessionWithMobileConfirmation:output_type -> CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response
+	22, // 42: Authentication.UpdateAuthSessionWithSteamGuardCode:output_type -> CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response
+	24, // 43: Authentication.GenerateAccessTokenForApp:output_type -> CAuthentication_AccessToken_GenerateForApp_Response
+	26, // 44: Authentication.EnumerateTokens:output_type -> CAuthentication_RefreshToken_Enumerate_Response
+	28, // 45: Authentication.GetAuthSessionsForAccount:output_type -> CAuthentication_GetAuthSessionsForAccount_Response
+	30, // 46: Authentication.MigrateMobileSession:output_type -> CAuthentication_MigrateMobileSession_Response
+	32, // 47: Authentication.RevokeToken:output_type -> CAuthentication_Token_Revoke_Response
+	34, // 48: Authentication.RevokeRefreshToken:output_type -> CAuthentication_RefreshToken_Revoke_Response
+	36, // [36:49] is the sub-list for method output_type
+	23, // [23:36] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_steammessages_auth_steamclient_proto_init() }
+func file_steammessages_auth_steamclient_proto_init() {
+	if File_steammessages_auth_steamclient_proto != nil {
+		return
+	}
+	file_steammessages_base_proto_init()
+	file_enums_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_steammessages_auth_steamclient_proto_rawDesc), len(file_steammessages_auth_steamclient_proto_rawDesc)),
+			NumEnums:      7,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_steammessages_auth_steamclient_proto_goTypes,
+		DependencyIndexes: file_steammessages_auth_steamclient_proto_depIdxs,
+		EnumInfos:         file_steammessages_auth_steamclient_proto_enumTypes,
+		MessageInfos:      file_steammessages_auth_steamclient_proto_msgTypes,
+	}.Build()
+	File_steammessages_auth_steamclient_proto = out.File
+	file_steammessages_auth_steamclient_proto_goTypes = nil
+	file_steammessages_auth_steamclient_proto_depIdxs = nil
+}
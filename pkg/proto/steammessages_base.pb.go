@@ -0,0 +1,166 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: steammessages_base.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CMsgIPAddress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Ip:
+	//
+	//	*CMsgIPAddress_V4
+	//	*CMsgIPAddress_V6
+	Ip            isCMsgIPAddress_Ip `protobuf_oneof:"ip"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CMsgIPAddress) Reset() {
+	*x = CMsgIPAddress{}
+	mi := &file_steammessages_base_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CMsgIPAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMsgIPAddress) ProtoMessage() {}
+
+func (x *CMsgIPAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_base_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMsgIPAddress.ProtoReflect.Descriptor instead.
+func (*CMsgIPAddress) Descriptor() ([]byte, []int) {
+	return file_steammessages_base_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CMsgIPAddress) GetIp() isCMsgIPAddress_Ip {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *CMsgIPAddress) GetV4() uint32 {
+	if x != nil {
+		if x, ok := x.Ip.(*CMsgIPAddress_V4); ok {
+			return x.V4
+		}
+	}
+	return 0
+}
+
+func (x *CMsgIPAddress) GetV6() []byte {
+	if x != nil {
+		if x, ok := x.Ip.(*CMsgIPAddress_V6); ok {
+			return x.V6
+		}
+	}
+	return nil
+}
+
+type isCMsgIPAddress_Ip interface {
+	isCMsgIPAddress_Ip()
+}
+
+type CMsgIPAddress_V4 struct {
+	V4 uint32 `protobuf:"fixed32,1,opt,name=v4,oneof"`
+}
+
+type CMsgIPAddress_V6 struct {
+	V6 []byte `protobuf:"bytes,2,opt,name=v6,oneof"`
+}
+
+func (*CMsgIPAddress_V4) isCMsgIPAddress_Ip() {}
+
+func (*CMsgIPAddress_V6) isCMsgIPAddress_Ip() {}
+
+var File_steammessages_base_proto protoreflect.FileDescriptor
+
+const file_steammessages_base_proto_rawDesc = "" +
+	"\n" +
+	"\x18steammessages_base.proto\"9\n" +
+	"\rCMsgIPAddress\x12\x10\n" +
+	"\x02v4\x18\x01 \x01(\aH\x00R\x02v4\x12\x10\n" +
+	"\x02v6\x18\x02 \x01(\fH\x00R\x02v6B\x04\n" +
+	"\x02ip"
+
+var (
+	file_steammessages_base_proto_rawDescOnce sync.Once
+	file_steammessages_base_proto_rawDescData []byte
+)
+
+func file_steammessages_base_proto_rawDescGZIP() []byte {
+	file_steammessages_base_proto_rawDescOnce.Do(func() {
+		file_steammessages_base_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_steammessages_base_proto_rawDesc), len(file_steammessages_base_proto_rawDesc)))
+	})
+	return file_steammessages_base_proto_rawDescData
+}
+
+var file_steammessages_base_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_steammessages_base_proto_goTypes = []any{
+	(*CMsgIPAddress)(nil), // 0: CMsgIPAddress
+}
+var file_steammessages_base_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_steammessages_base_proto_init() }
+func file_steammessages_base_proto_init() {
+	if File_steammessages_base_proto != nil {
+		return
+	}
+	file_steammessages_base_proto_msgTypes[0].OneofWrappers = []any{
+		(*CMsgIPAddress_V4)(nil),
+		(*CMsgIPAddress_V6)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_steammessages_base_proto_rawDesc), len(file_steammessages_base_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_steammessages_base_proto_goTypes,
+		DependencyIndexes: file_steammessages_base_proto_depIdxs,
+		MessageInfos:      file_steammessages_base_proto_msgTypes,
+	}.Build()
+	File_steammessages_base_proto = out.File
+	file_steammessages_base_proto_goTypes = nil
+	file_steammessages_base_proto_depIdxs = nil
+}
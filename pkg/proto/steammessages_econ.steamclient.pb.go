@@ -0,0 +1,1329 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: steammessages_econ.steamclient.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CEcon_GetInventoryItemsWithDescriptions_Request struct {
+	state                     protoimpl.MessageState                                         `protogen:"open.v1"`
+	Steamid                   *uint64                                                        `protobuf:"fixed64,1,opt,name=steamid" json:"steamid,omitempty"`
+	Appid                     *uint32                                                        `protobuf:"varint,2,opt,name=appid" json:"appid,omitempty"`
+	Contextid                 *uint64                                                        `protobuf:"varint,3,opt,name=contextid" json:"contextid,omitempty"`
+	GetDescriptions           *bool                                                          `protobuf:"varint,4,opt,name=get_descriptions,json=getDescriptions" json:"get_descriptions,omitempty"`
+	ForTradeOfferVerification *bool                                                          `protobuf:"varint,10,opt,name=for_trade_offer_verification,json=forTradeOfferVerification" json:"for_trade_offer_verification,omitempty"`
+	Language                  *string                                                        `protobuf:"bytes,5,opt,name=language" json:"language,omitempty"`
+	Filters                   *CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions `protobuf:"bytes,6,opt,name=filters" json:"filters,omitempty"`
+	StartAssetid              *uint64                                                        `protobuf:"varint,8,opt,name=start_assetid,json=startAssetid" json:"start_assetid,omitempty"`
+	Count                     *int32                                                         `protobuf:"varint,9,opt,name=count" json:"count,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) Reset() {
+	*x = CEcon_GetInventoryItemsWithDescriptions_Request{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEcon_GetInventoryItemsWithDescriptions_Request) ProtoMessage() {}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEcon_GetInventoryItemsWithDescriptions_Request.ProtoReflect.Descriptor instead.
+func (*CEcon_GetInventoryItemsWithDescriptions_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) GetContextid() uint64 {
+	if x != nil && x.Contextid != nil {
+		return *x.Contextid
+	}
+	return 0
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) GetGetDescriptions() bool {
+	if x != nil && x.GetDescriptions != nil {
+		return *x.GetDescriptions
+	}
+	return false
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) GetForTradeOfferVerification() bool {
+	if x != nil && x.ForTradeOfferVerification != nil {
+		return *x.ForTradeOfferVerification
+	}
+	return false
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) GetFilters() *CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) GetStartAssetid() uint64 {
+	if x != nil && x.StartAssetid != nil {
+		return *x.StartAssetid
+	}
+	return 0
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type CEcon_Asset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appid         *uint32                `protobuf:"varint,1,opt,name=appid" json:"appid,omitempty"`
+	Contextid     *uint64                `protobuf:"varint,2,opt,name=contextid" json:"contextid,omitempty"`
+	Assetid       *uint64                `protobuf:"varint,3,opt,name=assetid" json:"assetid,omitempty"`
+	Classid       *uint64                `protobuf:"varint,4,opt,name=classid" json:"classid,omitempty"`
+	Instanceid    *uint64                `protobuf:"varint,5,opt,name=instanceid" json:"instanceid,omitempty"`
+	Currencyid    *uint32                `protobuf:"varint,6,opt,name=currencyid" json:"currencyid,omitempty"`
+	Amount        *int64                 `protobuf:"varint,7,opt,name=amount" json:"amount,omitempty"`
+	Missing       *bool                  `protobuf:"varint,8,opt,name=missing" json:"missing,omitempty"`
+	EstUsd        *int64                 `protobuf:"varint,9,opt,name=est_usd,json=estUsd" json:"est_usd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CEcon_Asset) Reset() {
+	*x = CEcon_Asset{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEcon_Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEcon_Asset) ProtoMessage() {}
+
+func (x *CEcon_Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEcon_Asset.ProtoReflect.Descriptor instead.
+func (*CEcon_Asset) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CEcon_Asset) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CEcon_Asset) GetContextid() uint64 {
+	if x != nil && x.Contextid != nil {
+		return *x.Contextid
+	}
+	return 0
+}
+
+func (x *CEcon_Asset) GetAssetid() uint64 {
+	if x != nil && x.Assetid != nil {
+		return *x.Assetid
+	}
+	return 0
+}
+
+func (x *CEcon_Asset) GetClassid() uint64 {
+	if x != nil && x.Classid != nil {
+		return *x.Classid
+	}
+	return 0
+}
+
+func (x *CEcon_Asset) GetInstanceid() uint64 {
+	if x != nil && x.Instanceid != nil {
+		return *x.Instanceid
+	}
+	return 0
+}
+
+func (x *CEcon_Asset) GetCurrencyid() uint32 {
+	if x != nil && x.Currencyid != nil {
+		return *x.Currencyid
+	}
+	return 0
+}
+
+func (x *CEcon_Asset) GetAmount() int64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *CEcon_Asset) GetMissing() bool {
+	if x != nil && x.Missing != nil {
+		return *x.Missing
+	}
+	return false
+}
+
+func (x *CEcon_Asset) GetEstUsd() int64 {
+	if x != nil && x.EstUsd != nil {
+		return *x.EstUsd
+	}
+	return 0
+}
+
+type CEconItem_DescriptionLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *string                `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	Value         *string                `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	Color         *string                `protobuf:"bytes,3,opt,name=color" json:"color,omitempty"`
+	Label         *string                `protobuf:"bytes,4,opt,name=label" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CEconItem_DescriptionLine) Reset() {
+	*x = CEconItem_DescriptionLine{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEconItem_DescriptionLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEconItem_DescriptionLine) ProtoMessage() {}
+
+func (x *CEconItem_DescriptionLine) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEconItem_DescriptionLine.ProtoReflect.Descriptor instead.
+func (*CEconItem_DescriptionLine) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CEconItem_DescriptionLine) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *CEconItem_DescriptionLine) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *CEconItem_DescriptionLine) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *CEconItem_DescriptionLine) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+type CEconItem_Action struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *string                `protobuf:"bytes,1,opt,name=link" json:"link,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CEconItem_Action) Reset() {
+	*x = CEconItem_Action{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEconItem_Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEconItem_Action) ProtoMessage() {}
+
+func (x *CEconItem_Action) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEconItem_Action.ProtoReflect.Descriptor instead.
+func (*CEconItem_Action) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CEconItem_Action) GetLink() string {
+	if x != nil && x.Link != nil {
+		return *x.Link
+	}
+	return ""
+}
+
+func (x *CEconItem_Action) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type CEconItem_Tag struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Appid                 *uint32                `protobuf:"varint,1,opt,name=appid" json:"appid,omitempty"`
+	Category              *string                `protobuf:"bytes,2,opt,name=category" json:"category,omitempty"`
+	InternalName          *string                `protobuf:"bytes,3,opt,name=internal_name,json=internalName" json:"internal_name,omitempty"`
+	LocalizedCategoryName *string                `protobuf:"bytes,4,opt,name=localized_category_name,json=localizedCategoryName" json:"localized_category_name,omitempty"`
+	LocalizedTagName      *string                `protobuf:"bytes,5,opt,name=localized_tag_name,json=localizedTagName" json:"localized_tag_name,omitempty"`
+	Color                 *string                `protobuf:"bytes,6,opt,name=color" json:"color,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CEconItem_Tag) Reset() {
+	*x = CEconItem_Tag{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEconItem_Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEconItem_Tag) ProtoMessage() {}
+
+func (x *CEconItem_Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEconItem_Tag.ProtoReflect.Descriptor instead.
+func (*CEconItem_Tag) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CEconItem_Tag) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CEconItem_Tag) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *CEconItem_Tag) GetInternalName() string {
+	if x != nil && x.InternalName != nil {
+		return *x.InternalName
+	}
+	return ""
+}
+
+func (x *CEconItem_Tag) GetLocalizedCategoryName() string {
+	if x != nil && x.LocalizedCategoryName != nil {
+		return *x.LocalizedCategoryName
+	}
+	return ""
+}
+
+func (x *CEconItem_Tag) GetLocalizedTagName() string {
+	if x != nil && x.LocalizedTagName != nil {
+		return *x.LocalizedTagName
+	}
+	return ""
+}
+
+func (x *CEconItem_Tag) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+type CEconItem_Description struct {
+	state                        protoimpl.MessageState       `protogen:"open.v1"`
+	Appid                        *int32                       `protobuf:"varint,1,opt,name=appid" json:"appid,omitempty"`
+	Classid                      *uint64                      `protobuf:"varint,2,opt,name=classid" json:"classid,omitempty"`
+	Instanceid                   *uint64                      `protobuf:"varint,3,opt,name=instanceid" json:"instanceid,omitempty"`
+	Currency                     *bool                        `protobuf:"varint,4,opt,name=currency" json:"currency,omitempty"`
+	BackgroundColor              *string                      `protobuf:"bytes,5,opt,name=background_color,json=backgroundColor" json:"background_color,omitempty"`
+	IconUrl                      *string                      `protobuf:"bytes,6,opt,name=icon_url,json=iconUrl" json:"icon_url,omitempty"`
+	IconUrlLarge                 *string                      `protobuf:"bytes,7,opt,name=icon_url_large,json=iconUrlLarge" json:"icon_url_large,omitempty"`
+	Descriptions                 []*CEconItem_DescriptionLine `protobuf:"bytes,8,rep,name=descriptions" json:"descriptions,omitempty"`
+	Tradable                     *bool                        `protobuf:"varint,9,opt,name=tradable" json:"tradable,omitempty"`
+	Actions                      []*CEconItem_Action          `protobuf:"bytes,10,rep,name=actions" json:"actions,omitempty"`
+	OwnerDescriptions            []*CEconItem_DescriptionLine `protobuf:"bytes,11,rep,name=owner_descriptions,json=ownerDescriptions" json:"owner_descriptions,omitempty"`
+	OwnerActions                 []*CEconItem_Action          `protobuf:"bytes,12,rep,name=owner_actions,json=ownerActions" json:"owner_actions,omitempty"`
+	Fraudwarnings                []string                     `protobuf:"bytes,13,rep,name=fraudwarnings" json:"fraudwarnings,omitempty"`
+	Name                         *string                      `protobuf:"bytes,14,opt,name=name" json:"name,omitempty"`
+	NameColor                    *string                      `protobuf:"bytes,15,opt,name=name_color,json=nameColor" json:"name_color,omitempty"`
+	Type                         *string                      `protobuf:"bytes,16,opt,name=type" json:"type,omitempty"`
+	MarketName                   *string                      `protobuf:"bytes,17,opt,name=market_name,json=marketName" json:"market_name,omitempty"`
+	MarketHashName               *string                      `protobuf:"bytes,18,opt,name=market_hash_name,json=marketHashName" json:"market_hash_name,omitempty"`
+	MarketFee                    *string                      `protobuf:"bytes,19,opt,name=market_fee,json=marketFee" json:"market_fee,omitempty"`
+	ContainedItem                *CEconItem_Description       `protobuf:"bytes,20,opt,name=contained_item,json=containedItem" json:"contained_item,omitempty"`
+	MarketActions                []*CEconItem_Action          `protobuf:"bytes,21,rep,name=market_actions,json=marketActions" json:"market_actions,omitempty"`
+	Commodity                    *bool                        `protobuf:"varint,22,opt,name=commodity" json:"commodity,omitempty"`
+	MarketTradableRestriction    *int32                       `protobuf:"varint,23,opt,name=market_tradable_restriction,json=marketTradableRestriction" json:"market_tradable_restriction,omitempty"`
+	MarketMarketableRestriction  *int32                       `protobuf:"varint,24,opt,name=market_marketable_restriction,json=marketMarketableRestriction" json:"market_marketable_restriction,omitempty"`
+	Marketable                   *bool                        `protobuf:"varint,25,opt,name=marketable" json:"marketable,omitempty"`
+	Tags                         []*CEconItem_Tag             `protobuf:"bytes,26,rep,name=tags" json:"tags,omitempty"`
+	ItemExpiration               *string                      `protobuf:"bytes,27,opt,name=item_expiration,json=itemExpiration" json:"item_expiration,omitempty"`
+	MarketFeeApp                 *int32                       `protobuf:"varint,28,opt,name=market_fee_app,json=marketFeeApp" json:"market_fee_app,omitempty"`
+	MarketBuyCountryRestriction  *string                      `protobuf:"bytes,30,opt,name=market_buy_country_restriction,json=marketBuyCountryRestriction" json:"market_buy_country_restriction,omitempty"`
+	MarketSellCountryRestriction *string                      `protobuf:"bytes,31,opt,name=market_sell_country_restriction,json=marketSellCountryRestriction" json:"market_sell_country_restriction,omitempty"`
+	Sealed                       *bool                        `protobuf:"varint,32,opt,name=sealed" json:"sealed,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *CEconItem_Description) Reset() {
+	*x = CEconItem_Description{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEconItem_Description) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEconItem_Description) ProtoMessage() {}
+
+func (x *CEconItem_Description) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEconItem_Description.ProtoReflect.Descriptor instead.
+func (*CEconItem_Description) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CEconItem_Description) GetAppid() int32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CEconItem_Description) GetClassid() uint64 {
+	if x != nil && x.Classid != nil {
+		return *x.Classid
+	}
+	return 0
+}
+
+func (x *CEconItem_Description) GetInstanceid() uint64 {
+	if x != nil && x.Instanceid != nil {
+		return *x.Instanceid
+	}
+	return 0
+}
+
+func (x *CEconItem_Description) GetCurrency() bool {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return false
+}
+
+func (x *CEconItem_Description) GetBackgroundColor() string {
+	if x != nil && x.BackgroundColor != nil {
+		return *x.BackgroundColor
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetIconUrl() string {
+	if x != nil && x.IconUrl != nil {
+		return *x.IconUrl
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetIconUrlLarge() string {
+	if x != nil && x.IconUrlLarge != nil {
+		return *x.IconUrlLarge
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetDescriptions() []*CEconItem_DescriptionLine {
+	if x != nil {
+		return x.Descriptions
+	}
+	return nil
+}
+
+func (x *CEconItem_Description) GetTradable() bool {
+	if x != nil && x.Tradable != nil {
+		return *x.Tradable
+	}
+	return false
+}
+
+func (x *CEconItem_Description) GetActions() []*CEconItem_Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *CEconItem_Description) GetOwnerDescriptions() []*CEconItem_DescriptionLine {
+	if x != nil {
+		return x.OwnerDescriptions
+	}
+	return nil
+}
+
+func (x *CEconItem_Description) GetOwnerActions() []*CEconItem_Action {
+	if x != nil {
+		return x.OwnerActions
+	}
+	return nil
+}
+
+func (x *CEconItem_Description) GetFraudwarnings() []string {
+	if x != nil {
+		return x.Fraudwarnings
+	}
+	return nil
+}
+
+func (x *CEconItem_Description) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetNameColor() string {
+	if x != nil && x.NameColor != nil {
+		return *x.NameColor
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetMarketName() string {
+	if x != nil && x.MarketName != nil {
+		return *x.MarketName
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetMarketHashName() string {
+	if x != nil && x.MarketHashName != nil {
+		return *x.MarketHashName
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetMarketFee() string {
+	if x != nil && x.MarketFee != nil {
+		return *x.MarketFee
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetContainedItem() *CEconItem_Description {
+	if x != nil {
+		return x.ContainedItem
+	}
+	return nil
+}
+
+func (x *CEconItem_Description) GetMarketActions() []*CEconItem_Action {
+	if x != nil {
+		return x.MarketActions
+	}
+	return nil
+}
+
+func (x *CEconItem_Description) GetCommodity() bool {
+	if x != nil && x.Commodity != nil {
+		return *x.Commodity
+	}
+	return false
+}
+
+func (x *CEconItem_Description) GetMarketTradableRestriction() int32 {
+	if x != nil && x.MarketTradableRestriction != nil {
+		return *x.MarketTradableRestriction
+	}
+	return 0
+}
+
+func (x *CEconItem_Description) GetMarketMarketableRestriction() int32 {
+	if x != nil && x.MarketMarketableRestriction != nil {
+		return *x.MarketMarketableRestriction
+	}
+	return 0
+}
+
+func (x *CEconItem_Description) GetMarketable() bool {
+	if x != nil && x.Marketable != nil {
+		return *x.Marketable
+	}
+	return false
+}
+
+func (x *CEconItem_Description) GetTags() []*CEconItem_Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CEconItem_Description) GetItemExpiration() string {
+	if x != nil && x.ItemExpiration != nil {
+		return *x.ItemExpiration
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetMarketFeeApp() int32 {
+	if x != nil && x.MarketFeeApp != nil {
+		return *x.MarketFeeApp
+	}
+	return 0
+}
+
+func (x *CEconItem_Description) GetMarketBuyCountryRestriction() string {
+	if x != nil && x.MarketBuyCountryRestriction != nil {
+		return *x.MarketBuyCountryRestriction
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetMarketSellCountryRestriction() string {
+	if x != nil && x.MarketSellCountryRestriction != nil {
+		return *x.MarketSellCountryRestriction
+	}
+	return ""
+}
+
+func (x *CEconItem_Description) GetSealed() bool {
+	if x != nil && x.Sealed != nil {
+		return *x.Sealed
+	}
+	return false
+}
+
+type CEcon_GetInventoryItemsWithDescriptions_Response struct {
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	Assets              []*CEcon_Asset           `protobuf:"bytes,1,rep,name=assets" json:"assets,omitempty"`
+	Descriptions        []*CEconItem_Description `protobuf:"bytes,2,rep,name=descriptions" json:"descriptions,omitempty"`
+	MissingAssets       []*CEcon_Asset           `protobuf:"bytes,3,rep,name=missing_assets,json=missingAssets" json:"missing_assets,omitempty"`
+	MoreItems           *bool                    `protobuf:"varint,4,opt,name=more_items,json=moreItems" json:"more_items,omitempty"`
+	LastAssetid         *uint64                  `protobuf:"varint,5,opt,name=last_assetid,json=lastAssetid" json:"last_assetid,omitempty"`
+	TotalInventoryCount *uint32                  `protobuf:"varint,6,opt,name=total_inventory_count,json=totalInventoryCount" json:"total_inventory_count,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Response) Reset() {
+	*x = CEcon_GetInventoryItemsWithDescriptions_Response{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEcon_GetInventoryItemsWithDescriptions_Response) ProtoMessage() {}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEcon_GetInventoryItemsWithDescriptions_Response.ProtoReflect.Descriptor instead.
+func (*CEcon_GetInventoryItemsWithDescriptions_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Response) GetAssets() []*CEcon_Asset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Response) GetDescriptions() []*CEconItem_Description {
+	if x != nil {
+		return x.Descriptions
+	}
+	return nil
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Response) GetMissingAssets() []*CEcon_Asset {
+	if x != nil {
+		return x.MissingAssets
+	}
+	return nil
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Response) GetMoreItems() bool {
+	if x != nil && x.MoreItems != nil {
+		return *x.MoreItems
+	}
+	return false
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Response) GetLastAssetid() uint64 {
+	if x != nil && x.LastAssetid != nil {
+		return *x.LastAssetid
+	}
+	return 0
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Response) GetTotalInventoryCount() uint32 {
+	if x != nil && x.TotalInventoryCount != nil {
+		return *x.TotalInventoryCount
+	}
+	return 0
+}
+
+type CEcon_GetTradeOfferAccessToken_Request struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GenerateNewToken *bool                  `protobuf:"varint,1,opt,name=generate_new_token,json=generateNewToken" json:"generate_new_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CEcon_GetTradeOfferAccessToken_Request) Reset() {
+	*x = CEcon_GetTradeOfferAccessToken_Request{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEcon_GetTradeOfferAccessToken_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEcon_GetTradeOfferAccessToken_Request) ProtoMessage() {}
+
+func (x *CEcon_GetTradeOfferAccessToken_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEcon_GetTradeOfferAccessToken_Request.ProtoReflect.Descriptor instead.
+func (*CEcon_GetTradeOfferAccessToken_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CEcon_GetTradeOfferAccessToken_Request) GetGenerateNewToken() bool {
+	if x != nil && x.GenerateNewToken != nil {
+		return *x.GenerateNewToken
+	}
+	return false
+}
+
+type CEcon_GetTradeOfferAccessToken_Response struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TradeOfferAccessToken *string                `protobuf:"bytes,1,opt,name=trade_offer_access_token,json=tradeOfferAccessToken" json:"trade_offer_access_token,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CEcon_GetTradeOfferAccessToken_Response) Reset() {
+	*x = CEcon_GetTradeOfferAccessToken_Response{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEcon_GetTradeOfferAccessToken_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEcon_GetTradeOfferAccessToken_Response) ProtoMessage() {}
+
+func (x *CEcon_GetTradeOfferAccessToken_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEcon_GetTradeOfferAccessToken_Response.ProtoReflect.Descriptor instead.
+func (*CEcon_GetTradeOfferAccessToken_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CEcon_GetTradeOfferAccessToken_Response) GetTradeOfferAccessToken() string {
+	if x != nil && x.TradeOfferAccessToken != nil {
+		return *x.TradeOfferAccessToken
+	}
+	return ""
+}
+
+type CEcon_GetAssetClassInfo_Request struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Language      *string                                  `protobuf:"bytes,1,opt,name=language" json:"language,omitempty"`
+	Appid         *uint32                                  `protobuf:"varint,2,opt,name=appid" json:"appid,omitempty"`
+	Classes       []*CEcon_GetAssetClassInfo_Request_Class `protobuf:"bytes,3,rep,name=classes" json:"classes,omitempty"`
+	HighPri       *bool                                    `protobuf:"varint,4,opt,name=high_pri,json=highPri" json:"high_pri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CEcon_GetAssetClassInfo_Request) Reset() {
+	*x = CEcon_GetAssetClassInfo_Request{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEcon_GetAssetClassInfo_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEcon_GetAssetClassInfo_Request) ProtoMessage() {}
+
+func (x *CEcon_GetAssetClassInfo_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEcon_GetAssetClassInfo_Request.ProtoReflect.Descriptor instead.
+func (*CEcon_GetAssetClassInfo_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CEcon_GetAssetClassInfo_Request) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *CEcon_GetAssetClassInfo_Request) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CEcon_GetAssetClassInfo_Request) GetClasses() []*CEcon_GetAssetClassInfo_Request_Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+func (x *CEcon_GetAssetClassInfo_Request) GetHighPri() bool {
+	if x != nil && x.HighPri != nil {
+		return *x.HighPri
+	}
+	return false
+}
+
+type CEcon_GetAssetClassInfo_Response struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Descriptions  []*CEconItem_Description `protobuf:"bytes,1,rep,name=descriptions" json:"descriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CEcon_GetAssetClassInfo_Response) Reset() {
+	*x = CEcon_GetAssetClassInfo_Response{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEcon_GetAssetClassInfo_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEcon_GetAssetClassInfo_Response) ProtoMessage() {}
+
+func (x *CEcon_GetAssetClassInfo_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEcon_GetAssetClassInfo_Response.ProtoReflect.Descriptor instead.
+func (*CEcon_GetAssetClassInfo_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CEcon_GetAssetClassInfo_Response) GetDescriptions() []*CEconItem_Description {
+	if x != nil {
+		return x.Descriptions
+	}
+	return nil
+}
+
+type CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Assetids       []uint64               `protobuf:"varint,1,rep,name=assetids" json:"assetids,omitempty"`
+	Currencyids    []uint32               `protobuf:"varint,2,rep,name=currencyids" json:"currencyids,omitempty"`
+	TradableOnly   *bool                  `protobuf:"varint,3,opt,name=tradable_only,json=tradableOnly" json:"tradable_only,omitempty"`
+	MarketableOnly *bool                  `protobuf:"varint,4,opt,name=marketable_only,json=marketableOnly" json:"marketable_only,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions) Reset() {
+	*x = CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions) ProtoMessage() {}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions.ProtoReflect.Descriptor instead.
+func (*CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions) GetAssetids() []uint64 {
+	if x != nil {
+		return x.Assetids
+	}
+	return nil
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions) GetCurrencyids() []uint32 {
+	if x != nil {
+		return x.Currencyids
+	}
+	return nil
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions) GetTradableOnly() bool {
+	if x != nil && x.TradableOnly != nil {
+		return *x.TradableOnly
+	}
+	return false
+}
+
+func (x *CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions) GetMarketableOnly() bool {
+	if x != nil && x.MarketableOnly != nil {
+		return *x.MarketableOnly
+	}
+	return false
+}
+
+type CEcon_GetAssetClassInfo_Request_Class struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classid       *uint64                `protobuf:"varint,1,opt,name=classid" json:"classid,omitempty"`
+	Instanceid    *uint64                `protobuf:"varint,2,opt,name=instanceid" json:"instanceid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CEcon_GetAssetClassInfo_Request_Class) Reset() {
+	*x = CEcon_GetAssetClassInfo_Request_Class{}
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CEcon_GetAssetClassInfo_Request_Class) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CEcon_GetAssetClassInfo_Request_Class) ProtoMessage() {}
+
+func (x *CEcon_GetAssetClassInfo_Request_Class) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_econ_steamclient_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CEcon_GetAssetClassInfo_Request_Class.ProtoReflect.Descriptor instead.
+func (*CEcon_GetAssetClassInfo_Request_Class) Descriptor() ([]byte, []int) {
+	return file_steammessages_econ_steamclient_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *CEcon_GetAssetClassInfo_Request_Class) GetClassid() uint64 {
+	if x != nil && x.Classid != nil {
+		return *x.Classid
+	}
+	return 0
+}
+
+func (x *CEcon_GetAssetClassInfo_Request_Class) GetInstanceid() uint64 {
+	if x != nil && x.Instanceid != nil {
+		return *x.Instanceid
+	}
+	return 0
+}
+
+var File_steammessages_econ_steamclient_proto protoreflect.FileDescriptor
+
+const file_steammessages_econ_steamclient_proto_rawDesc = "" +
+	"\n" +
+	"$steammessages_econ.steamclient.proto\"\xba\x04\n" +
+	"/CEcon_GetInventoryItemsWithDescriptions_Request\x12\x18\n" +
+	"\asteamid\x18\x01 \x01(\x06R\asteamid\x12\x14\n" +
+	"\x05appid\x18\x02 \x01(\rR\x05appid\x12\x1c\n" +
+	"\tcontextid\x18\x03 \x01(\x04R\tcontextid\x12)\n" +
+	"\x10get_descriptions\x18\x04 \x01(\bR\x0fgetDescriptions\x12?\n" +
+	"\x1cfor_trade_offer_verification\x18\n" +
+	" \x01(\bR\x19forTradeOfferVerification\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12X\n" +
+	"\afilters\x18\x06 \x01(\v2>.CEcon_GetInventoryItemsWithDescriptions_Request.FilterOptionsR\afilters\x12#\n" +
+	"\rstart_assetid\x18\b \x01(\x04R\fstartAssetid\x12\x14\n" +
+	"\x05count\x18\t \x01(\x05R\x05count\x1a\x9b\x01\n" +
+	"\rFilterOptions\x12\x1a\n" +
+	"\bassetids\x18\x01 \x03(\x04R\bassetids\x12 \n" +
+	"\vcurrencyids\x18\x02 \x03(\rR\vcurrencyids\x12#\n" +
+	"\rtradable_only\x18\x03 \x01(\bR\ftradableOnly\x12'\n" +
+	"\x0fmarketable_only\x18\x04 \x01(\bR\x0emarketableOnly\"\x80\x02\n" +
+	"\vCEcon_Asset\x12\x14\n" +
+	"\x05appid\x18\x01 \x01(\rR\x05appid\x12\x1c\n" +
+	"\tcontextid\x18\x02 \x01(\x04R\tcontextid\x12\x18\n" +
+	"\aassetid\x18\x03 \x01(\x04R\aassetid\x12\x18\n" +
+	"\aclassid\x18\x04 \x01(\x04R\aclassid\x12\x1e\n" +
+	"\n" +
+	"instanceid\x18\x05 \x01(\x04R\n" +
+	"instanceid\x12\x1e\n" +
+	"\n" +
+	"currencyid\x18\x06 \x01(\rR\n" +
+	"currencyid\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x03R\x06amount\x12\x18\n" +
+	"\amissing\x18\b \x01(\bR\amissing\x12\x17\n" +
+	"\aest_usd\x18\t \x01(\x03R\x06estUsd\"q\n" +
+	"\x19CEconItem_DescriptionLine\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\":\n" +
+	"\x10CEconItem_Action\x12\x12\n" +
+	"\x04link\x18\x01 \x01(\tR\x04link\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xe2\x01\n" +
+	"\rCEconItem_Tag\x12\x14\n" +
+	"\x05appid\x18\x01 \x01(\rR\x05appid\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12#\n" +
+	"\rinternal_name\x18\x03 \x01(\tR\finternalName\x126\n" +
+	"\x17localized_category_name\x18\x04 \x01(\tR\x15localizedCategoryName\x12,\n" +
+	"\x12localized_tag_name\x18\x05 \x01(\tR\x10localizedTagName\x12\x14\n" +
+	"\x05color\x18\x06 \x01(\tR\x05color\"\xa4\n" +
+	"\n" +
+	"\x15CEconItem_Description\x12\x14\n" +
+	"\x05appid\x18\x01 \x01(\x05R\x05appid\x12\x18\n" +
+	"\aclassid\x18\x02 \x01(\x04R\aclassid\x12\x1e\n" +
+	"\n" +
+	"instanceid\x18\x03 \x01(\x04R\n" +
+	"instanceid\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\bR\bcurrency\x12)\n" +
+	"\x10background_color\x18\x05 \x01(\tR\x0fbackgroundColor\x12\x19\n" +
+	"\bicon_url\x18\x06 \x01(\tR\aiconUrl\x12$\n" +
+	"\x0eicon_url_large\x18\a \x01(\tR\ficonUrlLarge\x12>\n" +
+	"\fdescriptions\x18\b \x03(\v2\x1a.CEconItem_DescriptionLineR\fdescriptions\x12\x1a\n" +
+	"\btradable\x18\t \x01(\bR\btradable\x12+\n" +
+	"\aactions\x18\n" +
+	" \x03(\v2\x11.CEconItem_ActionR\aactions\x12I\n" +
+	"\x12owner_descriptions\x18\v \x03(\v2\x1a.CEconItem_DescriptionLineR\x11ownerDescriptions\x126\n" +
+	"\rowner_actions\x18\f \x03(\v2\x11.CEconItem_ActionR\fownerActions\x12$\n" +
+	"\rfraudwarnings\x18\r \x03(\tR\rfraudwarnings\x12\x12\n" +
+	"\x04name\x18\x0e \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"name_color\x18\x0f \x01(\tR\tnameColor\x12\x12\n" +
+	"\x04type\x18\x10 \x01(\tR\x04type\x12\x1f\n" +
+	"\vmarket_name\x18\x11 \x01(\tR\n" +
+	"marketName\x12(\n" +
+	"\x10market_hash_name\x18\x12 \x01(\tR\x0emarketHashName\x12\x1d\n" +
+	"\n" +
+	"market_fee\x18\x13 \x01(\tR\tmarketFee\x12=\n" +
+	"\x0econtained_item\x18\x14 \x01(\v2\x16.CEconItem_DescriptionR\rcontainedItem\x128\n" +
+	"\x0emarket_actions\x18\x15 \x03(\v2\x11.CEconItem_ActionR\rmarketActions\x12\x1c\n" +
+	"\tcommodity\x18\x16 \x01(\bR\tcommodity\x12>\n" +
+	"\x1bmarket_tradable_restriction\x18\x17 \x01(\x05R\x19marketTradableRestriction\x12B\n" +
+	"\x1dmarket_marketable_restriction\x18\x18 \x01(\x05R\x1bmarketMarketableRestriction\x12\x1e\n" +
+	"\n" +
+	"marketable\x18\x19 \x01(\bR\n" +
+	"marketable\x12\"\n" +
+	"\x04tags\x18\x1a \x03(\v2\x0e.CEconItem_TagR\x04tags\x12'\n" +
+	"\x0fitem_expiration\x18\x1b \x01(\tR\x0eitemExpiration\x12$\n" +
+	"\x0emarket_fee_app\x18\x1c \x01(\x05R\fmarketFeeApp\x12C\n" +
+	"\x1emarket_buy_country_restriction\x18\x1e \x01(\tR\x1bmarketBuyCountryRestriction\x12E\n" +
+	"\x1fmarket_sell_country_restriction\x18\x1f \x01(\tR\x1cmarketSellCountryRestriction\x12\x16\n" +
+	"\x06sealed\x18  \x01(\bR\x06sealed\"\xbf\x02\n" +
+	"0CEcon_GetInventoryItemsWithDescriptions_Response\x12$\n" +
+	"\x06assets\x18\x01 \x03(\v2\f.CEcon_AssetR\x06assets\x12:\n" +
+	"\fdescriptions\x18\x02 \x03(\v2\x16.CEconItem_DescriptionR\fdescriptions\x123\n" +
+	"\x0emissing_assets\x18\x03 \x03(\v2\f.CEcon_AssetR\rmissingAssets\x12\x1d\n" +
+	"\n" +
+	"more_items\x18\x04 \x01(\bR\tmoreItems\x12!\n" +
+	"\flast_assetid\x18\x05 \x01(\x04R\vlastAssetid\x122\n" +
+	"\x15total_inventory_count\x18\x06 \x01(\rR\x13totalInventoryCount\"V\n" +
+	"&CEcon_GetTradeOfferAccessToken_Request\x12,\n" +
+	"\x12generate_new_token\x18\x01 \x01(\bR\x10generateNewToken\"b\n" +
+	"'CEcon_GetTradeOfferAccessToken_Response\x127\n" +
+	"\x18trade_offer_access_token\x18\x01 \x01(\tR\x15tradeOfferAccessToken\"\xf3\x01\n" +
+	"\x1fCEcon_GetAssetClassInfo_Request\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12\x14\n" +
+	"\x05appid\x18\x02 \x01(\rR\x05appid\x12@\n" +
+	"\aclasses\x18\x03 \x03(\v2&.CEcon_GetAssetClassInfo_Request.ClassR\aclasses\x12\x19\n" +
+	"\bhigh_pri\x18\x04 \x01(\bR\ahighPri\x1aA\n" +
+	"\x05Class\x12\x18\n" +
+	"\aclassid\x18\x01 \x01(\x04R\aclassid\x12\x1e\n" +
+	"\n" +
+	"instanceid\x18\x02 \x01(\x04R\n" +
+	"instanceid\"^\n" +
+	" CEcon_GetAssetClassInfo_Response\x12:\n" +
+	"\fdescriptions\x18\x01 \x03(\v2\x16.CEconItem_DescriptionR\fdescriptions2\xda\x02\n" +
+	"\x04Econ\x12\x88\x01\n" +
+	"!GetInventoryItemsWithDescriptions\x120.CEcon_GetInventoryItemsWithDescriptions_Request\x1a1.CEcon_GetInventoryItemsWithDescriptions_Response\x12m\n" +
+	"\x18GetTradeOfferAccessToken\x12'.CEcon_GetTradeOfferAccessToken_Request\x1a(.CEcon_GetTradeOfferAccessToken_Response\x12X\n" +
+	"\x11GetAssetClassInfo\x12 .CEcon_GetAssetClassInfo_Request\x1a!.CEcon_GetAssetClassInfo_ResponseB\x03\x80\x01\x01"
+
+var (
+	file_steammessages_econ_steamclient_proto_rawDescOnce sync.Once
+	file_steammessages_econ_steamclient_proto_rawDescData []byte
+)
+
+func file_steammessages_econ_steamclient_proto_rawDescGZIP() []byte {
+	file_steammessages_econ_steamclient_proto_rawDescOnce.Do(func() {
+		file_steammessages_econ_steamclient_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_steammessages_econ_steamclient_proto_rawDesc), len(file_steammessages_econ_steamclient_proto_rawDesc)))
+	})
+	return file_steammessages_econ_steamclient_proto_rawDescData
+}
+
+var file_steammessages_econ_steamclient_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_steammessages_econ_steamclient_proto_goTypes = []any{
+	(*CEcon_GetInventoryItemsWithDescriptions_Request)(nil), // 0: CEcon_GetInventoryItemsWithDescriptions_Request
+	(*CEcon_Asset)(nil),                                                   // 1: CEcon_Asset
+	(*CEconItem_DescriptionLine)(nil),                                     // 2: CEconItem_DescriptionLine
+	(*CEconItem_Action)(nil),                                              // 3: CEconItem_Action
+	(*CEconItem_Tag)(nil),                                                 // 4: CEconItem_Tag
+	(*CEconItem_Description)(nil),                                         // 5: CEconItem_Description
+	(*CEcon_GetInventoryItemsWithDescriptions_Response)(nil),              // 6: CEcon_GetInventoryItemsWithDescriptions_Response
+	(*CEcon_GetTradeOfferAccessToken_Request)(nil),                        // 7: CEcon_GetTradeOfferAccessToken_Request
+	(*CEcon_GetTradeOfferAccessToken_Response)(nil),                       // 8: CEcon_GetTradeOfferAccessToken_Response
+	(*CEcon_GetAssetClassInfo_Request)(nil),                               // 9: CEcon_GetAssetClassInfo_Request
+	(*CEcon_GetAssetClassInfo_Response)(nil),                              // 10: CEcon_GetAssetClassInfo_Response
+	(*CEcon_GetInventoryItemsWithDescriptions_Request_FilterOptions)(nil), // 11: CEcon_GetInventoryItemsWithDescriptions_Request.FilterOptions
+	(*CEcon_GetAssetClassInfo_Request_Class)(nil),                         // 12: CEcon_GetAssetClassInfo_Request.Class
+}
+var file_steammessages_econ_steamclient_proto_depIdxs = []int32{
+	11, // 0: CEcon_GetInventoryItemsWithDescriptions_Request.filters:type_name -> CEcon_GetInventoryItemsWithDescriptions_Request.FilterOptions
+	2,  // 1: CEconItem_Description.descriptions:type_name -> CEconItem_DescriptionLine
+	3,  // 2: CEconItem_Description.actions:type_name -> CEconItem_Action
+	2,  // 3: CEconItem_Description.owner_descriptions:type_name -> CEconItem_DescriptionLine
+	3,  // 4: CEconItem_Description.owner_actions:type_name -> CEconItem_Action
+	5,  // 5: CEconItem_Description.contained_item:type_name -> CEconItem_Description
+	3,  // 6: CEconItem_Description.market_actions:type_name -> CEconItem_Action
+	4,  // 7: CEconItem_Description.tags:type_name -> CEconItem_Tag
+	1,  // 8: CEcon_GetInventoryItemsWithDescriptions_Response.assets:type_name -> CEcon_Asset
+	5,  // 9: CEcon_GetInventoryItemsWithDescriptions_Response.descriptions:type_name -> CEconItem_Description
+	1,  // 10: CEcon_GetInventoryItemsWithDescriptions_Response.missing_assets:type_name -> CEcon_Asset
+	12, // 11: CEcon_GetAssetClassInfo_Request.classes:type_name -> CEcon_GetAssetClassInfo_Request.Class
+	5,  // 12: CEcon_GetAssetClassInfo_Response.descriptions:type_name -> CEconItem_Description
+	0,  // 13: Econ.GetInventoryItemsWithDescriptions:input_type -> CEcon_GetInventoryItemsWithDescriptions_Request
+	7,  // 14: Econ.GetTradeOfferAccessToken:input_type -> CEcon_GetTradeOfferAccessToken_Request
+	9,  // 15: Econ.GetAssetClassInfo:input_type -> CEcon_GetAssetClassInfo_Request
+	6,  // 16: Econ.GetInventoryItemsWithDescriptions:output_type -> CEcon_GetInventoryItemsWithDescriptions_Response
+	8,  // 17: Econ.GetTradeOfferAccessToken:output_type -> CEcon_GetTradeOfferAccessToken_Response
+	10, // 18: Econ.GetAssetClassInfo:output_type -> CEcon_GetAssetClassInfo_Response
+	16, // [16:19] is the sub-list for method output_type
+	13, // [13:16] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_steammessages_econ_steamclient_proto_init() }
+func file_steammessages_econ_steamclient_proto_init() {
+	if File_steammessages_econ_steamclient_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_steammessages_econ_steamclient_proto_rawDesc), len(file_steammessages_econ_steamclient_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_steammessages_econ_steamclient_proto_goTypes,
+		DependencyIndexes: file_steammessages_econ_steamclient_proto_depIdxs,
+		MessageInfos:      file_steammessages_econ_steamclient_proto_msgTypes,
+	}.Build()
+	File_steammessages_econ_steamclient_proto = out.File
+	file_steammessages_econ_steamclient_proto_goTypes = nil
+	file_steammessages_econ_steamclient_proto_depIdxs = nil
+}
@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: steammessages_inventory.steamclient.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CInventory_GetInventory_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appid         *uint32                `protobuf:"varint,1,opt,name=appid" json:"appid,omitempty"`
+	Steamid       *uint64                `protobuf:"varint,2,opt,name=steamid" json:"steamid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CInventory_GetInventory_Request) Reset() {
+	*x = CInventory_GetInventory_Request{}
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CInventory_GetInventory_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CInventory_GetInventory_Request) ProtoMessage() {}
+
+func (x *CInventory_GetInventory_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CInventory_GetInventory_Request.ProtoReflect.Descriptor instead.
+func (*CInventory_GetInventory_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_inventory_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CInventory_GetInventory_Request) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CInventory_GetInventory_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+type CInventory_Response struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Etag           *string                `protobuf:"bytes,1,opt,name=etag" json:"etag,omitempty"`
+	Removeditemids []uint64               `protobuf:"varint,2,rep,name=removeditemids" json:"removeditemids,omitempty"`
+	ItemJson       *string                `protobuf:"bytes,3,opt,name=item_json,json=itemJson" json:"item_json,omitempty"`
+	ItemdefJson    *string                `protobuf:"bytes,4,opt,name=itemdef_json,json=itemdefJson" json:"itemdef_json,omitempty"`
+	Ticket         []byte                 `protobuf:"bytes,5,opt,name=ticket" json:"ticket,omitempty"`
+	Replayed       *bool                  `protobuf:"varint,6,opt,name=replayed" json:"replayed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CInventory_Response) Reset() {
+	*x = CInventory_Response{}
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CInventory_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CInventory_Response) ProtoMessage() {}
+
+func (x *CInventory_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CInventory_Response.ProtoReflect.Descriptor instead.
+func (*CInventory_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_inventory_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CInventory_Response) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
+func (x *CInventory_Response) GetRemoveditemids() []uint64 {
+	if x != nil {
+		return x.Removeditemids
+	}
+	return nil
+}
+
+func (x *CInventory_Response) GetItemJson() string {
+	if x != nil && x.ItemJson != nil {
+		return *x.ItemJson
+	}
+	return ""
+}
+
+func (x *CInventory_Response) GetItemdefJson() string {
+	if x != nil && x.ItemdefJson != nil {
+		return *x.ItemdefJson
+	}
+	return ""
+}
+
+func (x *CInventory_Response) GetTicket() []byte {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *CInventory_Response) GetReplayed() bool {
+	if x != nil && x.Replayed != nil {
+		return *x.Replayed
+	}
+	return false
+}
+
+type CInventory_GetItemDefs_Request struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Appid              *uint32                `protobuf:"varint,1,opt,name=appid" json:"appid,omitempty"`
+	Modifiedsince      *string                `protobuf:"bytes,2,opt,name=modifiedsince" json:"modifiedsince,omitempty"`
+	Itemdefids         []uint64               `protobuf:"varint,3,rep,name=itemdefids" json:"itemdefids,omitempty"`
+	Workshopids        []uint64               `protobuf:"varint,4,rep,name=workshopids" json:"workshopids,omitempty"`
+	CacheMaxAgeSeconds *uint32                `protobuf:"varint,5,opt,name=cache_max_age_seconds,json=cacheMaxAgeSeconds,def=0" json:"cache_max_age_seconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+// Default values for CInventory_GetItemDefs_Request fields.
+const (
+	Default_CInventory_GetItemDefs_Request_CacheMaxAgeSeconds = uint32(0)
+)
+
+func (x *CInventory_GetItemDefs_Request) Reset() {
+	*x = CInventory_GetItemDefs_Request{}
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CInventory_GetItemDefs_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CInventory_GetItemDefs_Request) ProtoMessage() {}
+
+func (x *CInventory_GetItemDefs_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CInventory_GetItemDefs_Request.ProtoReflect.Descriptor instead.
+func (*CInventory_GetItemDefs_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_inventory_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CInventory_GetItemDefs_Request) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CInventory_GetItemDefs_Request) GetModifiedsince() string {
+	if x != nil && x.Modifiedsince != nil {
+		return *x.Modifiedsince
+	}
+	return ""
+}
+
+func (x *CInventory_GetItemDefs_Request) GetItemdefids() []uint64 {
+	if x != nil {
+		return x.Itemdefids
+	}
+	return nil
+}
+
+func (x *CInventory_GetItemDefs_Request) GetWorkshopids() []uint64 {
+	if x != nil {
+		return x.Workshopids
+	}
+	return nil
+}
+
+func (x *CInventory_GetItemDefs_Request) GetCacheMaxAgeSeconds() uint32 {
+	if x != nil && x.CacheMaxAgeSeconds != nil {
+		return *x.CacheMaxAgeSeconds
+	}
+	return Default_CInventory_GetItemDefs_Request_CacheMaxAgeSeconds
+}
+
+type CInventory_GetItemDefMeta_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appid         *uint32                `protobuf:"varint,1,opt,name=appid" json:"appid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CInventory_GetItemDefMeta_Request) Reset() {
+	*x = CInventory_GetItemDefMeta_Request{}
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CInventory_GetItemDefMeta_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CInventory_GetItemDefMeta_Request) ProtoMessage() {}
+
+func (x *CInventory_GetItemDefMeta_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CInventory_GetItemDefMeta_Request.ProtoReflect.Descriptor instead.
+func (*CInventory_GetItemDefMeta_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_inventory_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CInventory_GetItemDefMeta_Request) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+type CInventory_GetItemDefMeta_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modified      *uint32                `protobuf:"varint,1,opt,name=modified" json:"modified,omitempty"`
+	Digest        *string                `protobuf:"bytes,2,opt,name=digest" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CInventory_GetItemDefMeta_Response) Reset() {
+	*x = CInventory_GetItemDefMeta_Response{}
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CInventory_GetItemDefMeta_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CInventory_GetItemDefMeta_Response) ProtoMessage() {}
+
+func (x *CInventory_GetItemDefMeta_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CInventory_GetItemDefMeta_Response.ProtoReflect.Descriptor instead.
+func (*CInventory_GetItemDefMeta_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_inventory_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CInventory_GetItemDefMeta_Response) GetModified() uint32 {
+	if x != nil && x.Modified != nil {
+		return *x.Modified
+	}
+	return 0
+}
+
+func (x *CInventory_GetItemDefMeta_Response) GetDigest() string {
+	if x != nil && x.Digest != nil {
+		return *x.Digest
+	}
+	return ""
+}
+
+type CInventory_ConsumePlaytime_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appid         *uint32                `protobuf:"varint,1,opt,name=appid" json:"appid,omitempty"`
+	Itemdefid     *uint64                `protobuf:"varint,2,opt,name=itemdefid" json:"itemdefid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CInventory_ConsumePlaytime_Request) Reset() {
+	*x = CInventory_ConsumePlaytime_Request{}
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CInventory_ConsumePlaytime_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CInventory_ConsumePlaytime_Request) ProtoMessage() {}
+
+func (x *CInventory_ConsumePlaytime_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_inventory_steamclient_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CInventory_ConsumePlaytime_Request.ProtoReflect.Descriptor instead.
+func (*CInventory_ConsumePlaytime_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_inventory_steamclient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CInventory_ConsumePlaytime_Request) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CInventory_ConsumePlaytime_Request) GetItemdefid() uint64 {
+	if x != nil && x.Itemdefid != nil {
+		return *x.Itemdefid
+	}
+	return 0
+}
+
+var File_steammessages_inventory_steamclient_proto protoreflect.FileDescriptor
+
+const file_steammessages_inventory_steamclient_proto_rawDesc = "" +
+	"\n" +
+	")steammessages_inventory.steamclient.proto\"Q\n" +
+	"\x1fCInventory_GetInventory_Request\x12\x14\n" +
+	"\x05appid\x18\x01 \x01(\rR\x05appid\x12\x18\n" +
+	"\asteamid\x18\x02 \x01(\x04R\asteamid\"\xc5\x01\n" +
+	"\x13CInventory_Response\x12\x12\n" +
+	"\x04etag\x18\x01 \x01(\tR\x04etag\x12&\n" +
+	"\x0eremoveditemids\x18\x02 \x03(\x04R\x0eremoveditemids\x12\x1b\n" +
+	"\titem_json\x18\x03 \x01(\tR\bitemJson\x12!\n" +
+	"\fitemdef_json\x18\x04 \x01(\tR\vitemdefJson\x12\x16\n" +
+	"\x06ticket\x18\x05 \x01(\fR\x06ticket\x12\x1a\n" +
+	"\breplayed\x18\x06 \x01(\bR\breplayed\"\xd4\x01\n" +
+	"\x1eCInventory_GetItemDefs_Request\x12\x14\n" +
+	"\x05appid\x18\x01 \x01(\rR\x05appid\x12$\n" +
+	"\rmodifiedsince\x18\x02 \x01(\tR\rmodifiedsince\x12\x1e\n" +
+	"\n" +
+	"itemdefids\x18\x03 \x03(\x04R\n" +
+	"itemdefids\x12 \n" +
+	"\vworkshopids\x18\x04 \x03(\x04R\vworkshopids\x124\n" +
+	"\x15cache_max_age_seconds\x18\x05 \x01(\r:\x010R\x12cacheMaxAgeSeconds\"9\n" +
+	"!CInventory_GetItemDefMeta_Request\x12\x14\n" +
+	"\x05appid\x18\x01 \x01(\rR\x05appid\"X\n" +
+	"\"CInventory_GetItemDefMeta_Response\x12\x1a\n" +
+	"\bmodified\x18\x01 \x01(\rR\bmodified\x12\x16\n" +
+	"\x06digest\x18\x02 \x01(\tR\x06digest\"X\n" +
+	"\"CInventory_ConsumePlaytime_Request\x12\x14\n" +
+	"\x05appid\x18\x01 \x01(\rR\x05appid\x12\x1c\n" +
+	"\titemdefid\x18\x02 \x01(\x04R\titemdefid2\xc2\x02\n" +
+	"\tInventory\x12F\n" +
+	"\fGetInventory\x12 .CInventory_GetInventory_Request\x1a\x14.CInventory_Response\x12D\n" +
+	"\vGetItemDefs\x12\x1f.CInventory_GetItemDefs_Request\x1a\x14.CInventory_Response\x12Y\n" +
+	"\x0eGetItemDefMeta\x12\".CInventory_GetItemDefMeta_Request\x1a#.CInventory_GetItemDefMeta_Response\x12L\n" +
+	"\x0fConsumePlaytime\x12#.CInventory_ConsumePlaytime_Request\x1a\x14.CInventory_ResponseB\x03\x80\x01\x01"
+
+var (
+	file_steammessages_inventory_steamclient_proto_rawDescOnce sync.Once
+	file_steammessages_inventory_steamclient_proto_rawDescData []byte
+)
+
+func file_steammessages_inventory_steamclient_proto_rawDescGZIP() []byte {
+	file_steammessages_inventory_steamclient_proto_rawDescOnce.Do(func() {
+		file_steammessages_inventory_steamclient_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_steammessages_inventory_steamclient_proto_rawDesc), len(file_steammessages_inventory_steamclient_proto_rawDesc)))
+	})
+	return file_steammessages_inventory_steamclient_proto_rawDescData
+}
+
+var file_steammessages_inventory_steamclient_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_steammessages_inventory_steamclient_proto_goTypes = []any{
+	(*CInventory_GetInventory_Request)(nil),    // 0: CInventory_GetInventory_Request
+	(*CInventory_Response)(nil),                // 1: CInventory_Response
+	(*CInventory_GetItemDefs_Request)(nil),     // 2: CInventory_GetItemDefs_Request
+	(*CInventory_GetItemDefMeta_Request)(nil),  // 3: CInventory_GetItemDefMeta_Request
+	(*CInventory_GetItemDefMeta_Response)(nil), // 4: CInventory_GetItemDefMeta_Response
+	(*CInventory_ConsumePlaytime_Request)(nil), // 5: CInventory_ConsumePlaytime_Request
+}
+var file_steammessages_inventory_steamclient_proto_depIdxs = []int32{
+	0, // 0: Inventory.GetInventory:input_type -> CInventory_GetInventory_Request
+	2, // 1: Inventory.GetItemDefs:input_type -> CInventory_GetItemDefs_Request
+	3, // 2: Inventory.GetItemDefMeta:input_type -> CInventory_GetItemDefMeta_Request
+	5, // 3: Inventory.ConsumePlaytime:input_type -> CInventory_ConsumePlaytime_Request
+	1, // 4: Inventory.GetInventory:output_type -> CInventory_Response
+	1, // 5: Inventory.GetItemDefs:output_type -> CInventory_Response
+	4, // 6: Inventory.GetItemDefMeta:output_type -> CInventory_GetItemDefMeta_Response
+	1, // 7: Inventory.ConsumePlaytime:output_type -> CInventory_Response
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_steammessages_inventory_steamclient_proto_init() }
+func file_steammessages_inventory_steamclient_proto_init() {
+	if File_steammessages_inventory_steamclient_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_steammessages_inventory_steamclient_proto_rawDesc), len(file_steammessages_inventory_steamclient_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_steammessages_inventory_steamclient_proto_goTypes,
+		DependencyIndexes: file_steammessages_inventory_steamclient_proto_depIdxs,
+		MessageInfos:      file_steammessages_inventory_steamclient_proto_msgTypes,
+	}.Build()
+	File_steammessages_inventory_steamclient_proto = out.File
+	file_steammessages_inventory_steamclient_proto_goTypes = nil
+	file_steammessages_inventory_steamclient_proto_depIdxs = nil
+}
@@ -0,0 +1,1010 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: steammessages_player.steamclient.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CPlayer_GetOwnedGames_Request struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Steamid                *uint64                `protobuf:"varint,1,opt,name=steamid" json:"steamid,omitempty"`
+	IncludeAppinfo         *bool                  `protobuf:"varint,2,opt,name=include_appinfo,json=includeAppinfo" json:"include_appinfo,omitempty"`
+	IncludePlayedFreeGames *bool                  `protobuf:"varint,3,opt,name=include_played_free_games,json=includePlayedFreeGames" json:"include_played_free_games,omitempty"`
+	AppidsFilter           []uint32               `protobuf:"varint,4,rep,name=appids_filter,json=appidsFilter" json:"appids_filter,omitempty"`
+	IncludeFreeSub         *bool                  `protobuf:"varint,5,opt,name=include_free_sub,json=includeFreeSub" json:"include_free_sub,omitempty"`
+	SkipUnvettedApps       *bool                  `protobuf:"varint,6,opt,name=skip_unvetted_apps,json=skipUnvettedApps,def=1" json:"skip_unvetted_apps,omitempty"`
+	Language               *string                `protobuf:"bytes,7,opt,name=language" json:"language,omitempty"`
+	IncludeExtendedAppinfo *bool                  `protobuf:"varint,8,opt,name=include_extended_appinfo,json=includeExtendedAppinfo" json:"include_extended_appinfo,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+// Default values for CPlayer_GetOwnedGames_Request fields.
+const (
+	Default_CPlayer_GetOwnedGames_Request_SkipUnvettedApps = bool(true)
+)
+
+func (x *CPlayer_GetOwnedGames_Request) Reset() {
+	*x = CPlayer_GetOwnedGames_Request{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetOwnedGames_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetOwnedGames_Request) ProtoMessage() {}
+
+func (x *CPlayer_GetOwnedGames_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetOwnedGames_Request.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetOwnedGames_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CPlayer_GetOwnedGames_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CPlayer_GetOwnedGames_Request) GetIncludeAppinfo() bool {
+	if x != nil && x.IncludeAppinfo != nil {
+		return *x.IncludeAppinfo
+	}
+	return false
+}
+
+func (x *CPlayer_GetOwnedGames_Request) GetIncludePlayedFreeGames() bool {
+	if x != nil && x.IncludePlayedFreeGames != nil {
+		return *x.IncludePlayedFreeGames
+	}
+	return false
+}
+
+func (x *CPlayer_GetOwnedGames_Request) GetAppidsFilter() []uint32 {
+	if x != nil {
+		return x.AppidsFilter
+	}
+	return nil
+}
+
+func (x *CPlayer_GetOwnedGames_Request) GetIncludeFreeSub() bool {
+	if x != nil && x.IncludeFreeSub != nil {
+		return *x.IncludeFreeSub
+	}
+	return false
+}
+
+func (x *CPlayer_GetOwnedGames_Request) GetSkipUnvettedApps() bool {
+	if x != nil && x.SkipUnvettedApps != nil {
+		return *x.SkipUnvettedApps
+	}
+	return Default_CPlayer_GetOwnedGames_Request_SkipUnvettedApps
+}
+
+func (x *CPlayer_GetOwnedGames_Request) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *CPlayer_GetOwnedGames_Request) GetIncludeExtendedAppinfo() bool {
+	if x != nil && x.IncludeExtendedAppinfo != nil {
+		return *x.IncludeExtendedAppinfo
+	}
+	return false
+}
+
+type CPlayer_GetOwnedGames_Response struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	GameCount     *uint32                                `protobuf:"varint,1,opt,name=game_count,json=gameCount" json:"game_count,omitempty"`
+	Games         []*CPlayer_GetOwnedGames_Response_Game `protobuf:"bytes,2,rep,name=games" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetOwnedGames_Response) Reset() {
+	*x = CPlayer_GetOwnedGames_Response{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetOwnedGames_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetOwnedGames_Response) ProtoMessage() {}
+
+func (x *CPlayer_GetOwnedGames_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetOwnedGames_Response.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetOwnedGames_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CPlayer_GetOwnedGames_Response) GetGameCount() uint32 {
+	if x != nil && x.GameCount != nil {
+		return *x.GameCount
+	}
+	return 0
+}
+
+func (x *CPlayer_GetOwnedGames_Response) GetGames() []*CPlayer_GetOwnedGames_Response_Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type CPlayer_GetRecentlyPlayedGames_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Steamid       *uint64                `protobuf:"varint,1,opt,name=steamid" json:"steamid,omitempty"`
+	Count         *uint32                `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Request) Reset() {
+	*x = CPlayer_GetRecentlyPlayedGames_Request{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetRecentlyPlayedGames_Request) ProtoMessage() {}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetRecentlyPlayedGames_Request.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetRecentlyPlayedGames_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Request) GetCount() uint32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type CPlayer_GetRecentlyPlayedGames_Response struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	TotalCount    *uint32                                         `protobuf:"varint,1,opt,name=total_count,json=totalCount" json:"total_count,omitempty"`
+	Games         []*CPlayer_GetRecentlyPlayedGames_Response_Game `protobuf:"bytes,2,rep,name=games" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response) Reset() {
+	*x = CPlayer_GetRecentlyPlayedGames_Response{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetRecentlyPlayedGames_Response) ProtoMessage() {}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetRecentlyPlayedGames_Response.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetRecentlyPlayedGames_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response) GetTotalCount() uint32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response) GetGames() []*CPlayer_GetRecentlyPlayedGames_Response_Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type CPlayer_GetGameBadgeLevels_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appid         *uint32                `protobuf:"varint,1,opt,name=appid" json:"appid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Request) Reset() {
+	*x = CPlayer_GetGameBadgeLevels_Request{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetGameBadgeLevels_Request) ProtoMessage() {}
+
+func (x *CPlayer_GetGameBadgeLevels_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetGameBadgeLevels_Request.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetGameBadgeLevels_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Request) GetAppid() uint32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+type CPlayer_GetGameBadgeLevels_Response struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	PlayerLevel   *uint32                                      `protobuf:"varint,1,opt,name=player_level,json=playerLevel" json:"player_level,omitempty"`
+	Badges        []*CPlayer_GetGameBadgeLevels_Response_Badge `protobuf:"bytes,2,rep,name=badges" json:"badges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Response) Reset() {
+	*x = CPlayer_GetGameBadgeLevels_Response{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetGameBadgeLevels_Response) ProtoMessage() {}
+
+func (x *CPlayer_GetGameBadgeLevels_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetGameBadgeLevels_Response.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetGameBadgeLevels_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Response) GetPlayerLevel() uint32 {
+	if x != nil && x.PlayerLevel != nil {
+		return *x.PlayerLevel
+	}
+	return 0
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Response) GetBadges() []*CPlayer_GetGameBadgeLevels_Response_Badge {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+type CPlayer_GetNicknameList_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetNicknameList_Request) Reset() {
+	*x = CPlayer_GetNicknameList_Request{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetNicknameList_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetNicknameList_Request) ProtoMessage() {}
+
+func (x *CPlayer_GetNicknameList_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetNicknameList_Request.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetNicknameList_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{6}
+}
+
+type CPlayer_GetNicknameList_Response struct {
+	state         protoimpl.MessageState                             `protogen:"open.v1"`
+	Nicknames     []*CPlayer_GetNicknameList_Response_PlayerNickname `protobuf:"bytes,1,rep,name=nicknames" json:"nicknames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetNicknameList_Response) Reset() {
+	*x = CPlayer_GetNicknameList_Response{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetNicknameList_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetNicknameList_Response) ProtoMessage() {}
+
+func (x *CPlayer_GetNicknameList_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetNicknameList_Response.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetNicknameList_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CPlayer_GetNicknameList_Response) GetNicknames() []*CPlayer_GetNicknameList_Response_PlayerNickname {
+	if x != nil {
+		return x.Nicknames
+	}
+	return nil
+}
+
+type CPlayer_GetOwnedGames_Response_Game struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Appid                    *int32                 `protobuf:"varint,1,opt,name=appid" json:"appid,omitempty"`
+	Name                     *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Playtime_2Weeks          *int32                 `protobuf:"varint,3,opt,name=playtime_2weeks,json=playtime2weeks" json:"playtime_2weeks,omitempty"`
+	PlaytimeForever          *int32                 `protobuf:"varint,4,opt,name=playtime_forever,json=playtimeForever" json:"playtime_forever,omitempty"`
+	ImgIconUrl               *string                `protobuf:"bytes,5,opt,name=img_icon_url,json=imgIconUrl" json:"img_icon_url,omitempty"`
+	HasCommunityVisibleStats *bool                  `protobuf:"varint,7,opt,name=has_community_visible_stats,json=hasCommunityVisibleStats" json:"has_community_visible_stats,omitempty"`
+	PlaytimeWindowsForever   *int32                 `protobuf:"varint,8,opt,name=playtime_windows_forever,json=playtimeWindowsForever" json:"playtime_windows_forever,omitempty"`
+	PlaytimeMacForever       *int32                 `protobuf:"varint,9,opt,name=playtime_mac_forever,json=playtimeMacForever" json:"playtime_mac_forever,omitempty"`
+	PlaytimeLinuxForever     *int32                 `protobuf:"varint,10,opt,name=playtime_linux_forever,json=playtimeLinuxForever" json:"playtime_linux_forever,omitempty"`
+	RtimeLastPlayed          *uint32                `protobuf:"varint,11,opt,name=rtime_last_played,json=rtimeLastPlayed" json:"rtime_last_played,omitempty"`
+	CapsuleFilename          *string                `protobuf:"bytes,12,opt,name=capsule_filename,json=capsuleFilename" json:"capsule_filename,omitempty"`
+	SortAs                   *string                `protobuf:"bytes,13,opt,name=sort_as,json=sortAs" json:"sort_as,omitempty"`
+	HasWorkshop              *bool                  `protobuf:"varint,14,opt,name=has_workshop,json=hasWorkshop" json:"has_workshop,omitempty"`
+	HasMarket                *bool                  `protobuf:"varint,15,opt,name=has_market,json=hasMarket" json:"has_market,omitempty"`
+	HasDlc                   *bool                  `protobuf:"varint,16,opt,name=has_dlc,json=hasDlc" json:"has_dlc,omitempty"`
+	HasLeaderboards          *bool                  `protobuf:"varint,17,opt,name=has_leaderboards,json=hasLeaderboards" json:"has_leaderboards,omitempty"`
+	ContentDescriptorids     []uint32               `protobuf:"varint,18,rep,name=content_descriptorids,json=contentDescriptorids" json:"content_descriptorids,omitempty"`
+	PlaytimeDisconnected     *int32                 `protobuf:"varint,19,opt,name=playtime_disconnected,json=playtimeDisconnected" json:"playtime_disconnected,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) Reset() {
+	*x = CPlayer_GetOwnedGames_Response_Game{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetOwnedGames_Response_Game) ProtoMessage() {}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetOwnedGames_Response_Game.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetOwnedGames_Response_Game) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetAppid() int32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetPlaytime_2Weeks() int32 {
+	if x != nil && x.Playtime_2Weeks != nil {
+		return *x.Playtime_2Weeks
+	}
+	return 0
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetPlaytimeForever() int32 {
+	if x != nil && x.PlaytimeForever != nil {
+		return *x.PlaytimeForever
+	}
+	return 0
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetImgIconUrl() string {
+	if x != nil && x.ImgIconUrl != nil {
+		return *x.ImgIconUrl
+	}
+	return ""
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetHasCommunityVisibleStats() bool {
+	if x != nil && x.HasCommunityVisibleStats != nil {
+		return *x.HasCommunityVisibleStats
+	}
+	return false
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetPlaytimeWindowsForever() int32 {
+	if x != nil && x.PlaytimeWindowsForever != nil {
+		return *x.PlaytimeWindowsForever
+	}
+	return 0
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetPlaytimeMacForever() int32 {
+	if x != nil && x.PlaytimeMacForever != nil {
+		return *x.PlaytimeMacForever
+	}
+	return 0
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetPlaytimeLinuxForever() int32 {
+	if x != nil && x.PlaytimeLinuxForever != nil {
+		return *x.PlaytimeLinuxForever
+	}
+	return 0
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetRtimeLastPlayed() uint32 {
+	if x != nil && x.RtimeLastPlayed != nil {
+		return *x.RtimeLastPlayed
+	}
+	return 0
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetCapsuleFilename() string {
+	if x != nil && x.CapsuleFilename != nil {
+		return *x.CapsuleFilename
+	}
+	return ""
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetSortAs() string {
+	if x != nil && x.SortAs != nil {
+		return *x.SortAs
+	}
+	return ""
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetHasWorkshop() bool {
+	if x != nil && x.HasWorkshop != nil {
+		return *x.HasWorkshop
+	}
+	return false
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetHasMarket() bool {
+	if x != nil && x.HasMarket != nil {
+		return *x.HasMarket
+	}
+	return false
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetHasDlc() bool {
+	if x != nil && x.HasDlc != nil {
+		return *x.HasDlc
+	}
+	return false
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetHasLeaderboards() bool {
+	if x != nil && x.HasLeaderboards != nil {
+		return *x.HasLeaderboards
+	}
+	return false
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetContentDescriptorids() []uint32 {
+	if x != nil {
+		return x.ContentDescriptorids
+	}
+	return nil
+}
+
+func (x *CPlayer_GetOwnedGames_Response_Game) GetPlaytimeDisconnected() int32 {
+	if x != nil && x.PlaytimeDisconnected != nil {
+		return *x.PlaytimeDisconnected
+	}
+	return 0
+}
+
+type CPlayer_GetRecentlyPlayedGames_Response_Game struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Appid                    *int32                 `protobuf:"varint,1,opt,name=appid" json:"appid,omitempty"`
+	Name                     *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Playtime_2Weeks          *int32                 `protobuf:"varint,3,opt,name=playtime_2weeks,json=playtime2weeks" json:"playtime_2weeks,omitempty"`
+	PlaytimeForever          *int32                 `protobuf:"varint,4,opt,name=playtime_forever,json=playtimeForever" json:"playtime_forever,omitempty"`
+	ImgIconUrl               *string                `protobuf:"bytes,5,opt,name=img_icon_url,json=imgIconUrl" json:"img_icon_url,omitempty"`
+	HasCommunityVisibleStats *bool                  `protobuf:"varint,7,opt,name=has_community_visible_stats,json=hasCommunityVisibleStats" json:"has_community_visible_stats,omitempty"`
+	PlaytimeWindowsForever   *int32                 `protobuf:"varint,8,opt,name=playtime_windows_forever,json=playtimeWindowsForever" json:"playtime_windows_forever,omitempty"`
+	PlaytimeMacForever       *int32                 `protobuf:"varint,9,opt,name=playtime_mac_forever,json=playtimeMacForever" json:"playtime_mac_forever,omitempty"`
+	PlaytimeLinuxForever     *int32                 `protobuf:"varint,10,opt,name=playtime_linux_forever,json=playtimeLinuxForever" json:"playtime_linux_forever,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) Reset() {
+	*x = CPlayer_GetRecentlyPlayedGames_Response_Game{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetRecentlyPlayedGames_Response_Game) ProtoMessage() {}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetRecentlyPlayedGames_Response_Game.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetRecentlyPlayedGames_Response_Game) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) GetAppid() int32 {
+	if x != nil && x.Appid != nil {
+		return *x.Appid
+	}
+	return 0
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) GetPlaytime_2Weeks() int32 {
+	if x != nil && x.Playtime_2Weeks != nil {
+		return *x.Playtime_2Weeks
+	}
+	return 0
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) GetPlaytimeForever() int32 {
+	if x != nil && x.PlaytimeForever != nil {
+		return *x.PlaytimeForever
+	}
+	return 0
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) GetImgIconUrl() string {
+	if x != nil && x.ImgIconUrl != nil {
+		return *x.ImgIconUrl
+	}
+	return ""
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) GetHasCommunityVisibleStats() bool {
+	if x != nil && x.HasCommunityVisibleStats != nil {
+		return *x.HasCommunityVisibleStats
+	}
+	return false
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) GetPlaytimeWindowsForever() int32 {
+	if x != nil && x.PlaytimeWindowsForever != nil {
+		return *x.PlaytimeWindowsForever
+	}
+	return 0
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) GetPlaytimeMacForever() int32 {
+	if x != nil && x.PlaytimeMacForever != nil {
+		return *x.PlaytimeMacForever
+	}
+	return 0
+}
+
+func (x *CPlayer_GetRecentlyPlayedGames_Response_Game) GetPlaytimeLinuxForever() int32 {
+	if x != nil && x.PlaytimeLinuxForever != nil {
+		return *x.PlaytimeLinuxForever
+	}
+	return 0
+}
+
+type CPlayer_GetGameBadgeLevels_Response_Badge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         *int32                 `protobuf:"varint,1,opt,name=level" json:"level,omitempty"`
+	Series        *int32                 `protobuf:"varint,2,opt,name=series" json:"series,omitempty"`
+	BorderColor   *uint32                `protobuf:"varint,3,opt,name=border_color,json=borderColor" json:"border_color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Response_Badge) Reset() {
+	*x = CPlayer_GetGameBadgeLevels_Response_Badge{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Response_Badge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetGameBadgeLevels_Response_Badge) ProtoMessage() {}
+
+func (x *CPlayer_GetGameBadgeLevels_Response_Badge) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetGameBadgeLevels_Response_Badge.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetGameBadgeLevels_Response_Badge) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Response_Badge) GetLevel() int32 {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return 0
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Response_Badge) GetSeries() int32 {
+	if x != nil && x.Series != nil {
+		return *x.Series
+	}
+	return 0
+}
+
+func (x *CPlayer_GetGameBadgeLevels_Response_Badge) GetBorderColor() uint32 {
+	if x != nil && x.BorderColor != nil {
+		return *x.BorderColor
+	}
+	return 0
+}
+
+type CPlayer_GetNicknameList_Response_PlayerNickname struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accountid     *uint32                `protobuf:"fixed32,1,opt,name=accountid" json:"accountid,omitempty"`
+	Nickname      *string                `protobuf:"bytes,2,opt,name=nickname" json:"nickname,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPlayer_GetNicknameList_Response_PlayerNickname) Reset() {
+	*x = CPlayer_GetNicknameList_Response_PlayerNickname{}
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPlayer_GetNicknameList_Response_PlayerNickname) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPlayer_GetNicknameList_Response_PlayerNickname) ProtoMessage() {}
+
+func (x *CPlayer_GetNicknameList_Response_PlayerNickname) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_player_steamclient_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPlayer_GetNicknameList_Response_PlayerNickname.ProtoReflect.Descriptor instead.
+func (*CPlayer_GetNicknameList_Response_PlayerNickname) Descriptor() ([]byte, []int) {
+	return file_steammessages_player_steamclient_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *CPlayer_GetNicknameList_Response_PlayerNickname) GetAccountid() uint32 {
+	if x != nil && x.Accountid != nil {
+		return *x.Accountid
+	}
+	return 0
+}
+
+func (x *CPlayer_GetNicknameList_Response_PlayerNickname) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+var File_steammessages_player_steamclient_proto protoreflect.FileDescriptor
+
+const file_steammessages_player_steamclient_proto_rawDesc = "" +
+	"\n" +
+	"&steammessages_player.steamclient.proto\"\xf6\x02\n" +
+	"\x1dCPlayer_GetOwnedGames_Request\x12\x18\n" +
+	"\asteamid\x18\x01 \x01(\x04R\asteamid\x12'\n" +
+	"\x0finclude_appinfo\x18\x02 \x01(\bR\x0eincludeAppinfo\x129\n" +
+	"\x19include_played_free_games\x18\x03 \x01(\bR\x16includePlayedFreeGames\x12#\n" +
+	"\rappids_filter\x18\x04 \x03(\rR\fappidsFilter\x12(\n" +
+	"\x10include_free_sub\x18\x05 \x01(\bR\x0eincludeFreeSub\x122\n" +
+	"\x12skip_unvetted_apps\x18\x06 \x01(\b:\x04trueR\x10skipUnvettedApps\x12\x1a\n" +
+	"\blanguage\x18\a \x01(\tR\blanguage\x128\n" +
+	"\x18include_extended_appinfo\x18\b \x01(\bR\x16includeExtendedAppinfo\"\xe5\x06\n" +
+	"\x1eCPlayer_GetOwnedGames_Response\x12\x1d\n" +
+	"\n" +
+	"game_count\x18\x01 \x01(\rR\tgameCount\x12:\n" +
+	"\x05games\x18\x02 \x03(\v2$.CPlayer_GetOwnedGames_Response.GameR\x05games\x1a\xe7\x05\n" +
+	"\x04Game\x12\x14\n" +
+	"\x05appid\x18\x01 \x01(\x05R\x05appid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x0fplaytime_2weeks\x18\x03 \x01(\x05R\x0eplaytime2weeks\x12)\n" +
+	"\x10playtime_forever\x18\x04 \x01(\x05R\x0fplaytimeForever\x12 \n" +
+	"\fimg_icon_url\x18\x05 \x01(\tR\n" +
+	"imgIconUrl\x12=\n" +
+	"\x1bhas_community_visible_stats\x18\a \x01(\bR\x18hasCommunityVisibleStats\x128\n" +
+	"\x18playtime_windows_forever\x18\b \x01(\x05R\x16playtimeWindowsForever\x120\n" +
+	"\x14playtime_mac_forever\x18\t \x01(\x05R\x12playtimeMacForever\x124\n" +
+	"\x16playtime_linux_forever\x18\n" +
+	" \x01(\x05R\x14playtimeLinuxForever\x12*\n" +
+	"\x11rtime_last_played\x18\v \x01(\rR\x0frtimeLastPlayed\x12)\n" +
+	"\x10capsule_filename\x18\f \x01(\tR\x0fcapsuleFilename\x12\x17\n" +
+	"\asort_as\x18\r \x01(\tR\x06sortAs\x12!\n" +
+	"\fhas_workshop\x18\x0e \x01(\bR\vhasWorkshop\x12\x1d\n" +
+	"\n" +
+	"has_market\x18\x0f \x01(\bR\thasMarket\x12\x17\n" +
+	"\ahas_dlc\x18\x10 \x01(\bR\x06hasDlc\x12)\n" +
+	"\x10has_leaderboards\x18\x11 \x01(\bR\x0fhasLeaderboards\x123\n" +
+	"\x15content_descriptorids\x18\x12 \x03(\rR\x14contentDescriptorids\x123\n" +
+	"\x15playtime_disconnected\x18\x13 \x01(\x05R\x14playtimeDisconnected\"X\n" +
+	"&CPlayer_GetRecentlyPlayedGames_Request\x12\x18\n" +
+	"\asteamid\x18\x01 \x01(\x04R\asteamid\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"\x99\x04\n" +
+	"'CPlayer_GetRecentlyPlayedGames_Response\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\rR\n" +
+	"totalCount\x12C\n" +
+	"\x05games\x18\x02 \x03(\v2-.CPlayer_GetRecentlyPlayedGames_Response.GameR\x05games\x1a\x87\x03\n" +
+	"\x04Game\x12\x14\n" +
+	"\x05appid\x18\x01 \x01(\x05R\x05appid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x0fplaytime_2weeks\x18\x03 \x01(\x05R\x0eplaytime2weeks\x12)\n" +
+	"\x10playtime_forever\x18\x04 \x01(\x05R\x0fplaytimeForever\x12 \n" +
+	"\fimg_icon_url\x18\x05 \x01(\tR\n" +
+	"imgIconUrl\x12=\n" +
+	"\x1bhas_community_visible_stats\x18\a \x01(\bR\x18hasCommunityVisibleStats\x128\n" +
+	"\x18playtime_windows_forever\x18\b \x01(\x05R\x16playtimeWindowsForever\x120\n" +
+	"\x14playtime_mac_forever\x18\t \x01(\x05R\x12playtimeMacForever\x124\n" +
+	"\x16playtime_linux_forever\x18\n" +
+	" \x01(\x05R\x14playtimeLinuxForever\":\n" +
+	"\"CPlayer_GetGameBadgeLevels_Request\x12\x14\n" +
+	"\x05appid\x18\x01 \x01(\rR\x05appid\"\xe6\x01\n" +
+	"#CPlayer_GetGameBadgeLevels_Response\x12!\n" +
+	"\fplayer_level\x18\x01 \x01(\rR\vplayerLevel\x12B\n" +
+	"\x06badges\x18\x02 \x03(\v2*.CPlayer_GetGameBadgeLevels_Response.BadgeR\x06badges\x1aX\n" +
+	"\x05Badge\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x05R\x05level\x12\x16\n" +
+	"\x06series\x18\x02 \x01(\x05R\x06series\x12!\n" +
+	"\fborder_color\x18\x03 \x01(\rR\vborderColor\"!\n" +
+	"\x1fCPlayer_GetNicknameList_Request\"\xbe\x01\n" +
+	" CPlayer_GetNicknameList_Response\x12N\n" +
+	"\tnicknames\x18\x01 \x03(\v20.CPlayer_GetNicknameList_Response.PlayerNicknameR\tnicknames\x1aJ\n" +
+	"\x0ePlayerNickname\x12\x1c\n" +
+	"\taccountid\x18\x01 \x01(\aR\taccountid\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname2\x80\x03\n" +
+	"\x06Player\x12k\n" +
+	"\x16GetRecentlyPlayedGames\x12'.CPlayer_GetRecentlyPlayedGames_Request\x1a(.CPlayer_GetRecentlyPlayedGames_Response\x12P\n" +
+	"\rGetOwnedGames\x12\x1e.CPlayer_GetOwnedGames_Request\x1a\x1f.CPlayer_GetOwnedGames_Response\x12V\n" +
+	"\x0fGetNicknameList\x12 .CPlayer_GetNicknameList_Request\x1a!.CPlayer_GetNicknameList_Response\x12_\n" +
+	"\x12GetGameBadgeLevels\x12#.CPlayer_GetGameBadgeLevels_Request\x1a$.CPlayer_GetGameBadgeLevels_ResponseB\x03\x80\x01\x01"
+
+var (
+	file_steammessages_player_steamclient_proto_rawDescOnce sync.Once
+	file_steammessages_player_steamclient_proto_rawDescData []byte
+)
+
+func file_steammessages_player_steamclient_proto_rawDescGZIP() []byte {
+	file_steammessages_player_steamclient_proto_rawDescOnce.Do(func() {
+		file_steammessages_player_steamclient_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_steammessages_player_steamclient_proto_rawDesc), len(file_steammessages_player_steamclient_proto_rawDesc)))
+	})
+	return file_steammessages_player_steamclient_proto_rawDescData
+}
+
+var file_steammessages_player_steamclient_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_steammessages_player_steamclient_proto_goTypes = []any{
+	(*CPlayer_GetOwnedGames_Request)(nil),                   // 0: CPlayer_GetOwnedGames_Request
+	(*CPlayer_GetOwnedGames_Response)(nil),                  // 1: CPlayer_GetOwnedGames_Response
+	(*CPlayer_GetRecentlyPlayedGames_Request)(nil),          // 2: CPlayer_GetRecentlyPlayedGames_Request
+	(*CPlayer_GetRecentlyPlayedGames_Response)(nil),         // 3: CPlayer_GetRecentlyPlayedGames_Response
+	(*CPlayer_GetGameBadgeLevels_Request)(nil),              // 4: CPlayer_GetGameBadgeLevels_Request
+	(*CPlayer_GetGameBadgeLevels_Response)(nil),             // 5: CPlayer_GetGameBadgeLevels_Response
+	(*CPlayer_GetNicknameList_Request)(nil),                 // 6: CPlayer_GetNicknameList_Request
+	(*CPlayer_GetNicknameList_Response)(nil),                // 7: CPlayer_GetNicknameList_Response
+	(*CPlayer_GetOwnedGames_Response_Game)(nil),             // 8: CPlayer_GetOwnedGames_Response.Game
+	(*CPlayer_GetRecentlyPlayedGames_Response_Game)(nil),    // 9: CPlayer_GetRecentlyPlayedGames_Response.Game
+	(*CPlayer_GetGameBadgeLevels_Response_Badge)(nil),       // 10: CPlayer_GetGameBadgeLevels_Response.Badge
+	(*CPlayer_GetNicknameList_Response_PlayerNickname)(nil), // 11: CPlayer_GetNicknameList_Response.PlayerNickname
+}
+var file_steammessages_player_steamclient_proto_depIdxs = []int32{
+	8,  // 0: CPlayer_GetOwnedGames_Response.games:type_name -> CPlayer_GetOwnedGames_Response.Game
+	9,  // 1: CPlayer_GetRecentlyPlayedGames_Response.games:type_name -> CPlayer_GetRecentlyPlayedGames_Response.Game
+	10, // 2: CPlayer_GetGameBadgeLevels_Response.badges:type_name -> CPlayer_GetGameBadgeLevels_Response.Badge
+	11, // 3: CPlayer_GetNicknameList_Response.nicknames:type_name -> CPlayer_GetNicknameList_Response.PlayerNickname
+	2,  // 4: Player.GetRecentlyPlayedGames:input_type -> CPlayer_GetRecentlyPlayedGames_Request
+	0,  // 5: Player.GetOwnedGames:input_type -> CPlayer_GetOwnedGames_Request
+	6,  // 6: Player.GetNicknameList:input_type -> CPlayer_GetNicknameList_Request
+	4,  // 7: Player.GetGameBadgeLevels:input_type -> CPlayer_GetGameBadgeLevels_Request
+	3,  // 8: Player.GetRecentlyPlayedGames:output_type -> CPlayer_GetRecentlyPlayedGames_Response
+	1,  // 9: Player.GetOwnedGames:output_type -> CPlayer_GetOwnedGames_Response
+	7,  // 10: Player.GetNicknameList:output_type -> CPlayer_GetNicknameList_Response
+	5,  // 11: Player.GetGameBadgeLevels:output_type -> CPlayer_GetGameBadgeLevels_Response
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_steammessages_player_steamclient_proto_init() }
+func file_steammessages_player_steamclient_proto_init() {
+	if File_steammessages_player_steamclient_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_steammessages_player_steamclient_proto_rawDesc), len(file_steammessages_player_steamclient_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_steammessages_player_steamclient_proto_goTypes,
+		DependencyIndexes: file_steammessages_player_steamclient_proto_depIdxs,
+		MessageInfos:      file_steammessages_player_steamclient_proto_msgTypes,
+	}.Build()
+	File_steammessages_player_steamclient_proto = out.File
+	file_steammessages_player_steamclient_proto_goTypes = nil
+	file_steammessages_player_steamclient_proto_depIdxs = nil
+}
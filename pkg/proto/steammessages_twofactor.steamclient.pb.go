@@ -0,0 +1,1042 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: steammessages_twofactor.steamclient.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CTwoFactor_Time_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderTime    *uint64                `protobuf:"varint,1,opt,name=sender_time,json=senderTime" json:"sender_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CTwoFactor_Time_Request) Reset() {
+	*x = CTwoFactor_Time_Request{}
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTwoFactor_Time_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_Time_Request) ProtoMessage() {}
+
+func (x *CTwoFactor_Time_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_Time_Request.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_Time_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CTwoFactor_Time_Request) GetSenderTime() uint64 {
+	if x != nil && x.SenderTime != nil {
+		return *x.SenderTime
+	}
+	return 0
+}
+
+type CTwoFactor_Time_Response struct {
+	state                             protoimpl.MessageState `protogen:"open.v1"`
+	ServerTime                        *uint64                `protobuf:"varint,1,opt,name=server_time,json=serverTime" json:"server_time,omitempty"`
+	SkewToleranceSeconds              *uint64                `protobuf:"varint,2,opt,name=skew_tolerance_seconds,json=skewToleranceSeconds" json:"skew_tolerance_seconds,omitempty"`
+	LargeTimeJink                     *uint64                `protobuf:"varint,3,opt,name=large_time_jink,json=largeTimeJink" json:"large_time_jink,omitempty"`
+	ProbeFrequencySeconds             *uint32                `protobuf:"varint,4,opt,name=probe_frequency_seconds,json=probeFrequencySeconds" json:"probe_frequency_seconds,omitempty"`
+	AdjustedTimeProbeFrequencySeconds *uint32                `protobuf:"varint,5,opt,name=adjusted_time_probe_frequency_seconds,json=adjustedTimeProbeFrequencySeconds" json:"adjusted_time_probe_frequency_seconds,omitempty"`
+	HintProbeFrequencySeconds         *uint32                `protobuf:"varint,6,opt,name=hint_probe_frequency_seconds,json=hintProbeFrequencySeconds" json:"hint_probe_frequency_seconds,omitempty"`
+	SyncTimeout                       *uint32                `protobuf:"varint,7,opt,name=sync_timeout,json=syncTimeout" json:"sync_timeout,omitempty"`
+	TryAgainSeconds                   *uint32                `protobuf:"varint,8,opt,name=try_again_seconds,json=tryAgainSeconds" json:"try_again_seconds,omitempty"`
+	MaxAttempts                       *uint32                `protobuf:"varint,9,opt,name=max_attempts,json=maxAttempts" json:"max_attempts,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *CTwoFactor_Time_Response) Reset() {
+	*x = CTwoFactor_Time_Response{}
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTwoFactor_Time_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_Time_Response) ProtoMessage() {}
+
+func (x *CTwoFactor_Time_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_Time_Response.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_Time_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CTwoFactor_Time_Response) GetServerTime() uint64 {
+	if x != nil && x.ServerTime != nil {
+		return *x.ServerTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetSkewToleranceSeconds() uint64 {
+	if x != nil && x.SkewToleranceSeconds != nil {
+		return *x.SkewToleranceSeconds
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetLargeTimeJink() uint64 {
+	if x != nil && x.LargeTimeJink != nil {
+		return *x.LargeTimeJink
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetProbeFrequencySeconds() uint32 {
+	if x != nil && x.ProbeFrequencySeconds != nil {
+		return *x.ProbeFrequencySeconds
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetAdjustedTimeProbeFrequencySeconds() uint32 {
+	if x != nil && x.AdjustedTimeProbeFrequencySeconds != nil {
+		return *x.AdjustedTimeProbeFrequencySeconds
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetHintProbeFrequencySeconds() uint32 {
+	if x != nil && x.HintProbeFrequencySeconds != nil {
+		return *x.HintProbeFrequencySeconds
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetSyncTimeout() uint32 {
+	if x != nil && x.SyncTimeout != nil {
+		return *x.SyncTimeout
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetTryAgainSeconds() uint32 {
+	if x != nil && x.TryAgainSeconds != nil {
+		return *x.TryAgainSeconds
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetMaxAttempts() uint32 {
+	if x != nil && x.MaxAttempts != nil {
+		return *x.MaxAttempts
+	}
+	return 0
+}
+
+type CTwoFactor_Status_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Steamid       *uint64                `protobuf:"fixed64,1,opt,name=steamid" json:"steamid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CTwoFactor_Status_Request) Reset() {
+	*x = CTwoFactor_Status_Request{}
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTwoFactor_Status_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_Status_Request) ProtoMessage() {}
+
+func (x *CTwoFactor_Status_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_Status_Request.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_Status_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CTwoFactor_Status_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+type CTwoFactor_Status_Response struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	State                       *uint32                `protobuf:"varint,1,opt,name=state" json:"state,omitempty"`
+	InactivationReason          *uint32                `protobuf:"varint,2,opt,name=inactivation_reason,json=inactivationReason" json:"inactivation_reason,omitempty"`
+	AuthenticatorType           *uint32                `protobuf:"varint,3,opt,name=authenticator_type,json=authenticatorType" json:"authenticator_type,omitempty"`
+	AuthenticatorAllowed        *bool                  `protobuf:"varint,4,opt,name=authenticator_allowed,json=authenticatorAllowed" json:"authenticator_allowed,omitempty"`
+	SteamguardScheme            *uint32                `protobuf:"varint,5,opt,name=steamguard_scheme,json=steamguardScheme" json:"steamguard_scheme,omitempty"`
+	TokenGid                    *string                `protobuf:"bytes,6,opt,name=token_gid,json=tokenGid" json:"token_gid,omitempty"`
+	EmailValidated              *bool                  `protobuf:"varint,7,opt,name=email_validated,json=emailValidated" json:"email_validated,omitempty"`
+	DeviceIdentifier            *string                `protobuf:"bytes,8,opt,name=device_identifier,json=deviceIdentifier" json:"device_identifier,omitempty"`
+	TimeCreated                 *uint32                `protobuf:"varint,9,opt,name=time_created,json=timeCreated" json:"time_created,omitempty"`
+	RevocationAttemptsRemaining *uint32                `protobuf:"varint,10,opt,name=revocation_attempts_remaining,json=revocationAttemptsRemaining" json:"revocation_attempts_remaining,omitempty"`
+	ClassifiedAgent             *string                `protobuf:"bytes,11,opt,name=classified_agent,json=classifiedAgent" json:"classified_agent,omitempty"`
+	AllowExternalAuthenticator  *bool                  `protobuf:"varint,12,opt,name=allow_external_authenticator,json=allowExternalAuthenticator" json:"allow_external_authenticator,omitempty"`
+	TimeTransferred             *uint32                `protobuf:"varint,13,opt,name=time_transferred,json=timeTransferred" json:"time_transferred,omitempty"`
+	Version                     *uint32                `protobuf:"varint,14,opt,name=version" json:"version,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *CTwoFactor_Status_Response) Reset() {
+	*x = CTwoFactor_Status_Response{}
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTwoFactor_Status_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_Status_Response) ProtoMessage() {}
+
+func (x *CTwoFactor_Status_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_Status_Response.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_Status_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CTwoFactor_Status_Response) GetState() uint32 {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetInactivationReason() uint32 {
+	if x != nil && x.InactivationReason != nil {
+		return *x.InactivationReason
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetAuthenticatorType() uint32 {
+	if x != nil && x.AuthenticatorType != nil {
+		return *x.AuthenticatorType
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetAuthenticatorAllowed() bool {
+	if x != nil && x.AuthenticatorAllowed != nil {
+		return *x.AuthenticatorAllowed
+	}
+	return false
+}
+
+func (x *CTwoFactor_Status_Response) GetSteamguardScheme() uint32 {
+	if x != nil && x.SteamguardScheme != nil {
+		return *x.SteamguardScheme
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetTokenGid() string {
+	if x != nil && x.TokenGid != nil {
+		return *x.TokenGid
+	}
+	return ""
+}
+
+func (x *CTwoFactor_Status_Response) GetEmailValidated() bool {
+	if x != nil && x.EmailValidated != nil {
+		return *x.EmailValidated
+	}
+	return false
+}
+
+func (x *CTwoFactor_Status_Response) GetDeviceIdentifier() string {
+	if x != nil && x.DeviceIdentifier != nil {
+		return *x.DeviceIdentifier
+	}
+	return ""
+}
+
+func (x *CTwoFactor_Status_Response) GetTimeCreated() uint32 {
+	if x != nil && x.TimeCreated != nil {
+		return *x.TimeCreated
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetRevocationAttemptsRemaining() uint32 {
+	if x != nil && x.RevocationAttemptsRemaining != nil {
+		return *x.RevocationAttemptsRemaining
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetClassifiedAgent() string {
+	if x != nil && x.ClassifiedAgent != nil {
+		return *x.ClassifiedAgent
+	}
+	return ""
+}
+
+func (x *CTwoFactor_Status_Response) GetAllowExternalAuthenticator() bool {
+	if x != nil && x.AllowExternalAuthenticator != nil {
+		return *x.AllowExternalAuthenticator
+	}
+	return false
+}
+
+func (x *CTwoFactor_Status_Response) GetTimeTransferred() uint32 {
+	if x != nil && x.TimeTransferred != nil {
+		return *x.TimeTransferred
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type CTwoFactor_AddAuthenticator_Request struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Steamid           *uint64                `protobuf:"fixed64,1,opt,name=steamid" json:"steamid,omitempty"`
+	AuthenticatorTime *uint64                `protobuf:"varint,2,opt,name=authenticator_time,json=authenticatorTime" json:"authenticator_time,omitempty"`
+	SerialNumber      *uint64                `protobuf:"fixed64,3,opt,name=serial_number,json=serialNumber" json:"serial_number,omitempty"`
+	AuthenticatorType *uint32                `protobuf:"varint,4,opt,name=authenticator_type,json=authenticatorType" json:"authenticator_type,omitempty"`
+	DeviceIdentifier  *string                `protobuf:"bytes,5,opt,name=device_identifier,json=deviceIdentifier" json:"device_identifier,omitempty"`
+	SmsPhoneId        *string                `protobuf:"bytes,6,opt,name=sms_phone_id,json=smsPhoneId" json:"sms_phone_id,omitempty"`
+	HttpHeaders       []string               `protobuf:"bytes,7,rep,name=http_headers,json=httpHeaders" json:"http_headers,omitempty"`
+	Version           *uint32                `protobuf:"varint,8,opt,name=version,def=1" json:"version,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+// Default values for CTwoFactor_AddAuthenticator_Request fields.
+const (
+	Default_CTwoFactor_AddAuthenticator_Request_Version = uint32(1)
+)
+
+func (x *CTwoFactor_AddAuthenticator_Request) Reset() {
+	*x = CTwoFactor_AddAuthenticator_Request{}
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_AddAuthenticator_Request) ProtoMessage() {}
+
+func (x *CTwoFactor_AddAuthenticator_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_AddAuthenticator_Request.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_AddAuthenticator_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetAuthenticatorTime() uint64 {
+	if x != nil && x.AuthenticatorTime != nil {
+		return *x.AuthenticatorTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetSerialNumber() uint64 {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetAuthenticatorType() uint32 {
+	if x != nil && x.AuthenticatorType != nil {
+		return *x.AuthenticatorType
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetDeviceIdentifier() string {
+	if x != nil && x.DeviceIdentifier != nil {
+		return *x.DeviceIdentifier
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetSmsPhoneId() string {
+	if x != nil && x.SmsPhoneId != nil {
+		return *x.SmsPhoneId
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetHttpHeaders() []string {
+	if x != nil {
+		return x.HttpHeaders
+	}
+	return nil
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return Default_CTwoFactor_AddAuthenticator_Request_Version
+}
+
+type CTwoFactor_AddAuthenticator_Response struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SharedSecret    []byte                 `protobuf:"bytes,1,opt,name=shared_secret,json=sharedSecret" json:"shared_secret,omitempty"`
+	SerialNumber    *uint64                `protobuf:"fixed64,2,opt,name=serial_number,json=serialNumber" json:"serial_number,omitempty"`
+	RevocationCode  *string                `protobuf:"bytes,3,opt,name=revocation_code,json=revocationCode" json:"revocation_code,omitempty"`
+	Uri             *string                `protobuf:"bytes,4,opt,name=uri" json:"uri,omitempty"`
+	ServerTime      *uint64                `protobuf:"varint,5,opt,name=server_time,json=serverTime" json:"server_time,omitempty"`
+	AccountName     *string                `protobuf:"bytes,6,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+	TokenGid        *string                `protobuf:"bytes,7,opt,name=token_gid,json=tokenGid" json:"token_gid,omitempty"`
+	IdentitySecret  []byte                 `protobuf:"bytes,8,opt,name=identity_secret,json=identitySecret" json:"identity_secret,omitempty"`
+	Secret_1        []byte                 `protobuf:"bytes,9,opt,name=secret_1,json=secret1" json:"secret_1,omitempty"`
+	Status          *int32                 `protobuf:"varint,10,opt,name=status" json:"status,omitempty"`
+	PhoneNumberHint *string                `protobuf:"bytes,11,opt,name=phone_number_hint,json=phoneNumberHint" json:"phone_number_hint,omitempty"`
+	ConfirmType     *int32                 `protobuf:"varint,12,opt,name=confirm_type,json=confirmType" json:"confirm_type,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) Reset() {
+	*x = CTwoFactor_AddAuthenticator_Response{}
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_AddAuthenticator_Response) ProtoMessage() {}
+
+func (x *CTwoFactor_AddAuthenticator_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_AddAuthenticator_Response.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_AddAuthenticator_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetSharedSecret() []byte {
+	if x != nil {
+		return x.SharedSecret
+	}
+	return nil
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetSerialNumber() uint64 {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetRevocationCode() string {
+	if x != nil && x.RevocationCode != nil {
+		return *x.RevocationCode
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetUri() string {
+	if x != nil && x.Uri != nil {
+		return *x.Uri
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetServerTime() uint64 {
+	if x != nil && x.ServerTime != nil {
+		return *x.ServerTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetAccountName() string {
+	if x != nil && x.AccountName != nil {
+		return *x.AccountName
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetTokenGid() string {
+	if x != nil && x.TokenGid != nil {
+		return *x.TokenGid
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetIdentitySecret() []byte {
+	if x != nil {
+		return x.IdentitySecret
+	}
+	return nil
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetSecret_1() []byte {
+	if x != nil {
+		return x.Secret_1
+	}
+	return nil
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetPhoneNumberHint() string {
+	if x != nil && x.PhoneNumberHint != nil {
+		return *x.PhoneNumberHint
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetConfirmType() int32 {
+	if x != nil && x.ConfirmType != nil {
+		return *x.ConfirmType
+	}
+	return 0
+}
+
+type CTwoFactor_FinalizeAddAuthenticator_Request struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Steamid           *uint64                `protobuf:"fixed64,1,opt,name=steamid" json:"steamid,omitempty"`
+	AuthenticatorCode *string                `protobuf:"bytes,2,opt,name=authenticator_code,json=authenticatorCode" json:"authenticator_code,omitempty"`
+	AuthenticatorTime *uint64                `protobuf:"varint,3,opt,name=authenticator_time,json=authenticatorTime" json:"authenticator_time,omitempty"`
+	ActivationCode    *string                `protobuf:"bytes,4,opt,name=activation_code,json=activationCode" json:"activation_code,omitempty"`
+	HttpHeaders       []string               `protobuf:"bytes,5,rep,name=http_headers,json=httpHeaders" json:"http_headers,omitempty"`
+	ValidateSmsCode   *bool                  `protobuf:"varint,6,opt,name=validate_sms_code,json=validateSmsCode" json:"validate_sms_code,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) Reset() {
+	*x = CTwoFactor_FinalizeAddAuthenticator_Request{}
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_FinalizeAddAuthenticator_Request) ProtoMessage() {}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_FinalizeAddAuthenticator_Request.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_FinalizeAddAuthenticator_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetAuthenticatorCode() string {
+	if x != nil && x.AuthenticatorCode != nil {
+		return *x.AuthenticatorCode
+	}
+	return ""
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetAuthenticatorTime() uint64 {
+	if x != nil && x.AuthenticatorTime != nil {
+		return *x.AuthenticatorTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetActivationCode() string {
+	if x != nil && x.ActivationCode != nil {
+		return *x.ActivationCode
+	}
+	return ""
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetHttpHeaders() []string {
+	if x != nil {
+		return x.HttpHeaders
+	}
+	return nil
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetValidateSmsCode() bool {
+	if x != nil && x.ValidateSmsCode != nil {
+		return *x.ValidateSmsCode
+	}
+	return false
+}
+
+type CTwoFactor_FinalizeAddAuthenticator_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       *bool                  `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	WantMore      *bool                  `protobuf:"varint,2,opt,name=want_more,json=wantMore" json:"want_more,omitempty"`
+	ServerTime    *uint64                `protobuf:"varint,3,opt,name=server_time,json=serverTime" json:"server_time,omitempty"`
+	Status        *int32                 `protobuf:"varint,4,opt,name=status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) Reset() {
+	*x = CTwoFactor_FinalizeAddAuthenticator_Response{}
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_FinalizeAddAuthenticator_Response) ProtoMessage() {}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_FinalizeAddAuthenticator_Response.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_FinalizeAddAuthenticator_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) GetWantMore() bool {
+	if x != nil && x.WantMore != nil {
+		return *x.WantMore
+	}
+	return false
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) GetServerTime() uint64 {
+	if x != nil && x.ServerTime != nil {
+		return *x.ServerTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+type CTwoFactor_RemoveAuthenticator_Request struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	RevocationCode             *string                `protobuf:"bytes,2,opt,name=revocation_code,json=revocationCode" json:"revocation_code,omitempty"`
+	RevocationReason           *uint32                `protobuf:"varint,5,opt,name=revocation_reason,json=revocationReason" json:"revocation_reason,omitempty"`
+	SteamguardScheme           *uint32                `protobuf:"varint,6,opt,name=steamguard_scheme,json=steamguardScheme" json:"steamguard_scheme,omitempty"`
+	RemoveAllSteamguardCookies *bool                  `protobuf:"varint,7,opt,name=remove_all_steamguard_cookies,json=removeAllSteamguardCookies" json:"remove_all_steamguard_cookies,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) Reset() {
+	*x = CTwoFactor_RemoveAuthenticator_Request{}
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_RemoveAuthenticator_Request) ProtoMessage() {}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_RemoveAuthenticator_Request.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_RemoveAuthenticator_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) GetRevocationCode() string {
+	if x != nil && x.RevocationCode != nil {
+		return *x.RevocationCode
+	}
+	return ""
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) GetRevocationReason() uint32 {
+	if x != nil && x.RevocationReason != nil {
+		return *x.RevocationReason
+	}
+	return 0
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) GetSteamguardScheme() uint32 {
+	if x != nil && x.SteamguardScheme != nil {
+		return *x.SteamguardScheme
+	}
+	return 0
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) GetRemoveAllSteamguardCookies() bool {
+	if x != nil && x.RemoveAllSteamguardCookies != nil {
+		return *x.RemoveAllSteamguardCookies
+	}
+	return false
+}
+
+type CTwoFactor_RemoveAuthenticator_Response struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	Success                     *bool                  `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	ServerTime                  *uint64                `protobuf:"varint,3,opt,name=server_time,json=serverTime" json:"server_time,omitempty"`
+	RevocationAttemptsRemaining *uint32                `protobuf:"varint,5,opt,name=revocation_attempts_remaining,json=revocationAttemptsRemaining" json:"revocation_attempts_remaining,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) Reset() {
+	*x = CTwoFactor_RemoveAuthenticator_Response{}
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_RemoveAuthenticator_Response) ProtoMessage() {}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_RemoveAuthenticator_Response.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_RemoveAuthenticator_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) GetServerTime() uint64 {
+	if x != nil && x.ServerTime != nil {
+		return *x.ServerTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) GetRevocationAttemptsRemaining() uint32 {
+	if x != nil && x.RevocationAttemptsRemaining != nil {
+		return *x.RevocationAttemptsRemaining
+	}
+	return 0
+}
+
+var File_steammessages_twofactor_steamclient_proto protoreflect.FileDescriptor
+
+const file_steammessages_twofactor_steamclient_proto_rawDesc = "" +
+	"\n" +
+	")steammessages_twofactor.steamclient.proto\":\n" +
+	"\x17CTwoFactor_Time_Request\x12\x1f\n" +
+	"\vsender_time\x18\x01 \x01(\x04R\n" +
+	"senderTime\"\xd6\x03\n" +
+	"\x18CTwoFactor_Time_Response\x12\x1f\n" +
+	"\vserver_time\x18\x01 \x01(\x04R\n" +
+	"serverTime\x124\n" +
+	"\x16skew_tolerance_seconds\x18\x02 \x01(\x04R\x14skewToleranceSeconds\x12&\n" +
+	"\x0flarge_time_jink\x18\x03 \x01(\x04R\rlargeTimeJink\x126\n" +
+	"\x17probe_frequency_seconds\x18\x04 \x01(\rR\x15probeFrequencySeconds\x12P\n" +
+	"%adjusted_time_probe_frequency_seconds\x18\x05 \x01(\rR!adjustedTimeProbeFrequencySeconds\x12?\n" +
+	"\x1chint_probe_frequency_seconds\x18\x06 \x01(\rR\x19hintProbeFrequencySeconds\x12!\n" +
+	"\fsync_timeout\x18\a \x01(\rR\vsyncTimeout\x12*\n" +
+	"\x11try_again_seconds\x18\b \x01(\rR\x0ftryAgainSeconds\x12!\n" +
+	"\fmax_attempts\x18\t \x01(\rR\vmaxAttempts\"5\n" +
+	"\x19CTwoFactor_Status_Request\x12\x18\n" +
+	"\asteamid\x18\x01 \x01(\x06R\asteamid\"\x80\x05\n" +
+	"\x1aCTwoFactor_Status_Response\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\rR\x05state\x12/\n" +
+	"\x13inactivation_reason\x18\x02 \x01(\rR\x12inactivationReason\x12-\n" +
+	"\x12authenticator_type\x18\x03 \x01(\rR\x11authenticatorType\x123\n" +
+	"\x15authenticator_allowed\x18\x04 \x01(\bR\x14authenticatorAllowed\x12+\n" +
+	"\x11steamguard_scheme\x18\x05 \x01(\rR\x10steamguardScheme\x12\x1b\n" +
+	"\ttoken_gid\x18\x06 \x01(\tR\btokenGid\x12'\n" +
+	"\x0femail_validated\x18\a \x01(\bR\x0eemailValidated\x12+\n" +
+	"\x11device_identifier\x18\b \x01(\tR\x10deviceIdentifier\x12!\n" +
+	"\ftime_created\x18\t \x01(\rR\vtimeCreated\x12B\n" +
+	"\x1drevocation_attempts_remaining\x18\n" +
+	" \x01(\rR\x1brevocationAttemptsRemaining\x12)\n" +
+	"\x10classified_agent\x18\v \x01(\tR\x0fclassifiedAgent\x12@\n" +
+	"\x1callow_external_authenticator\x18\f \x01(\bR\x1aallowExternalAuthenticator\x12)\n" +
+	"\x10time_transferred\x18\r \x01(\rR\x0ftimeTransferred\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\rR\aversion\"\xd1\x02\n" +
+	"#CTwoFactor_AddAuthenticator_Request\x12\x18\n" +
+	"\asteamid\x18\x01 \x01(\x06R\asteamid\x12-\n" +
+	"\x12authenticator_time\x18\x02 \x01(\x04R\x11authenticatorTime\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\x06R\fserialNumber\x12-\n" +
+	"\x12authenticator_type\x18\x04 \x01(\rR\x11authenticatorType\x12+\n" +
+	"\x11device_identifier\x18\x05 \x01(\tR\x10deviceIdentifier\x12 \n" +
+	"\fsms_phone_id\x18\x06 \x01(\tR\n" +
+	"smsPhoneId\x12!\n" +
+	"\fhttp_headers\x18\a \x03(\tR\vhttpHeaders\x12\x1b\n" +
+	"\aversion\x18\b \x01(\r:\x011R\aversion\"\xb7\x03\n" +
+	"$CTwoFactor_AddAuthenticator_Response\x12#\n" +
+	"\rshared_secret\x18\x01 \x01(\fR\fsharedSecret\x12#\n" +
+	"\rserial_number\x18\x02 \x01(\x06R\fserialNumber\x12'\n" +
+	"\x0frevocation_code\x18\x03 \x01(\tR\x0erevocationCode\x12\x10\n" +
+	"\x03uri\x18\x04 \x01(\tR\x03uri\x12\x1f\n" +
+	"\vserver_time\x18\x05 \x01(\x04R\n" +
+	"serverTime\x12!\n" +
+	"\faccount_name\x18\x06 \x01(\tR\vaccountName\x12\x1b\n" +
+	"\ttoken_gid\x18\a \x01(\tR\btokenGid\x12'\n" +
+	"\x0fidentity_secret\x18\b \x01(\fR\x0eidentitySecret\x12\x19\n" +
+	"\bsecret_1\x18\t \x01(\fR\asecret1\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\x05R\x06status\x12*\n" +
+	"\x11phone_number_hint\x18\v \x01(\tR\x0fphoneNumberHint\x12!\n" +
+	"\fconfirm_type\x18\f \x01(\x05R\vconfirmType\"\x9d\x02\n" +
+	"+CTwoFactor_FinalizeAddAuthenticator_Request\x12\x18\n" +
+	"\asteamid\x18\x01 \x01(\x06R\asteamid\x12-\n" +
+	"\x12authenticator_code\x18\x02 \x01(\tR\x11authenticatorCode\x12-\n" +
+	"\x12authenticator_time\x18\x03 \x01(\x04R\x11authenticatorTime\x12'\n" +
+	"\x0factivation_code\x18\x04 \x01(\tR\x0eactivationCode\x12!\n" +
+	"\fhttp_headers\x18\x05 \x03(\tR\vhttpHeaders\x12*\n" +
+	"\x11validate_sms_code\x18\x06 \x01(\bR\x0fvalidateSmsCode\"\x9e\x01\n" +
+	",CTwoFactor_FinalizeAddAuthenticator_Response\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\twant_more\x18\x02 \x01(\bR\bwantMore\x12\x1f\n" +
+	"\vserver_time\x18\x03 \x01(\x04R\n" +
+	"serverTime\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\"\xee\x01\n" +
+	"&CTwoFactor_RemoveAuthenticator_Request\x12'\n" +
+	"\x0frevocation_code\x18\x02 \x01(\tR\x0erevocationCode\x12+\n" +
+	"\x11revocation_reason\x18\x05 \x01(\rR\x10revocationReason\x12+\n" +
+	"\x11steamguard_scheme\x18\x06 \x01(\rR\x10steamguardScheme\x12A\n" +
+	"\x1dremove_all_steamguard_cookies\x18\a \x01(\bR\x1aremoveAllSteamguardCookies\"\xa8\x01\n" +
+	"'CTwoFactor_RemoveAuthenticator_Response\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\vserver_time\x18\x03 \x01(\x04R\n" +
+	"serverTime\x12B\n" +
+	"\x1drevocation_attempts_remaining\x18\x05 \x01(\rR\x1brevocationAttemptsRemaining2\xd9\x03\n" +
+	"\tTwoFactor\x12F\n" +
+	"\vQueryStatus\x12\x1a.CTwoFactor_Status_Request\x1a\x1b.CTwoFactor_Status_Response\x12_\n" +
+	"\x10AddAuthenticator\x12$.CTwoFactor_AddAuthenticator_Request\x1a%.CTwoFactor_AddAuthenticator_Response\x12w\n" +
+	"\x18FinalizeAddAuthenticator\x12,.CTwoFactor_FinalizeAddAuthenticator_Request\x1a-.CTwoFactor_FinalizeAddAuthenticator_Response\x12h\n" +
+	"\x13RemoveAuthenticator\x12'.CTwoFactor_RemoveAuthenticator_Request\x1a(.CTwoFactor_RemoveAuthenticator_Response\x12@\n" +
+	"\tQueryTime\x12\x18.CTwoFactor_Time_Request\x1a\x19.CTwoFactor_Time_ResponseB\x03\x80\x01\x01"
+
+var (
+	file_steammessages_twofactor_steamclient_proto_rawDescOnce sync.Once
+	file_steammessages_twofactor_steamclient_proto_rawDescData []byte
+)
+
+func file_steammessages_twofactor_steamclient_proto_rawDescGZIP() []byte {
+	file_steammessages_twofactor_steamclient_proto_rawDescOnce.Do(func() {
+		file_steammessages_twofactor_steamclient_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_steammessages_twofactor_steamclient_proto_rawDesc), len(file_steammessages_twofactor_steamclient_proto_rawDesc)))
+	})
+	return file_steammessages_twofactor_steamclient_proto_rawDescData
+}
+
+var file_steammessages_twofactor_steamclient_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_steammessages_twofactor_steamclient_proto_goTypes = []any{
+	(*CTwoFactor_Time_Request)(nil),                      // 0: CTwoFactor_Time_Request
+	(*CTwoFactor_Time_Response)(nil),                     // 1: CTwoFactor_Time_Response
+	(*CTwoFactor_Status_Request)(nil),                    // 2: CTwoFactor_Status_Request
+	(*CTwoFactor_Status_Response)(nil),                   // 3: CTwoFactor_Status_Response
+	(*CTwoFactor_AddAuthenticator_Request)(nil),          // 4: CTwoFactor_AddAuthenticator_Request
+	(*CTwoFactor_AddAuthenticator_Response)(nil),         // 5: CTwoFactor_AddAuthenticator_Response
+	(*CTwoFactor_FinalizeAddAuthenticator_Request)(nil),  // 6: CTwoFactor_FinalizeAddAuthenticator_Request
+	(*CTwoFactor_FinalizeAddAuthenticator_Response)(nil), // 7: CTwoFactor_FinalizeAddAuthenticator_Response
+	(*CTwoFactor_RemoveAuthenticator_Request)(nil),       // 8: CTwoFactor_RemoveAuthenticator_Request
+	(*CTwoFactor_RemoveAuthenticator_Response)(nil),      // 9: CTwoFactor_RemoveAuthenticator_Response
+}
+var file_steammessages_twofactor_steamclient_proto_depIdxs = []int32{
+	2, // 0: TwoFactor.QueryStatus:input_type -> CTwoFactor_Status_Request
+	4, // 1: TwoFactor.AddAuthenticator:input_type -> CTwoFactor_AddAuthenticator_Request
+	6, // 2: TwoFactor.FinalizeAddAuthenticator:input_type -> CTwoFactor_FinalizeAddAuthenticator_Request
+	8, // 3: TwoFactor.RemoveAuthenticator:input_type -> CTwoFactor_RemoveAuthenticator_Request
+	0, // 4: TwoFactor.QueryTime:input_type -> CTwoFactor_Time_Request
+	3, // 5: TwoFactor.QueryStatus:output_type -> CTwoFactor_Status_Response
+	5, // 6: TwoFactor.AddAuthenticator:output_type -> CTwoFactor_AddAuthenticator_Response
+	7, // 7: TwoFactor.FinalizeAddAuthenticator:output_type -> CTwoFactor_FinalizeAddAuthenticator_Response
+	9, // 8: TwoFactor.RemoveAuthenticator:output_type -> CTwoFactor_RemoveAuthenticator_Response
+	1, // 9: TwoFactor.QueryTime:output_type -> CTwoFactor_Time_Response
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_steammessages_twofactor_steamclient_proto_init() }
+func file_steammessages_twofactor_steamclient_proto_init() {
+	if File_steammessages_twofactor_steamclient_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_steammessages_twofactor_steamclient_proto_rawDesc), len(file_steammessages_twofactor_steamclient_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_steammessages_twofactor_steamclient_proto_goTypes,
+		DependencyIndexes: file_steammessages_twofactor_steamclient_proto_depIdxs,
+		MessageInfos:      file_steammessages_twofactor_steamclient_proto_msgTypes,
+	}.Build()
+	File_steammessages_twofactor_steamclient_proto = out.File
+	file_steammessages_twofactor_steamclient_proto_goTypes = nil
+	file_steammessages_twofactor_steamclient_proto_depIdxs = nil
+}
@@ -0,0 +1,336 @@
+// Package ratelimit throttles the requests sent to steam with a token bucket per endpoint class,
+// and backs off with a cool-down shared by every caller once steam answers 429 or 503.
+// Steam limits per IP: share one Limiter between the cores behind the same proxy,
+// and give every proxy or account with its own IP a Limiter of its own.
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+type Class string
+
+const (
+	ClassPriceHistory   Class = "pricehistory"
+	ClassOrderHistogram Class = "itemordershistogram"
+	ClassPriceOverview  Class = "priceoverview"
+	ClassInventory      Class = "inventory"
+	ClassMarket         Class = "market"    // other market pages and actions
+	ClassWebAPI         Class = "webapi"    // api.steampowered.com services
+	ClassCommunity      Class = "community" // every other request
+)
+
+// Endpoint class of a request, from its path only so the test servers are classified alike
+func Classify(req *http.Request) Class {
+	path := req.URL.Path
+	switch {
+	case strings.HasPrefix(path, "/market/pricehistory"):
+		return ClassPriceHistory
+	case strings.HasPrefix(path, "/market/itemordershistogram"):
+		return ClassOrderHistogram
+	case strings.HasPrefix(path, "/market/priceoverview"):
+		return ClassPriceOverview
+	case strings.HasPrefix(path, "/inventory/"):
+		return ClassInventory
+	case strings.HasPrefix(path, "/market"):
+		return ClassMarket
+	case strings.HasPrefix(path, "/I") && strings.Contains(path, "Service/"):
+		return ClassWebAPI
+	}
+	return ClassCommunity
+}
+
+type Limit struct {
+	Interval time.Duration // one request every interval on average, 0 means unlimited
+	Burst    int           // requests allowed at once after an idle period, 1 while < 1
+}
+
+// Known-safe limits for a single IP
+func DefaultLimits() map[Class]Limit {
+	return map[Class]Limit{
+		ClassPriceHistory:   {Interval: 4 * time.Second, Burst: 1},
+		ClassOrderHistogram: {Interval: 3 * time.Second, Burst: 2},
+		ClassPriceOverview:  {Interval: 3 * time.Second, Burst: 2},
+		ClassInventory:      {Interval: 4 * time.Second, Burst: 1},
+		ClassMarket:         {Interval: time.Second, Burst: 3},
+		ClassWebAPI:         {Interval: 200 * time.Millisecond, Burst: 10},
+		ClassCommunity:      {Interval: 500 * time.Millisecond, Burst: 5},
+	}
+}
+
+type Backoff struct {
+	Base       time.Duration // cool-down after the first 429 or 503, doubled on every consecutive one
+	Max        time.Duration // upper bound of the cool-down
+	MaxRetries int           // retries of a throttled idempotent request before its response is returned, 0 disables
+}
+
+func DefaultBackoff() Backoff {
+	return Backoff{Base: 5 * time.Second, Max: 5 * time.Minute, MaxRetries: 3}
+}
+
+type EventType int
+
+const (
+	EventWait      EventType = iota // a request is delayed by the bucket or the cool-down
+	EventThrottled                  // steam answered 429 or 503, the class cools down
+	EventRetry                      // a throttled request is sent again after the cool-down
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventWait:
+		return "Wait"
+	case EventThrottled:
+		return "Throttled"
+	case EventRetry:
+		return "Retry"
+	}
+	return "EventType(" + strconv.Itoa(int(t)) + ")"
+}
+
+type Event struct {
+	Type    EventType
+	Class   Class
+	Time    time.Time
+	Wait    time.Duration // delay before the request, or the cool-down for EventThrottled
+	Status  int           // http status for EventThrottled
+	Attempt int           // retry count for EventRetry
+}
+
+type Stats struct {
+	Requests      uint64
+	Throttled     uint64
+	Retries       uint64
+	Waited        time.Duration // total delay added by the limiter
+	CooldownUntil time.Time     // zero while the class is not cooling down
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter is safe for concurrent use, install it with auth.Core.SetRateLimiter
+type Limiter struct {
+	mu       sync.Mutex
+	clock    utils.Clock
+	random   utils.Rand
+	limits   map[Class]Limit
+	backoff  Backoff
+	handler  func(Event)
+	buckets  map[Class]*bucket
+	cooldown map[Class]time.Time
+	strikes  map[Class]int // consecutive throttled responses
+	stats    map[Class]*Stats
+}
+
+func NewLimiter() *Limiter {
+	return &Limiter{
+		clock:    utils.SystemClock{},
+		random:   utils.CryptoRand{},
+		limits:   DefaultLimits(),
+		backoff:  DefaultBackoff(),
+		buckets:  map[Class]*bucket{},
+		cooldown: map[Class]time.Time{},
+		strikes:  map[Class]int{},
+		stats:    map[Class]*Stats{},
+	}
+}
+
+// Replace the limit of a class, the unknown classes use the ClassCommunity limit;
+// setting the current limit again changes nothing
+func (l *Limiter) SetLimit(class Class, limit Limit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if old, ok := l.limits[class]; ok && old == limit {
+		return
+	}
+	// The tokens left are kept, up to the new burst, so a change hands out no new burst
+	b, ok := l.buckets[class]
+	if ok && l.limit(class).Interval > 0 {
+		l.refill(class, l.limit(class), l.clock.Now())
+	}
+	l.limits[class] = limit
+	if burst := float64(l.limit(class).Burst); ok && b.tokens > burst {
+		b.tokens = burst
+	}
+}
+
+func (l *Limiter) SetBackoff(backoff Backoff) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.backoff = backoff
+}
+
+// Share it with the Core, e.g. a utils.ManualClock in tests
+func (l *Limiter) SetClock(clock utils.Clock) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.clock = clock
+}
+
+// Source of the backoff jitter
+func (l *Limiter) SetRand(random utils.Rand) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.random = random
+}
+
+// The handler is called from the requesting goroutine, it must not block
+func (l *Limiter) SetEventHandler(handler func(Event)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.handler = handler
+}
+
+// Delay a request of the class would get if it was sent now, for schedulers planning the next call
+func (l *Limiter) Wait(class Class) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock.Now()
+	limit := l.limit(class)
+	wait := time.Duration(0)
+	if limit.Interval > 0 {
+		b := l.refill(class, limit, now)
+		if b.tokens < 1 {
+			wait = time.Duration((1 - b.tokens) * float64(limit.Interval))
+		}
+	}
+	if until := l.cooldown[class]; until.After(now.Add(wait)) {
+		wait = until.Sub(now)
+	}
+	return wait
+}
+
+func (l *Limiter) Stats() map[Class]Stats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock.Now()
+	res := map[Class]Stats{}
+	for class, stats := range l.stats {
+		tmp := *stats
+		if until := l.cooldown[class]; until.After(now) {
+			tmp.CooldownUntil = until
+		}
+		res[class] = tmp
+	}
+	return res
+}
+
+// Wrap base, nil means http.DefaultTransport
+func (l *Limiter) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{limiter: l, base: base}
+}
+
+// Take a token and return the delay before the request may start, l.mu must be held
+func (l *Limiter) reserve(class Class, now time.Time) time.Duration {
+	stats := l.classStats(class)
+	stats.Requests++
+	limit := l.limit(class)
+	wait := time.Duration(0)
+	if limit.Interval > 0 {
+		b := l.refill(class, limit, now)
+		b.tokens--
+		if b.tokens < 0 {
+			wait = time.Duration(-b.tokens * float64(limit.Interval))
+		}
+	}
+	if until := l.cooldown[class]; until.After(now.Add(wait)) {
+		wait = until.Sub(now)
+	}
+	stats.Waited += wait
+	return wait
+}
+
+// Give back the token of a request cancelled while waiting, l.mu must be held
+func (l *Limiter) cancel(class Class) {
+	if b, ok := l.buckets[class]; ok {
+		b.tokens++
+	}
+}
+
+// Start or extend the cool-down of the class and return it, l.mu must be held
+func (l *Limiter) throttle(class Class, res *http.Response, now time.Time) time.Duration {
+	l.classStats(class).Throttled++
+	l.strikes[class]++
+	cooldown := l.backoff.Base << (l.strikes[class] - 1)
+	if cooldown <= 0 || (l.backoff.Max > 0 && cooldown > l.backoff.Max) {
+		cooldown = l.backoff.Max
+	}
+	// Equal jitter, so the callers sharing the IP do not come back at once
+	if half := int64(cooldown / 2); half > 0 {
+		cooldown = time.Duration(half + l.random.Int63n(half))
+	}
+	if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil {
+		if retryAfter := time.Duration(seconds) * time.Second; retryAfter > cooldown {
+			cooldown = retryAfter
+		}
+	}
+	if until := now.Add(cooldown); until.After(l.cooldown[class]) {
+		l.cooldown[class] = until
+	}
+	return cooldown
+}
+
+// l.mu must be held
+func (l *Limiter) succeed(class Class) {
+	delete(l.strikes, class)
+}
+
+// l.mu must be held
+func (l *Limiter) limit(class Class) Limit {
+	limit, ok := l.limits[class]
+	if !ok {
+		limit = l.limits[ClassCommunity]
+	}
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	return limit
+}
+
+// l.mu must be held
+func (l *Limiter) refill(class Class, limit Limit, now time.Time) *bucket {
+	b, ok := l.buckets[class]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		l.buckets[class] = b
+		return b
+	}
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += float64(elapsed) / float64(limit.Interval)
+		if b.tokens > float64(limit.Burst) {
+			b.tokens = float64(limit.Burst)
+		}
+		b.last = now
+	}
+	return b
+}
+
+// l.mu must be held
+func (l *Limiter) classStats(class Class) *Stats {
+	stats, ok := l.stats[class]
+	if !ok {
+		stats = &Stats{}
+		l.stats[class] = stats
+	}
+	return stats
+}
+
+func (l *Limiter) emit(event Event) {
+	l.mu.Lock()
+	handler := l.handler
+	l.mu.Unlock()
+	if handler != nil {
+		handler(event)
+	}
+}
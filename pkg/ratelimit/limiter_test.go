@@ -0,0 +1,155 @@
+package ratelimit_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/market"
+	"github.com/umichan0621/steam/pkg/ratelimit"
+	"github.com/umichan0621/steam/pkg/steamtest"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+// A Core of the fake server throttled by a limiter, all on one manual clock
+func newLimited(t *testing.T) (*steamtest.Server, *auth.Core, *ratelimit.Limiter, *utils.ManualClock) {
+	t.Helper()
+	server := steamtest.NewServer()
+	t.Cleanup(server.Close)
+	clock := utils.NewManualClock(steamtest.TestTime)
+	server.SetClock(clock)
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Case", LowestPrice: 100, MedianPrice: 95, Volume: 10})
+	core := server.NewCore(auth.LoginInfo{UserName: "bot", Password: "pass"})
+	core.SetLogger(nil)
+	limiter := ratelimit.NewLimiter()
+	limiter.SetClock(clock)
+	limiter.SetRand(utils.NewRand(1))
+	core.SetRateLimiter(limiter)
+	return server, core, limiter, clock
+}
+
+func TestLimiterPacing(t *testing.T) {
+	_, core, limiter, clock := newLimited(t)
+	marketCore := &market.Core{}
+	marketCore.Init()
+	start := clock.Now()
+	for i := 0; i < 4; i++ {
+		if _, err := marketCore.PriceOverview(core, "730", "US", marketCore.Currency(), "Case"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A burst of 2, then one request every 3 seconds
+	limit := ratelimit.DefaultLimits()[ratelimit.ClassPriceOverview]
+	if elapsed := clock.Now().Sub(start); elapsed < 2*limit.Interval {
+		t.Fatalf("4 requests took %s, want at least %s", elapsed, 2*limit.Interval)
+	}
+	stats := limiter.Stats()[ratelimit.ClassPriceOverview]
+	if stats.Requests != 4 || stats.Waited < 2*limit.Interval {
+		t.Fatalf("stats = %+v", stats)
+	}
+}
+
+func TestLimiterBackoff(t *testing.T) {
+	server, core, limiter, clock := newLimited(t)
+	events := []ratelimit.Event{}
+	limiter.SetEventHandler(func(event ratelimit.Event) { events = append(events, event) })
+	marketCore := &market.Core{}
+	marketCore.Init()
+
+	server.Fail("/market/priceoverview", steamtest.Failure{Status: 429, RetryAfter: 60, Times: 2})
+	start := clock.Now()
+	if _, err := marketCore.PriceOverview(core, "730", "US", marketCore.Currency(), "Case"); err != nil {
+		t.Fatalf("throttled request not retried: %v", err)
+	}
+	stats := limiter.Stats()[ratelimit.ClassPriceOverview]
+	if stats.Throttled != 2 || stats.Retries != 2 {
+		t.Fatalf("stats = %+v", stats)
+	}
+	// Retry-After is longer than the backoff, both cool-downs honour it
+	if elapsed := clock.Now().Sub(start); elapsed < 2*time.Minute {
+		t.Fatalf("retried after %s, want the Retry-After twice", elapsed)
+	}
+	throttled := 0
+	for _, event := range events {
+		if event.Type == ratelimit.EventThrottled {
+			throttled++
+			if event.Status != 429 || event.Wait < time.Minute {
+				t.Fatalf("event = %+v", event)
+			}
+		}
+	}
+	if throttled != 2 {
+		t.Fatalf("%d throttled events, want 2", throttled)
+	}
+
+	// The cool-down is over and the strikes are reset after a success
+	if wait := limiter.Wait(ratelimit.ClassPriceOverview); wait > ratelimit.DefaultLimits()[ratelimit.ClassPriceOverview].Interval {
+		t.Fatalf("wait = %s after the recovery", wait)
+	}
+}
+
+func TestSetLimitKeepsBucket(t *testing.T) {
+	_, core, limiter, _ := newLimited(t)
+	marketCore := &market.Core{}
+	marketCore.Init()
+	for i := 0; i < 2; i++ {
+		if _, err := marketCore.PriceOverview(core, "730", "US", marketCore.Currency(), "Case"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	limit := ratelimit.DefaultLimits()[ratelimit.ClassPriceOverview]
+	if limiter.Wait(ratelimit.ClassPriceOverview) == 0 {
+		t.Fatal("burst not spent")
+	}
+	// The same limit again, then a larger burst: the tokens spent stay spent
+	limiter.SetLimit(ratelimit.ClassPriceOverview, limit)
+	if limiter.Wait(ratelimit.ClassPriceOverview) == 0 {
+		t.Fatal("same limit refilled the bucket")
+	}
+	limiter.SetLimit(ratelimit.ClassPriceOverview, ratelimit.Limit{Interval: limit.Interval, Burst: 10})
+	if limiter.Wait(ratelimit.ClassPriceOverview) == 0 {
+		t.Fatal("new limit refilled the bucket")
+	}
+}
+
+// Answer every request with the status and count them
+type statusTransport struct {
+	status int
+	calls  int
+}
+
+func (t *statusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return &http.Response{StatusCode: t.status, Body: http.NoBody, Header: http.Header{}, Request: req}, nil
+}
+
+func TestTransportRetriesOnlyIdempotent(t *testing.T) {
+	tests := []struct {
+		method string
+		status int
+		calls  int
+	}{
+		{http.MethodGet, http.StatusTooManyRequests, 4},
+		{http.MethodGet, http.StatusServiceUnavailable, 4},
+		// A write is left to retry.Write, which reconciles it before sending it again
+		{http.MethodPost, http.StatusTooManyRequests, 1},
+		{http.MethodPost, http.StatusServiceUnavailable, 1},
+	}
+	for _, tt := range tests {
+		limiter := ratelimit.NewLimiter()
+		limiter.SetClock(utils.NewManualClock(steamtest.TestTime))
+		base := &statusTransport{status: tt.status}
+		client := &http.Client{Transport: limiter.Transport(base)}
+		req, _ := http.NewRequest(tt.method, "https://steamcommunity.com/market/createbuyorder", strings.NewReader("quantity=1"))
+		res, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != tt.status || base.calls != tt.calls {
+			t.Errorf("%s answered %d: status %d after %d calls, want %d", tt.method, tt.status, res.StatusCode, base.calls, tt.calls)
+		}
+	}
+}
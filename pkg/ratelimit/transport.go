@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+// Transport delays the requests according to the Limiter, created by Limiter.Transport
+type Transport struct {
+	limiter *Limiter
+	base    http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	l := t.limiter
+	class := Classify(req)
+	for attempt := 0; ; attempt++ {
+		l.mu.Lock()
+		now := l.clock.Now()
+		clock := l.clock
+		wait := l.reserve(class, now)
+		l.mu.Unlock()
+		if wait > 0 {
+			l.emit(Event{Type: EventWait, Class: class, Time: now, Wait: wait})
+			if err := utils.SleepContext(req.Context(), clock, wait); err != nil {
+				l.mu.Lock()
+				l.cancel(class)
+				l.mu.Unlock()
+				return nil, err
+			}
+		}
+
+		res, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode != http.StatusTooManyRequests && res.StatusCode != http.StatusServiceUnavailable {
+			l.mu.Lock()
+			l.succeed(class)
+			l.mu.Unlock()
+			return res, nil
+		}
+
+		l.mu.Lock()
+		now = l.clock.Now()
+		cooldown := l.throttle(class, res, now)
+		maxRetries := l.backoff.MaxRetries
+		l.mu.Unlock()
+		l.emit(Event{Type: EventThrottled, Class: class, Time: now, Wait: cooldown, Status: res.StatusCode})
+		if attempt >= maxRetries || !retryable(req) {
+			return res, nil
+		}
+		next, ok := rewind(req)
+		if !ok {
+			return res, nil
+		}
+		io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+		req = next
+
+		l.mu.Lock()
+		l.classStats(class).Retries++
+		l.mu.Unlock()
+		l.emit(Event{Type: EventRetry, Class: class, Time: now, Attempt: attempt + 1})
+	}
+}
+
+// Only the idempotent methods are sent again. A write is returned with its 429 or 503,
+// retry.Write checks with its Reconcile whether steam applied it before sending it again
+func retryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// Copy of the request with a fresh body, false while the body can not be read again
+func rewind(req *http.Request) (*http.Request, bool) {
+	next := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return next, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	next.Body = body
+	return next, true
+}
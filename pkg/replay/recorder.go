@@ -0,0 +1,241 @@
+// Package replay records http exchanges with steam into sanitized fixture files
+// and replays them deterministically, so the scraping code can be checked offline.
+package replay
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+type Mode int
+
+const (
+	ModeReplay Mode = iota // serve responses from the fixture, never touch the network
+	ModeRecord             // forward to the base transport and capture the exchanges
+)
+
+// Environment variable switching every recorder created with ModeFromEnv into record mode,
+// e.g. STEAM_FIXTURE_MODE=record go run ./cmd/fixture
+const ENV_FIXTURE_MODE = "STEAM_FIXTURE_MODE"
+
+var ErrNoFixture = errors.New("no recorded interaction matches the request")
+
+func ModeFromEnv() Mode {
+	if strings.EqualFold(os.Getenv(ENV_FIXTURE_MODE), "record") {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body"`
+	Base64 bool        `json:"base64,omitempty"` // body is base64 encoded binary, e.g. protobuf
+}
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper, install it with auth.Core.SetTransport
+type Recorder struct {
+	path      string
+	mode      Mode
+	base      http.RoundTripper
+	sanitizer Sanitizer
+
+	mu      sync.Mutex
+	fixture Fixture
+	used    []bool
+}
+
+// base: transport used in record mode, nil means http.DefaultTransport;
+// in replay mode the fixture at path must exist
+func NewRecorder(path string, mode Mode, base http.RoundTripper) (*Recorder, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	recorder := &Recorder{path: path, mode: mode, base: base}
+	if mode == ModeRecord {
+		return recorder, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &recorder.fixture); err != nil {
+		return nil, fmt.Errorf("fail to parse fixture %s: %s", path, err.Error())
+	}
+	recorder.used = make([]bool, len(recorder.fixture.Interactions))
+	return recorder, nil
+}
+
+func (recorder *Recorder) Mode() Mode { return recorder.mode }
+
+// Extra literal replacements applied while recording and matching, e.g. the account name
+func (recorder *Recorder) SetReplace(replace map[string]string) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.sanitizer.Replace = replace
+}
+
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody := ""
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		reqBody = string(data)
+		req.Body = io.NopCloser(bytes.NewReader(data))
+	}
+	recorder.mu.Lock()
+	key := Request{
+		Method: req.Method,
+		URL:    recorder.sanitizer.URL(req.URL.String()),
+		Body:   recorder.sanitizer.Body(req.Header.Get("Content-Type"), reqBody),
+	}
+	recorder.mu.Unlock()
+	if recorder.mode == ModeRecord {
+		return recorder.record(req, key)
+	}
+	return recorder.replay(req, key)
+}
+
+// Write the sanitized interactions captured in record mode
+func (recorder *Recorder) Save() error {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if recorder.mode != ModeRecord {
+		return nil
+	}
+	// The html of the pages is kept readable
+	buf := bytes.Buffer{}
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(&recorder.fixture); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(recorder.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(recorder.path, buf.Bytes(), 0o644)
+}
+
+func (recorder *Recorder) record(req *http.Request, key Request) (*http.Response, error) {
+	res, err := recorder.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(data))
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	response := Response{
+		Status: res.StatusCode,
+		Header: recorder.sanitizer.Header(res.Header),
+	}
+	if utf8.Valid(data) {
+		response.Body = recorder.sanitizer.String(string(data))
+	} else {
+		// Binary bodies are protobuf, what can not be scrubbed is not recorded
+		scrubbed, err := recorder.sanitizer.Proto(data)
+		if err != nil {
+			return nil, fmt.Errorf("fail to sanitize the binary response of %s %s, %w", key.Method, key.URL, err)
+		}
+		response.Body = base64.StdEncoding.EncodeToString(scrubbed)
+		response.Base64 = true
+	}
+	recorder.fixture.Interactions = append(recorder.fixture.Interactions, &Interaction{
+		Request:  key,
+		Response: response,
+	})
+	return res, nil
+}
+
+// Serve the first unused interaction matching the request, in recording order
+func (recorder *Recorder) replay(req *http.Request, key Request) (*http.Response, error) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	for i, interaction := range recorder.fixture.Interactions {
+		if recorder.used[i] || interaction.Request != key {
+			continue
+		}
+		recorder.used[i] = true
+		body := []byte(interaction.Response.Body)
+		if interaction.Response.Base64 {
+			data, err := base64.StdEncoding.DecodeString(interaction.Response.Body)
+			if err != nil {
+				return nil, err
+			}
+			body = data
+		}
+		header := interaction.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrNoFixture, key.Method, key.URL)
+}
+
+// Field values of a multipart body, independent of the random boundary
+func multipartValues(contentType, body string) (url.Values, error) {
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, err
+	}
+	reader := multipart.NewReader(strings.NewReader(body), params["boundary"])
+	values := url.Values{}
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			break
+		}
+		data, _ := io.ReadAll(part)
+		values.Add(part.FormName(), string(data))
+	}
+	return values, nil
+}
@@ -0,0 +1,166 @@
+package replay_test
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/inventory"
+	"github.com/umichan0621/steam/pkg/market"
+	"github.com/umichan0621/steam/pkg/replay"
+)
+
+// Written by cmd/fixture, run from the root of the module. The steam capture guards the
+// parsers against the markup changes of steam; the smoke fixture is a recording of the
+// steamtest server, it only checks the recorder and the parsers against our own fake
+const (
+	steamFixture = "../../testdata/fixtures/steam.json"
+	smokeFixture = "../../testdata/fixtures/fake_smoke.json"
+)
+
+var usd, _ = common.CurrencyByCode("USD")
+
+const (
+	appID     = "730"
+	contextID = "2"
+	hashName  = "Chroma 2 Case"
+)
+
+// Run test against a Core replaying each fixture
+func eachFixture(t *testing.T, test func(t *testing.T, core *auth.Core)) {
+	for _, fixture := range []struct{ name, path string }{{"steam", steamFixture}, {"fake smoke", smokeFixture}} {
+		t.Run(fixture.name, func(t *testing.T) {
+			if _, err := os.Stat(fixture.path); fixture.path == steamFixture && errors.Is(err, os.ErrNotExist) {
+				t.Skip("no capture of steam, record one with go run ./cmd/fixture")
+			}
+			test(t, newReplayCore(t, fixture.path))
+		})
+	}
+}
+
+// A Core replaying the fixture, with the steam ID the sanitizer put in its URLs
+func newReplayCore(t *testing.T, path string) *auth.Core {
+	t.Helper()
+	recorder, err := replay.NewRecorder(path, replay.ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("fail to load fixture: %v", err)
+	}
+	core := &auth.Core{}
+	core.Init(auth.LoginInfo{UserName: "USERNAME"})
+	core.SetLogger(nil)
+	if err := core.SetCookie(`{"SessionID":"REDACTED","SteamLoginSecure":"` + replay.PLACEHOLDER_ID64 + `%7C%7CREDACTED","SteamID":"` + replay.PLACEHOLDER_ID64 + `"}`); err != nil {
+		t.Fatal(err)
+	}
+	core.ApplyCookie()
+	core.SetTransport(recorder)
+	return core
+}
+
+func TestReplayWallet(t *testing.T) {
+	eachFixture(t, func(t *testing.T, core *auth.Core) {
+		wallet, err := inventory.WalletBalance(core)
+		if err != nil {
+			t.Fatal(err)
+		}
+		currency, ok := common.CurrencyByID(int(wallet.WalletCurrency))
+		if !ok || wallet.WalletCountry == "" || wallet.Success != 1 {
+			t.Fatalf("g_rgWalletInfo not parsed: %+v", wallet)
+		}
+		if wallet.WalletBalance.Currency != currency || wallet.WalletBalance.Amount <= 0 {
+			t.Fatalf("balance = %+v", wallet.WalletBalance)
+		}
+	})
+}
+
+func TestReplayInventory(t *testing.T) {
+	eachFixture(t, func(t *testing.T, core *auth.Core) {
+		items := []inventory.InventoryItem{}
+		if _, _, err := inventory.AllItems(core, appID, contextID, "", 50, &items); err != nil {
+			t.Fatal(err)
+		}
+		if len(items) == 0 {
+			t.Fatal("no item")
+		}
+		for _, item := range items {
+			if item.AssetID == "" || item.Desc == nil || item.Desc.MarketHashName == "" {
+				t.Fatalf("item without asset ID or description: %+v", item)
+			}
+		}
+	})
+}
+
+func TestReplayOrderGraph(t *testing.T) {
+	eachFixture(t, func(t *testing.T, core *auth.Core) {
+		nameID, err := market.ItemNameID(core, appID, hashName)
+		if err != nil || nameID == "" {
+			t.Fatalf("Market_LoadOrderSpread not found: %q, %v", nameID, err)
+		}
+		graph, err := market.ItemOrderGraph(core, "US", usd, appID, nameID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(graph.BuyOrderGraph) == 0 || len(graph.SellOrderGraph) == 0 {
+			t.Fatalf("graph = %+v", graph)
+		}
+		// Buy prices fall and sell prices rise, both with cumulative quantities
+		buy, sell := graph.BuyOrderGraph, graph.SellOrderGraph
+		if !sort.SliceIsSorted(buy, func(i, j int) bool { return buy[i].Price.Amount > buy[j].Price.Amount }) ||
+			!sort.SliceIsSorted(sell, func(i, j int) bool { return sell[i].Price.Amount < sell[j].Price.Amount }) {
+			t.Fatalf("levels out of order: %+v", graph)
+		}
+		if buy[0].Price.Amount >= sell[0].Price.Amount {
+			t.Fatalf("highest buy %s over the lowest sell %s", buy[0].Price, sell[0].Price)
+		}
+	})
+}
+
+func TestReplayPrices(t *testing.T) {
+	eachFixture(t, func(t *testing.T, core *auth.Core) {
+		marketCore := &market.Core{}
+		marketCore.Init()
+		history, err := marketCore.PriceHistory(core, appID, hashName, 30)
+		if err != nil || len(history) == 0 {
+			t.Fatalf("history = %d points, %v", len(history), err)
+		}
+		overview, err := marketCore.PriceOverview(core, appID, "US", usd, hashName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if overview.Lowest.Amount <= 0 || overview.Median.Amount <= 0 {
+			t.Fatalf("overview = %+v", overview)
+		}
+	})
+}
+
+func TestReplayMarketHistory(t *testing.T) {
+	eachFixture(t, func(t *testing.T, core *auth.Core) {
+		orders, err := market.HistoryOrder(core, appID, contextID, 0, 20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(orders) == 0 {
+			t.Fatal("no row parsed from results_html")
+		}
+		for _, order := range orders {
+			// The asset ID comes from the hovers, the rest from results_html
+			if order.AssetID == "" || order.MarketHashName == "" || order.Price.Amount <= 0 || order.Date.IsZero() {
+				t.Fatalf("row not parsed: %+v", order)
+			}
+		}
+	})
+}
+
+func TestReplayUnknownRequest(t *testing.T) {
+	recorder, err := replay.NewRecorder(smokeFixture, replay.ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://steamcommunity.com/market/unknown", nil)
+	if _, err := recorder.RoundTrip(req); !errors.Is(err, replay.ErrNoFixture) {
+		t.Fatalf("err = %v, want ErrNoFixture", err)
+	}
+}
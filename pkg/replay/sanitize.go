@@ -0,0 +1,228 @@
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	REDACTED         = "REDACTED"
+	PLACEHOLDER_ID64 = "76561197960287930"
+)
+
+var (
+	steamID64Regexp = regexp.MustCompile(`7656119\d{10}`)
+	jwtRegexp       = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)
+	loginRegexp     = regexp.MustCompile(`\d+(%7C%7C|\|\|)[A-Za-z0-9_.-]+`)
+	apiKeyRegexp    = regexp.MustCompile(`(Key: |"api_key":\s*")[0-9A-F]{32}`) // dev/apikey page and dev/requestkey
+)
+
+// Query and form parameters carrying secrets or values changing on every request
+var sensitiveParams = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"key":           true,
+	"sessionid":     true,
+	"nonce":         true,
+	"auth":          true,
+	"steamid":       true,
+	"steamID":       true,
+	"p":             true, // mobileconf device ID
+	"a":             true, // mobileconf steam ID
+	"k":             true, // mobileconf signature
+	"t":             true, // mobileconf timestamp
+	"ck":            true, // confirmation nonce
+}
+
+// Headers kept in the fixture, the others (cookies above all) are dropped
+var keptHeaders = []string{"Content-Type", "X-Eresult", "X-Error_message", "Location", "Retry-After"}
+
+// Sanitizer scrubs cookies, tokens and steam IDs from the recorded exchanges,
+// Replace holds extra literal replacements such as the account name
+type Sanitizer struct {
+	Replace map[string]string
+}
+
+func (s *Sanitizer) String(str string) string {
+	str = jwtRegexp.ReplaceAllString(str, REDACTED)
+	str = loginRegexp.ReplaceAllString(str, REDACTED)
+	str = apiKeyRegexp.ReplaceAllString(str, "${1}"+REDACTED)
+	str = steamID64Regexp.ReplaceAllString(str, PLACEHOLDER_ID64)
+	keys := make([]string, 0, len(s.Replace))
+	for key := range s.Replace {
+		keys = append(keys, key)
+	}
+	// Longest first, so overlapping replacements are deterministic
+	sort.Slice(keys, func(i, j int) bool { return len(keys[i]) > len(keys[j]) })
+	for _, key := range keys {
+		if key != "" {
+			str = strings.ReplaceAll(str, key, s.Replace[key])
+		}
+	}
+	return str
+}
+
+func (s *Sanitizer) Values(values url.Values) url.Values {
+	res := url.Values{}
+	for key, list := range values {
+		for _, val := range list {
+			if sensitiveParams[key] {
+				val = REDACTED
+			}
+			res.Add(key, s.String(val))
+		}
+	}
+	return res
+}
+
+func (s *Sanitizer) URL(rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return s.String(rawUrl)
+	}
+	u.RawQuery = s.Values(u.Query()).Encode()
+	u.Path = s.String(u.Path)
+	u.RawPath = ""
+	return u.String()
+}
+
+// Form bodies are sanitized per field, other bodies as plain text
+func (s *Sanitizer) Body(contentType string, body string) string {
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(body)
+		if err == nil {
+			return s.Values(values).Encode()
+		}
+	}
+	if strings.HasPrefix(contentType, "multipart/form-data") {
+		values, err := multipartValues(contentType, body)
+		if err == nil {
+			return s.Values(values).Encode()
+		}
+	}
+	return s.String(body)
+}
+
+func (s *Sanitizer) Header(header http.Header) http.Header {
+	res := http.Header{}
+	for _, key := range keptHeaders {
+		for _, val := range header.Values(key) {
+			res.Add(key, s.String(val))
+		}
+	}
+	return res
+}
+
+// Scrub a protobuf message without its schema: the strings are sanitized like the text bodies,
+// the nested messages recursively and the 64-bit steam IDs replaced. A bytes field that is
+// neither text nor a message fails, so nothing opaque reaches a fixture
+func (s *Sanitizer) Proto(data []byte) ([]byte, error) {
+	return s.protoMessage(data, 0)
+}
+
+// Deep enough for every steam message, a deeper one is no message
+const maxProtoDepth = 16
+
+var (
+	placeholderID, _ = strconv.ParseUint(PLACEHOLDER_ID64, 10, 64)
+	errOpaqueBytes   = errors.New("opaque bytes field")
+)
+
+func (s *Sanitizer) protoMessage(data []byte, depth int) ([]byte, error) {
+	if depth > maxProtoDepth {
+		return nil, errors.New("message nested too deep")
+	}
+	res := make([]byte, 0, len(data))
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+		res = protowire.AppendTag(res, num, typ)
+		switch typ {
+		case protowire.VarintType:
+			var value uint64
+			value, n = protowire.ConsumeVarint(data)
+			res = protowire.AppendVarint(res, scrubID(value))
+		case protowire.Fixed64Type:
+			var value uint64
+			value, n = protowire.ConsumeFixed64(data)
+			res = protowire.AppendFixed64(res, scrubID(value))
+		case protowire.Fixed32Type:
+			var value uint32
+			value, n = protowire.ConsumeFixed32(data)
+			res = protowire.AppendFixed32(res, value)
+		case protowire.BytesType:
+			var value []byte
+			value, n = protowire.ConsumeBytes(data)
+			if n < 0 {
+				break
+			}
+			scrubbed, err := s.protoBytes(value, depth)
+			if err != nil {
+				return nil, fmt.Errorf("field %d: %w", num, err)
+			}
+			res = protowire.AppendBytes(res, scrubbed)
+		default:
+			return nil, fmt.Errorf("field %d: unsupported wire type %d", num, typ)
+		}
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+	}
+	return res, nil
+}
+
+// A string or a nested message; text left unchanged by the sanitizer is kept as is,
+// otherwise the structure wins so the length prefixes of a message stay right
+func (s *Sanitizer) protoBytes(value []byte, depth int) ([]byte, error) {
+	text := isText(value)
+	if text {
+		scrubbed := s.String(string(value))
+		if scrubbed == string(value) {
+			return value, nil
+		}
+		// A text parsing as a message by chance still has to come out clean
+		if message, err := s.protoMessage(value, depth+1); err == nil && s.String(string(message)) == string(message) {
+			return message, nil
+		}
+		return []byte(scrubbed), nil
+	}
+	message, err := s.protoMessage(value, depth+1)
+	if err != nil {
+		return nil, errOpaqueBytes
+	}
+	return message, nil
+}
+
+func isText(value []byte) bool {
+	if !utf8.Valid(value) {
+		return false
+	}
+	for _, r := range string(value) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// The numbers steamID64Regexp matches in the text bodies
+func scrubID(value uint64) uint64 {
+	if value >= 76561190000000000 && value <= 76561199999999999 {
+		return placeholderID
+	}
+	return value
+}
@@ -0,0 +1,51 @@
+package retry
+
+type Kind int
+
+const (
+	KindRead  Kind = iota // no side effect, sent again freely
+	KindWrite             // changes the account, sent again only after reconciliation
+)
+
+func (kind Kind) String() string {
+	if kind == KindWrite {
+		return "Write"
+	}
+	return "Read"
+}
+
+type Operation struct {
+	Name string
+	Kind Kind
+}
+
+// Every network operation of the library
+var (
+	OpRefreshCookie  = Operation{Name: "auth.RefreshCookieWithToken", Kind: KindRead}
+	OpLogin          = Operation{Name: "auth.Login", Kind: KindWrite}
+	OpFetchAPIKey    = Operation{Name: "auth.FetchAPIKey", Kind: KindRead}
+	OpRegisterAPIKey = Operation{Name: "auth.RegisterAPIKey", Kind: KindWrite}
+	OpAPIKeyStatus   = Operation{Name: "auth.APIKeyStatus", Kind: KindRead}
+
+	OpItemNameID      = Operation{Name: "market.ItemNameID", Kind: KindRead}
+	OpItemOrderGraph  = Operation{Name: "market.ItemOrderGraph", Kind: KindRead}
+	OpPriceHistory    = Operation{Name: "market.PriceHistory", Kind: KindRead}
+	OpPriceOverview   = Operation{Name: "market.PriceOverview", Kind: KindRead}
+	OpHistoryOrder    = Operation{Name: "market.HistoryOrder", Kind: KindRead}
+	OpMyListings      = Operation{Name: "market.MyListings", Kind: KindRead}
+	OpCreateBuyOrder  = Operation{Name: "market.CreateBuyOrder", Kind: KindWrite}
+	OpCancelBuyOrder  = Operation{Name: "market.CancelBuyOrder", Kind: KindWrite}
+	OpCreateSellOrder = Operation{Name: "market.CreateSellOrder", Kind: KindWrite}
+
+	OpAllItems      = Operation{Name: "inventory.AllItems", Kind: KindRead}
+	OpWalletBalance = Operation{Name: "inventory.WalletBalance", Kind: KindRead}
+
+	OpGetTradeOffers    = Operation{Name: "trade.GetTradeOffers", Kind: KindRead}
+	OpGetTradeOffer     = Operation{Name: "trade.GetTradeOffer", Kind: KindRead}
+	OpAcceptTradeOffer  = Operation{Name: "trade.AcceptTradeOffer", Kind: KindWrite}
+	OpCancelTradeOffer  = Operation{Name: "trade.CancelTradeOffer", Kind: KindWrite}
+	OpDeclineTradeOffer = Operation{Name: "trade.DeclineTradeOffer", Kind: KindWrite}
+
+	OpGetConfirmations   = Operation{Name: "confirm.GetConfirmations", Kind: KindRead}
+	OpAnswerConfirmation = Operation{Name: "confirm.AnswerConfirmation", Kind: KindWrite}
+)
@@ -0,0 +1,160 @@
+// Package retry runs the library operations again after a transient failure.
+// Reads are simply sent again; a write is only sent again once a reconciliation
+// check proved that the failed attempt had no effect, so nothing is bought or listed twice.
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/observe"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+type Policy struct {
+	MaxAttempts int           // attempts including the first one, retries are disabled while < 2
+	BaseDelay   time.Duration // delay before the first retry, doubled on every retry
+	MaxDelay    time.Duration // upper bound of the delay
+	Transient   func(err error) bool
+	OnRetry     func(op Operation, attempt int, err error) // called before every retry, may be nil
+}
+
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Second,
+		MaxDelay:    30 * time.Second,
+		Transient:   Transient,
+	}
+}
+
+// Policy and hooks used by the operations, implemented by auth.Core
+type Env interface {
+	RetryPolicy() Policy
+	Clock() utils.Clock
+	Rand() utils.Rand
+	Hooks() observe.Hooks
+	UserName() string
+	Logger() logging.Logger
+}
+
+// Result of a reconciliation check after a write failed in a transient way
+type Outcome int
+
+const (
+	NotApplied Outcome = iota // the write had no effect, it is safe to send it again
+	Applied                   // the write took effect, the reconciled result is returned
+	Unknown                   // the effect can not be told, the error is returned
+)
+
+// Check a failed write against the current state, e.g. look for the created buy order
+type Reconcile[T any] func(ctx context.Context) (T, Outcome, error)
+
+// Run a read operation, retrying it on transient failures
+func Read[T any](ctx context.Context, env Env, op Operation, call func(ctx context.Context) (T, error)) (T, error) {
+	return run(ctx, env, op, call, nil)
+}
+
+// Run a write operation; after a transient failure reconcile decides whether it is sent again,
+// a nil reconcile never sends it twice
+func Write[T any](ctx context.Context, env Env, op Operation, call func(ctx context.Context) (T, error), reconcile Reconcile[T]) (T, error) {
+	return run(ctx, env, op, call, reconcile)
+}
+
+// Report the operation to the hooks of env, if any
+func run[T any](ctx context.Context, env Env, op Operation, call func(ctx context.Context) (T, error), reconcile Reconcile[T]) (T, error) {
+	hooks := env.Hooks()
+	if hooks == nil {
+		res, _, err := attempts(ctx, env, op, call, reconcile)
+		return res, err
+	}
+	info := &observe.Operation{Name: op.Name, Write: op.Kind == KindWrite, Account: env.UserName()}
+	start := time.Now()
+	ctx = hooks.StartOperation(ctx, info)
+	res, attempt, err := attempts(ctx, env, op, call, reconcile)
+	info.Attempts = attempt
+	info.Duration = time.Since(start)
+	info.EResult = errcode.EResultOf(err)
+	info.Err = err
+	hooks.EndOperation(ctx, info)
+	return res, err
+}
+
+// Return the result and the number of attempts
+func attempts[T any](ctx context.Context, env Env, op Operation, call func(ctx context.Context) (T, error), reconcile Reconcile[T]) (T, int, error) {
+	policy := env.RetryPolicy()
+	transient := policy.Transient
+	if transient == nil {
+		transient = Transient
+	}
+	for attempt := 1; ; attempt++ {
+		res, err := call(observe.WithOperation(ctx, op.Name, attempt))
+		if err == nil || attempt >= policy.MaxAttempts || !transient(err) {
+			return res, attempt, err
+		}
+		if op.Kind == KindWrite {
+			if reconcile == nil {
+				return res, attempt, err
+			}
+			reconciled, outcome, checkErr := reconcile(ctx)
+			switch {
+			case checkErr != nil || outcome == Unknown:
+				env.Logger().Warn("write failed with an unknown outcome, not sent again",
+					logging.F("operation", op.Name), logging.Err(err))
+				return res, attempt, err
+			case outcome == Applied:
+				env.Logger().Info("write applied despite the failure",
+					logging.F("operation", op.Name), logging.Err(err))
+				return reconciled, attempt, nil
+			}
+		}
+		env.Logger().Warn("transient failure, retrying",
+			logging.F("operation", op.Name), logging.F("attempt", attempt), logging.Err(err))
+		if policy.OnRetry != nil {
+			policy.OnRetry(op, attempt, err)
+		}
+		if sleepErr := utils.SleepContext(ctx, env.Clock(), policy.delay(env.Rand(), attempt)); sleepErr != nil {
+			return res, attempt, err
+		}
+	}
+}
+
+// Exponential delay with equal jitter
+func (policy Policy) delay(random utils.Rand, attempt int) time.Duration {
+	delay := policy.BaseDelay << (attempt - 1)
+	if delay <= 0 || (policy.MaxDelay > 0 && delay > policy.MaxDelay) {
+		delay = policy.MaxDelay
+	}
+	if half := int64(delay / 2); half > 0 {
+		delay = time.Duration(half + random.Int63n(half))
+	}
+	return delay
+}
+
+// Failures worth another attempt: network errors, truncated bodies,
+// rate limiting and the 5xx statuses; never a cancelled context
+func Transient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var e *errcode.Error
+	if errors.As(err, &e) {
+		switch e.EResult {
+		case errcode.EResultRateLimitExceeded, errcode.EResultServiceUnavailable, errcode.EResultBusy,
+			errcode.EResultTimeout, errcode.EResultBadResponse, errcode.EResultNoConnection:
+			return true
+		}
+		return e.Status >= http.StatusInternalServerError
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
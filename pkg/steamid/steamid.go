@@ -0,0 +1,288 @@
+// Package steamid converts between the representations of a steam ID:
+// SteamID64, Steam2 (STEAM_0:1:x), Steam3 ([U:1:x]), account IDs and profile urls.
+package steamid
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SteamID64: universe (8 bits), account type (4 bits), instance (20 bits) and account ID (32 bits)
+type SteamID uint64
+
+type Universe uint8
+
+const (
+	UniverseInvalid  Universe = 0
+	UniversePublic   Universe = 1
+	UniverseBeta     Universe = 2
+	UniverseInternal Universe = 3
+	UniverseDev      Universe = 4
+)
+
+type AccountType uint8
+
+const (
+	TypeInvalid        AccountType = 0
+	TypeIndividual     AccountType = 1
+	TypeMultiseat      AccountType = 2
+	TypeGameServer     AccountType = 3
+	TypeAnonGameServer AccountType = 4
+	TypePending        AccountType = 5
+	TypeContentServer  AccountType = 6
+	TypeClan           AccountType = 7
+	TypeChat           AccountType = 8
+	TypeConsoleUser    AccountType = 9
+	TypeAnonUser       AccountType = 10
+)
+
+// Instances of the accounts
+const (
+	InstanceAll     uint32 = 0
+	InstanceDesktop uint32 = 1
+	InstanceConsole uint32 = 2
+	InstanceWeb     uint32 = 4
+
+	// Flags of the chat instances
+	InstanceFlagClan     uint32 = 1 << 19
+	InstanceFlagLobby    uint32 = 1 << 18
+	InstanceFlagMMSLobby uint32 = 1 << 17
+)
+
+const instanceMask = 1<<20 - 1
+
+// Returned by Parse for the /id/ profile urls, resolved by auth.Core.ResolveVanityURL
+var ErrVanityURL = errors.New("vanity url needs to be resolved")
+
+// Letters of the account types in Steam3
+var typeLetters = map[AccountType]string{
+	TypeInvalid:        "I",
+	TypeIndividual:     "U",
+	TypeMultiseat:      "M",
+	TypeGameServer:     "G",
+	TypeAnonGameServer: "A",
+	TypePending:        "P",
+	TypeContentServer:  "C",
+	TypeClan:           "g",
+	TypeChat:           "T",
+	TypeAnonUser:       "a",
+}
+
+var (
+	steam2Regexp = regexp.MustCompile(`^STEAM_([0-5]):([01]):(\d+)$`)
+	steam3Regexp = regexp.MustCompile(`^\[([IUMGAPCgTcLa]):([0-5]):(\d+)(?::(\d+))?\]$`)
+)
+
+func New(universe Universe, accountType AccountType, instance, accountID uint32) SteamID {
+	return SteamID(uint64(universe)<<56 | uint64(accountType&0xf)<<52 | uint64(instance&instanceMask)<<32 | uint64(accountID))
+}
+
+// The public individual account of accountID, e.g. the partner of a trade offer
+func FromAccountID(accountID uint32) SteamID {
+	return New(UniversePublic, TypeIndividual, InstanceDesktop, accountID)
+}
+
+// Parse a SteamID64, a Steam2 or Steam3 ID, or a /profiles/ url; a bare number below 2^32
+// is an account ID. The /id/ urls fail with ErrVanityURL
+func Parse(str string) (SteamID, error) {
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return 0, fmt.Errorf("fail to parse steam ID, empty string")
+	}
+	if value, err := strconv.ParseUint(str, 10, 64); err == nil {
+		if value <= 0xffffffff {
+			return FromAccountID(uint32(value)), nil
+		}
+		return SteamID(value), nil
+	}
+	if match := steam2Regexp.FindStringSubmatch(str); match != nil {
+		universe, _ := strconv.ParseUint(match[1], 10, 8)
+		// STEAM_0 is the legacy notation of the public universe
+		if universe == 0 {
+			universe = uint64(UniversePublic)
+		}
+		low, _ := strconv.ParseUint(match[2], 10, 32)
+		high, err := strconv.ParseUint(match[3], 10, 31)
+		if err != nil {
+			return 0, fmt.Errorf("fail to parse steam ID %s, %w", str, err)
+		}
+		return New(Universe(universe), TypeIndividual, InstanceDesktop, uint32(high<<1|low)), nil
+	}
+	if match := steam3Regexp.FindStringSubmatch(str); match != nil {
+		return parseSteam3(str, match)
+	}
+	if strings.Contains(str, "/profiles/") || strings.Contains(str, "/id/") {
+		id, vanity, err := parseURL(str)
+		if err == nil && vanity != "" {
+			return 0, fmt.Errorf("%w: %s", ErrVanityURL, vanity)
+		}
+		return id, err
+	}
+	return 0, fmt.Errorf("fail to parse steam ID %s, unknown format", str)
+}
+
+func parseSteam3(str string, match []string) (SteamID, error) {
+	universe, _ := strconv.ParseUint(match[2], 10, 8)
+	accountID, err := strconv.ParseUint(match[3], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("fail to parse steam ID %s, %w", str, err)
+	}
+	instance := InstanceAll
+	accountType := TypeInvalid
+	switch match[1] {
+	case "c":
+		accountType, instance = TypeChat, InstanceFlagClan
+	case "L":
+		accountType, instance = TypeChat, InstanceFlagLobby
+	default:
+		for tmp, letter := range typeLetters {
+			if letter == match[1] {
+				accountType = tmp
+				break
+			}
+		}
+	}
+	if accountType == TypeIndividual {
+		instance = InstanceDesktop
+	}
+	if match[4] != "" {
+		value, err := strconv.ParseUint(match[4], 10, 32)
+		if err != nil || value > instanceMask {
+			return 0, fmt.Errorf("fail to parse steam ID %s, invalid instance", str)
+		}
+		instance = uint32(value)
+	}
+	return New(Universe(universe), accountType, instance, uint32(accountID)), nil
+}
+
+// The ID of a /profiles/ url or the vanity name of an /id/ url
+func parseURL(str string) (SteamID, string, error) {
+	if !strings.Contains(str, "://") {
+		str = "https://" + str
+	}
+	u, err := url.Parse(str)
+	if err != nil {
+		return 0, "", fmt.Errorf("fail to parse steam ID %s, %w", str, err)
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) >= 2 && parts[0] == "profiles" {
+		value, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return 0, "", fmt.Errorf("fail to parse steam ID %s, %w", str, err)
+		}
+		return SteamID(value), "", nil
+	}
+	if len(parts) >= 2 && parts[0] == "id" && parts[1] != "" {
+		return 0, parts[1], nil
+	}
+	return 0, "", fmt.Errorf("fail to parse steam ID %s, unknown format", str)
+}
+
+// Vanity name of an /id/ profile url, e.g. gabelogannewell for https://steamcommunity.com/id/gabelogannewell
+func VanityName(str string) (string, bool) {
+	_, vanity, err := parseURL(strings.TrimSpace(str))
+	return vanity, err == nil && vanity != ""
+}
+
+func (id SteamID) Uint64() uint64     { return uint64(id) }
+func (id SteamID) AccountID() uint32  { return uint32(id) }
+func (id SteamID) Instance() uint32   { return uint32(id>>32) & instanceMask }
+func (id SteamID) Type() AccountType  { return AccountType(id>>52) & 0xf }
+func (id SteamID) Universe() Universe { return Universe(id >> 56) }
+func (id SteamID) IsIndividual() bool { return id.Type() == TypeIndividual }
+func (id SteamID) ProfileURL() string { return "https://steamcommunity.com/profiles/" + id.String() }
+func (id SteamID) WithInstance(instance uint32) SteamID {
+	return New(id.Universe(), id.Type(), instance, id.AccountID())
+}
+
+// A valid universe and account type, and an account ID set for the individual accounts
+func (id SteamID) IsValid() bool {
+	if id.Universe() == UniverseInvalid || id.Universe() > UniverseDev {
+		return false
+	}
+	switch id.Type() {
+	case TypeInvalid:
+		return false
+	case TypeIndividual:
+		return id.AccountID() != 0 && id.Instance() <= InstanceWeb
+	case TypeClan:
+		return id.AccountID() != 0 && id.Instance() == InstanceAll
+	case TypeGameServer:
+		return id.AccountID() != 0
+	}
+	return id.Type() <= TypeAnonUser
+}
+
+// SteamID64 in decimal
+func (id SteamID) String() string { return strconv.FormatUint(uint64(id), 10) }
+
+// STEAM_0:Y:Z for the public universe, like the games print it
+func (id SteamID) Steam2() string {
+	universe := id.Universe()
+	if universe == UniversePublic {
+		universe = UniverseInvalid
+	}
+	return fmt.Sprintf("STEAM_%d:%d:%d", universe, id.AccountID()&1, id.AccountID()>>1)
+}
+
+// [U:1:x], the instance is shown while it is not the default one of the account type
+func (id SteamID) Steam3() string {
+	letter, ok := typeLetters[id.Type()]
+	if !ok {
+		letter = "i"
+	}
+	instance := id.Instance()
+	if id.Type() == TypeChat {
+		if instance&InstanceFlagClan != 0 {
+			letter = "c"
+		} else if instance&InstanceFlagLobby != 0 {
+			letter = "L"
+		}
+	}
+	showInstance := id.Type() == TypeAnonGameServer || id.Type() == TypeMultiseat ||
+		(id.Type() == TypeIndividual && instance != InstanceDesktop)
+	if showInstance {
+		return fmt.Sprintf("[%s:%d:%d:%d]", letter, id.Universe(), id.AccountID(), instance)
+	}
+	return fmt.Sprintf("[%s:%d:%d]", letter, id.Universe(), id.AccountID())
+}
+
+// The SteamID64 in decimal, the form used by the web api
+func (id SteamID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// Any form accepted by Parse, an empty text or 0 is the zero ID
+func (id *SteamID) UnmarshalText(text []byte) error {
+	if len(text) == 0 || string(text) == "0" {
+		*id = 0
+		return nil
+	}
+	value, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*id = value
+	return nil
+}
+
+// A JSON string, since the SteamID64 overflows the numbers of javascript
+func (id SteamID) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + id.String() + `"`), nil
+}
+
+// A JSON string in any form accepted by Parse or a number, null is the zero ID
+func (id *SteamID) UnmarshalJSON(data []byte) error {
+	str := string(data)
+	if str == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(str); err == nil {
+		return id.UnmarshalText([]byte(unquoted))
+	}
+	return id.UnmarshalText(data)
+}
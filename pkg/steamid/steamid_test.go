@@ -0,0 +1,109 @@
+package steamid_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/umichan0621/steam/pkg/steamid"
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     steamid.SteamID
+		steam2 string
+		steam3 string
+	}{
+		{"individual even", 76561197960287930, "STEAM_0:0:11101", "[U:1:22202]"},
+		{"individual odd", 76561198012345679, "STEAM_0:1:26039975", "[U:1:52079951]"},
+		{"web instance", steamid.FromAccountID(22202).WithInstance(steamid.InstanceWeb), "STEAM_0:0:11101", "[U:1:22202:4]"},
+		{"clan", steamid.New(steamid.UniversePublic, steamid.TypeClan, steamid.InstanceAll, 4), "STEAM_0:0:2", "[g:1:4]"},
+		{"game server", steamid.New(steamid.UniversePublic, steamid.TypeGameServer, steamid.InstanceAll, 123), "STEAM_0:1:61", "[G:1:123]"},
+		{"anon game server", steamid.New(steamid.UniversePublic, steamid.TypeAnonGameServer, 7, 123), "STEAM_0:1:61", "[A:1:123:7]"},
+		{"clan chat", steamid.New(steamid.UniversePublic, steamid.TypeChat, steamid.InstanceFlagClan, 9), "STEAM_0:1:4", "[c:1:9]"},
+		{"lobby", steamid.New(steamid.UniversePublic, steamid.TypeChat, steamid.InstanceFlagLobby, 9), "STEAM_0:1:4", "[L:1:9]"},
+		{"beta universe", steamid.New(steamid.UniverseBeta, steamid.TypeIndividual, steamid.InstanceDesktop, 3), "STEAM_2:1:1", "[U:2:3]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.id.Steam2(); got != tt.steam2 {
+				t.Fatalf("Steam2 = %s, want %s", got, tt.steam2)
+			}
+			if got := tt.id.Steam3(); got != tt.steam3 {
+				t.Fatalf("Steam3 = %s, want %s", got, tt.steam3)
+			}
+			forms := map[string]string{"steam3": tt.steam3, "steamid64": tt.id.String(), "url": tt.id.ProfileURL()}
+			// Steam2 only holds the individual desktop accounts
+			if tt.id.IsIndividual() && tt.id.Instance() == steamid.InstanceDesktop {
+				forms["steam2"] = tt.steam2
+			}
+			for form, str := range forms {
+				id, err := steamid.Parse(str)
+				if err != nil || id != tt.id {
+					t.Fatalf("Parse(%s) of the %s form = %d, %v, want %d", str, form, id, err, tt.id)
+				}
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		str  string
+		want steamid.SteamID
+	}{
+		{"22202", 76561197960287930},
+		{" 76561197960287930 ", 76561197960287930},
+		{"STEAM_1:0:11101", 76561197960287930},
+		{"[U:1:22202]", 76561197960287930},
+		{"steamcommunity.com/profiles/76561197960287930/", 76561197960287930},
+		{"https://steamcommunity.com/profiles/76561197960287930/inventory", 76561197960287930},
+	}
+	for _, tt := range tests {
+		if id, err := steamid.Parse(tt.str); err != nil || id != tt.want {
+			t.Errorf("Parse(%q) = %d, %v, want %d", tt.str, id, err, tt.want)
+		}
+	}
+	for _, str := range []string{"", "STEAM_0:2:1", "[X:1:2]", "[U:1:2:2000000]", "steamcommunity.com/market", "abc"} {
+		if _, err := steamid.Parse(str); err == nil {
+			t.Errorf("Parse(%q) succeeded", str)
+		}
+	}
+	if _, err := steamid.Parse("https://steamcommunity.com/id/gabelogannewell"); !errors.Is(err, steamid.ErrVanityURL) {
+		t.Errorf("err = %v, want ErrVanityURL", err)
+	}
+	if name, ok := steamid.VanityName("https://steamcommunity.com/id/gabelogannewell/"); !ok || name != "gabelogannewell" {
+		t.Errorf("VanityName = %s, %t", name, ok)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type holder struct {
+		SteamID steamid.SteamID `json:"steamid"`
+	}
+	data, err := json.Marshal(holder{SteamID: 76561197960287930})
+	if err != nil || string(data) != `{"steamid":"76561197960287930"}` {
+		t.Fatalf("json = %s, %v", data, err)
+	}
+	tests := []struct {
+		json string
+		want steamid.SteamID
+	}{
+		{`{"steamid":"76561197960287930"}`, 76561197960287930},
+		{`{"steamid":76561197960287930}`, 76561197960287930},
+		{`{"steamid":"[U:1:22202]"}`, 76561197960287930},
+		{`{"steamid":""}`, 0},
+		{`{"steamid":"0"}`, 0},
+		{`{"steamid":null}`, 0},
+	}
+	for _, tt := range tests {
+		value := holder{}
+		if err := json.Unmarshal([]byte(tt.json), &value); err != nil || value.SteamID != tt.want {
+			t.Errorf("Unmarshal(%s) = %d, %v, want %d", tt.json, value.SteamID, err, tt.want)
+		}
+	}
+	if err := json.Unmarshal([]byte(`{"steamid":"nope"}`), &holder{}); err == nil {
+		t.Error("invalid steam ID accepted")
+	}
+}
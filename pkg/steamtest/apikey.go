@@ -0,0 +1,89 @@
+package steamtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+func (s *Server) registerAPIKey() {
+	s.mux.HandleFunc("GET /dev/apikey", s.handleAPIKeyPage)
+	s.mux.HandleFunc("POST /dev/requestkey", s.handleRequestAPIKey)
+	s.mux.HandleFunc("GET /ISteamUser/ResolveVanityURL/v1/", s.handleResolveVanityURL)
+}
+
+// The vanity name of an account is its user name, the call takes a web api key only
+func (s *Server) handleResolveVanityURL(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if query.Get("key") == "" || query.Get("access_token") != "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	if s.apiAccount(w, r) == nil {
+		return
+	}
+	for _, acc := range s.accounts {
+		if acc.UserName == query.Get("vanityurl") {
+			writeJSON(w, map[string]any{"response": map[string]any{"steamid": itoa(acc.SteamID), "success": eresultOK}})
+			return
+		}
+	}
+	writeJSON(w, map[string]any{"response": map[string]any{"success": eresultNoMatch, "message": "No match"}})
+}
+
+func (s *Server) handleAPIKeyPage(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.communityAccount(r)
+	if acc == nil {
+		redirectLogin(w, r)
+		return
+	}
+	body := `<form id="editForm" action="/dev/requestkey" method="POST"><p>Register for a new Steam Web API Key</p></form>`
+	if acc.APIKey != "" {
+		body = fmt.Sprintf(`<h2>Your Steam Web API Key</h2><p>Key: %s</p><p>Domain Name: localhost</p>`, acc.APIKey)
+	}
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	fmt.Fprintf(w, `<!DOCTYPE html>
+<html class="responsive">
+<head><title>Steam Community :: Steam Web API Key</title></head>
+<body class="responsive_page"><div id="bodyContents_ex">%s</div></body>
+</html>`, body)
+}
+
+// A new request waits for the mobile confirmation of an API Key confirmation,
+// polling with its request ID returns the key once the confirmation is accepted
+func (s *Server) handleRequestAPIKey(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.communityAccount(r)
+	if acc == nil || !s.checkSessionID(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("null"))
+		return
+	}
+	if r.PostFormValue("domain") == "" || r.PostFormValue("agreeToTerms") != "true" {
+		writeJSON(w, map[string]any{"success": eresultInvalidParam})
+		return
+	}
+	requestID, _ := strconv.ParseUint(r.PostFormValue("request_id"), 10, 64)
+	if requestID == 0 {
+		acc.APIKey = ""
+		acc.apiKeyRequest = s.newID()
+		s.addConfirmation(acc, ConfirmationTypeAPIKey, acc.apiKeyRequest, "Steam Web API Key",
+			[]string{r.PostFormValue("domain")})
+		writeJSON(w, map[string]any{"success": eresultPending, "requires_confirmation": 1, "request_id": itoa(acc.apiKeyRequest)})
+		return
+	}
+	switch {
+	case requestID != acc.apiKeyRequest:
+		writeJSON(w, map[string]any{"success": eresultInvalidParam})
+	case acc.APIKey != "":
+		writeJSON(w, map[string]any{"success": eresultOK, "api_key": acc.APIKey})
+	default:
+		writeJSON(w, map[string]any{"success": eresultPending, "requires_confirmation": 1, "request_id": itoa(acc.apiKeyRequest)})
+	}
+}
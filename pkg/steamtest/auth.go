@@ -0,0 +1,300 @@
+package steamtest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	pb "github.com/umichan0621/steam/pkg/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	eresultOK                    = 1
+	eresultInvalidPassword       = 5
+	eresultInvalidParam          = 8
+	eresultAccessDenied          = 15
+	eresultFileNotFound          = 9
+	eresultPending               = 22
+	eresultNoMatch               = 42
+	eresultTwoFactorCodeMismatch = 88
+)
+
+type authSession struct {
+	clientID  uint64
+	requestID []byte
+	steamID   uint64
+	needsCode bool
+	approved  bool
+}
+
+type transfer struct {
+	steamID     uint64
+	auth        string
+	accessToken string
+}
+
+func (s *Server) registerAuth() {
+	s.mux.HandleFunc("GET /IAuthenticationService/GetPasswordRSAPublicKey/v1", s.handleGetPasswordRSAPublicKey)
+	s.mux.HandleFunc("POST /IAuthenticationService/BeginAuthSessionViaCredentials/v1", s.handleBeginAuthSession)
+	s.mux.HandleFunc("POST /IAuthenticationService/UpdateAuthSessionWithSteamGuardCode/v1", s.handleUpdateAuthSession)
+	s.mux.HandleFunc("POST /IAuthenticationService/PollAuthSessionStatus/v1", s.handlePollAuthSession)
+	s.mux.HandleFunc("POST /IAuthenticationService/GenerateAccessTokenForApp/v1", s.handleGenerateAccessToken)
+	s.mux.HandleFunc("POST /jwt/finalizelogin", s.handleFinalizeLogin)
+	s.mux.HandleFunc("POST /login/settoken", s.handleSetToken)
+}
+
+func (s *Server) handleGetPasswordRSAPublicKey(w http.ResponseWriter, r *http.Request) {
+	req := pb.CAuthentication_GetPasswordRSAPublicKey_Request{}
+	if err := decodeProtoRequest(r, &req); err != nil {
+		writeEResult(w, eresultInvalidParam)
+		return
+	}
+	res := pb.CAuthentication_GetPasswordRSAPublicKey_Response{
+		PublickeyMod: proto.String(s.rsaKey.N.Text(16)),
+		PublickeyExp: proto.String(big.NewInt(int64(s.rsaKey.E)).Text(16)),
+		Timestamp:    proto.Uint64(s.rsaTimestamp),
+	}
+	writeProto(w, &res)
+}
+
+func (s *Server) handleBeginAuthSession(w http.ResponseWriter, r *http.Request) {
+	req := pb.CAuthentication_BeginAuthSessionViaCredentials_Request{}
+	if err := decodeProtoRequest(r, &req); err != nil {
+		writeEResult(w, eresultInvalidParam)
+		return
+	}
+	encrypted, err := base64.StdEncoding.DecodeString(req.GetEncryptedPassword())
+	if err != nil {
+		writeEResult(w, eresultInvalidPassword)
+		return
+	}
+	password, err := rsa.DecryptPKCS1v15(rand.Reader, s.rsaKey, encrypted)
+	if err != nil || req.GetEncryptionTimestamp() != s.rsaTimestamp {
+		writeEResult(w, eresultInvalidPassword)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var acc *Account
+	for _, tmp := range s.accounts {
+		if tmp.UserName == req.GetAccountName() {
+			acc = tmp
+			break
+		}
+	}
+	if acc == nil || acc.Password != string(password) {
+		writeEResult(w, eresultInvalidPassword)
+		return
+	}
+	session := &authSession{
+		clientID:  s.newID(),
+		requestID: []byte(strconv.FormatUint(s.newID(), 16)),
+		steamID:   acc.SteamID,
+		needsCode: acc.SharedSecret != "",
+	}
+	session.approved = !session.needsCode
+	s.authSessions[session.clientID] = session
+
+	guardType := pb.EAuthSessionGuardType_k_EAuthSessionGuardType_None
+	if session.needsCode {
+		guardType = pb.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode
+	}
+	res := pb.CAuthentication_BeginAuthSessionViaCredentials_Response{
+		ClientId:  proto.Uint64(session.clientID),
+		RequestId: session.requestID,
+		Interval:  proto.Float32(5),
+		Steamid:   proto.Uint64(acc.SteamID),
+		AllowedConfirmations: []*pb.CAuthentication_AllowedConfirmation{
+			{ConfirmationType: guardType.Enum()},
+		},
+	}
+	writeProto(w, &res)
+}
+
+func (s *Server) handleUpdateAuthSession(w http.ResponseWriter, r *http.Request) {
+	req := pb.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request{}
+	if err := decodeProtoRequest(r, &req); err != nil {
+		writeEResult(w, eresultInvalidParam)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.authSessions[req.GetClientId()]
+	if !ok || session.steamID != req.GetSteamid() {
+		writeEResult(w, eresultFileNotFound)
+		return
+	}
+	if req.GetCodeType() != pb.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode {
+		writeEResult(w, eresultInvalidParam)
+		return
+	}
+	acc := s.accounts[session.steamID]
+	now := s.now().Unix()
+	for _, current := range []int64{now, now - 30, now + 30} {
+		code, err := auth.GenerateTwoFactorCode(acc.SharedSecret, current)
+		if err == nil && code == req.GetCode() {
+			session.approved = true
+			writeProto(w, &pb.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response{})
+			return
+		}
+	}
+	writeEResult(w, eresultTwoFactorCodeMismatch)
+}
+
+func (s *Server) handlePollAuthSession(w http.ResponseWriter, r *http.Request) {
+	req := pb.CAuthentication_PollAuthSessionStatus_Request{}
+	if err := decodeProtoRequest(r, &req); err != nil {
+		writeEResult(w, eresultInvalidParam)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.authSessions[req.GetClientId()]
+	if !ok || string(session.requestID) != string(req.GetRequestId()) {
+		writeEResult(w, eresultFileNotFound)
+		return
+	}
+	res := pb.CAuthentication_PollAuthSessionStatus_Response{}
+	if session.approved {
+		delete(s.authSessions, req.GetClientId())
+		refreshToken := s.issueToken(session.steamID, s.refreshLifetime)
+		s.refreshTokens[refreshToken] = session.steamID
+		accessToken := s.issueToken(session.steamID, s.accessLifetime)
+		s.accessTokens[accessToken] = session.steamID
+		res.RefreshToken = proto.String(refreshToken)
+		res.AccessToken = proto.String(accessToken)
+		res.AccountName = proto.String(s.accounts[session.steamID].UserName)
+	}
+	writeProto(w, &res)
+}
+
+func (s *Server) handleGenerateAccessToken(w http.ResponseWriter, r *http.Request) {
+	r.ParseMultipartForm(1 << 20)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	steamID, ok := s.refreshTokens[r.FormValue("refresh_token")]
+	if !ok || strconv.FormatUint(steamID, 10) != r.FormValue("steamid") {
+		writeEResult(w, eresultAccessDenied)
+		return
+	}
+	accessToken := s.issueToken(steamID, s.accessLifetime)
+	s.accessTokens[accessToken] = steamID
+	writeJSON(w, map[string]any{
+		"response": map[string]any{"access_token": accessToken},
+	})
+}
+
+func (s *Server) handleFinalizeLogin(w http.ResponseWriter, r *http.Request) {
+	r.ParseMultipartForm(1 << 20)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	steamID, ok := s.refreshTokens[r.FormValue("nonce")]
+	if !ok || r.FormValue("sessionid") == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]any{"error": 8})
+		return
+	}
+	tmp := &transfer{
+		steamID:     steamID,
+		auth:        randomHex(16),
+		accessToken: s.issueToken(steamID, s.accessLifetime),
+	}
+	nonce := randomHex(16)
+	s.transfers[nonce] = tmp
+	writeJSON(w, map[string]any{
+		"steamID": strconv.FormatUint(steamID, 10),
+		"redir":   r.FormValue("redir"),
+		"transfer_info": []any{
+			map[string]any{
+				"url":    s.server.URL + "/login/settoken",
+				"params": map[string]any{"nonce": nonce, "auth": tmp.auth},
+			},
+		},
+		"primary_domain": "steamcommunity.com",
+	})
+}
+
+func (s *Server) handleSetToken(w http.ResponseWriter, r *http.Request) {
+	r.ParseMultipartForm(1 << 20)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nonce := r.FormValue("nonce")
+	tmp, ok := s.transfers[nonce]
+	if !ok || tmp.auth != r.FormValue("auth") || strconv.FormatUint(tmp.steamID, 10) != r.FormValue("steamID") {
+		writeJSON(w, map[string]any{"result": eresultAccessDenied})
+		return
+	}
+	delete(s.transfers, nonce)
+	s.accessTokens[tmp.accessToken] = tmp.steamID
+	http.SetCookie(w, &http.Cookie{
+		Name:     "steamLoginSecure",
+		Value:    strconv.FormatUint(tmp.steamID, 10) + "%7C%7C" + tmp.accessToken,
+		Path:     "/",
+		Expires:  s.now().Add(s.accessLifetime),
+		MaxAge:   int(s.accessLifetime.Seconds()),
+		HttpOnly: true,
+	})
+	writeJSON(w, map[string]any{"result": eresultOK})
+}
+
+// JWT shaped like the steam ones, only "sub" and "exp" are meaningful, s.mu must be held
+func (s *Server) issueToken(steamID uint64, lifetime time.Duration) string {
+	now := s.now()
+	header, _ := json.Marshal(map[string]any{"typ": "JWT", "alg": "EdDSA"})
+	payload, _ := json.Marshal(map[string]any{
+		"iss": "steam",
+		"sub": strconv.FormatUint(steamID, 10),
+		"aud": []string{"web", "mobile"},
+		"exp": now.Add(lifetime).Unix(),
+		"nbf": now.Unix(),
+		"iat": now.Unix(),
+		"jti": fmt.Sprintf("%X", s.newID()),
+	})
+	return base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(randomHex(32)))
+}
+
+func decodeProtoRequest(r *http.Request, msg proto.Message) error {
+	encoded := r.URL.Query().Get("input_protobuf_encoded")
+	if encoded == "" {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			return err
+		}
+		encoded = r.FormValue("input_protobuf_encoded")
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+func writeProto(w http.ResponseWriter, msg proto.Message) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("X-Eresult", strconv.Itoa(eresultOK))
+	w.Write(data)
+}
+
+func randomHex(n int) string {
+	buf := make([]byte, n)
+	rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+func itoa(v uint64) string { return strconv.FormatUint(v, 10) }
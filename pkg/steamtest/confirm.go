@@ -0,0 +1,140 @@
+package steamtest
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func (s *Server) registerConfirm() {
+	s.mux.HandleFunc("GET /mobileconf/getlist", s.handleConfirmationList)
+	s.mux.HandleFunc("GET /mobileconf/ajaxop", s.handleConfirmationOp)
+}
+
+// The account of a valid mobileconf request, s.mu must be held
+func (s *Server) confirmationAccount(r *http.Request, tag string) *Account {
+	acc := s.communityAccount(r)
+	query := r.URL.Query()
+	if acc == nil || query.Get("a") != itoa(acc.SteamID) || query.Get("tag") != tag {
+		return nil
+	}
+	current, err := strconv.ParseInt(query.Get("t"), 10, 64)
+	if err != nil {
+		return nil
+	}
+	key, err := base64.StdEncoding.DecodeString(acc.IdentitySecret)
+	if err != nil {
+		return nil
+	}
+	msg := make([]byte, 8+len(tag))
+	binary.BigEndian.PutUint32(msg[4:], uint32(current))
+	copy(msg[8:], tag)
+	hash := hmac.New(sha1.New, key)
+	hash.Write(msg)
+	if base64.StdEncoding.EncodeToString(hash.Sum(nil)) != query.Get("k") {
+		return nil
+	}
+	return acc
+}
+
+func (s *Server) handleConfirmationList(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.confirmationAccount(r, "conf")
+	if acc == nil {
+		writeJSON(w, map[string]any{"success": false, "needauth": true})
+		return
+	}
+	list := []map[string]any{}
+	for _, confirmation := range s.sortedConfirmations(acc) {
+		typeName := "Trade Offer"
+		switch confirmation.Type {
+		case ConfirmationTypeListing:
+			typeName = "Market Listing"
+		case ConfirmationTypeAPIKey:
+			typeName = "API Key"
+		}
+		list = append(list, map[string]any{
+			"type":          confirmation.Type,
+			"type_name":     typeName,
+			"id":            itoa(confirmation.ID),
+			"creator_id":    itoa(confirmation.CreatorID),
+			"nonce":         itoa(confirmation.Nonce),
+			"creation_time": confirmation.Created.Unix(),
+			"cancel":        "Cancel",
+			"accept":        "Confirm",
+			"icon":          "",
+			"multi":         false,
+			"headline":      confirmation.Headline,
+			"summary":       confirmation.Summary,
+			"warn":          nil,
+		})
+	}
+	writeJSON(w, map[string]any{"success": true, "needauth": false, "conf": list})
+}
+
+func (s *Server) handleConfirmationOp(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	op := query.Get("op")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.confirmationAccount(r, op)
+	if acc == nil || (op != "allow" && op != "cancel") {
+		writeJSON(w, map[string]any{"success": false, "message": "Invalid authenticator"})
+		return
+	}
+	id, _ := strconv.ParseUint(query.Get("cid"), 10, 64)
+	confirmation, ok := acc.confirmations[id]
+	if !ok || itoa(confirmation.Nonce) != query.Get("ck") {
+		writeJSON(w, map[string]any{"success": false, "message": "Could not find the requested confirmation."})
+		return
+	}
+	delete(acc.confirmations, id)
+	switch confirmation.Type {
+	case ConfirmationTypeListing:
+		listing := acc.listings[confirmation.CreatorID]
+		if listing == nil {
+			break
+		}
+		if op == "allow" {
+			listing.Active = true
+		} else {
+			delete(acc.listings, listing.ID)
+			acc.Inventory = append(acc.Inventory, listing.Item)
+		}
+	case ConfirmationTypeTrade:
+		offer := acc.offers[confirmation.CreatorID]
+		if offer == nil {
+			break
+		}
+		if op == "allow" {
+			s.completeOffer(acc, offer)
+		} else {
+			offer.State = OfferStateCanceled
+		}
+	case ConfirmationTypeAPIKey:
+		if op == "allow" && acc.apiKeyRequest == confirmation.CreatorID {
+			acc.APIKey = strings.ToUpper(randomHex(16))
+		} else if acc.apiKeyRequest == confirmation.CreatorID {
+			acc.apiKeyRequest = 0
+		}
+	}
+	writeJSON(w, map[string]any{"success": true})
+}
+
+func (s *Server) sortedConfirmations(acc *Account) []*Confirmation {
+	res := []*Confirmation{}
+	for _, confirmation := range acc.confirmations {
+		res = append(res, confirmation)
+	}
+	for i := 1; i < len(res); i++ {
+		for j := i; j > 0 && res[j].ID < res[j-1].ID; j-- {
+			res[j], res[j-1] = res[j-1], res[j]
+		}
+	}
+	return res
+}
@@ -0,0 +1,111 @@
+package steamtest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Failure describes a scripted misbehaviour of an endpoint, only one kind should be set
+type Failure struct {
+	Status       int           // reply with the http status, e.g. 429 or 503
+	EResult      int           // reply 200 with an empty body and the X-Eresult header
+	LoginPage    bool          // redirect to the html login page like an expired session
+	MalformedRaw bool          // reply 200 with truncated json
+	Body         string        // body written with Status, optional
+	RetryAfter   int           // Retry-After header in seconds with Status, optional
+	Delay        time.Duration // wait before replying, e.g. to trigger client timeouts
+	Times        int           // number of requests affected, 0 means every request
+}
+
+type failureRule struct {
+	pathPrefix string
+	failure    Failure
+	remaining  int
+}
+
+// Inject a failure into every request whose path starts with pathPrefix,
+// e.g. "/market/pricehistory" or "/IAuthenticationService/BeginAuthSessionViaCredentials"
+func (s *Server) Fail(pathPrefix string, failure Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, &failureRule{
+		pathPrefix: pathPrefix,
+		failure:    failure,
+		remaining:  failure.Times,
+	})
+}
+
+func (s *Server) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = nil
+}
+
+// Reply with the first matching failure, return false while no rule matches
+func (s *Server) injectFailure(w http.ResponseWriter, r *http.Request) bool {
+	// The login page itself is never broken, otherwise the redirect loops
+	if strings.HasPrefix(r.URL.Path, "/login/home") {
+		writeLoginPage(w)
+		return true
+	}
+	s.mu.Lock()
+	var failure *Failure
+	for i, rule := range s.failures {
+		if !strings.HasPrefix(r.URL.Path, rule.pathPrefix) {
+			continue
+		}
+		tmp := rule.failure
+		failure = &tmp
+		if rule.remaining > 0 {
+			rule.remaining--
+			if rule.remaining == 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+		}
+		break
+	}
+	s.mu.Unlock()
+	if failure == nil {
+		return false
+	}
+
+	if failure.Delay > 0 {
+		select {
+		case <-time.After(failure.Delay):
+		case <-r.Context().Done():
+			return true
+		}
+	}
+	switch {
+	case failure.LoginPage:
+		redirectLogin(w, r)
+	case failure.MalformedRaw:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Write([]byte(`{"success":1,"data":[`))
+	case failure.EResult != 0:
+		writeEResult(w, failure.EResult)
+	case failure.Status != 0:
+		if failure.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(failure.RetryAfter))
+		}
+		w.WriteHeader(failure.Status)
+		w.Write([]byte(failure.Body))
+	default:
+		// Delay only
+		return false
+	}
+	return true
+}
+
+func writeLoginPage(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	w.Write([]byte(`<!DOCTYPE html>
+<html class="responsive">
+<head><title>Sign In</title></head>
+<body class="login"><div id="responsive_page_template_content">
+<div class="login_modal"><div class="signin_title">Sign In</div></div>
+</div></body>
+</html>`))
+}
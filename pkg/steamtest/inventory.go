@@ -0,0 +1,101 @@
+package steamtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/umichan0621/steam/pkg/common"
+)
+
+func (s *Server) registerInventory() {
+	s.mux.HandleFunc("GET /inventory/{steamid}/{appid}/{contextid}", s.handleInventory)
+	s.mux.HandleFunc("GET /market/{$}", s.handleMarketPage)
+}
+
+func (s *Server) handleInventory(w http.ResponseWriter, r *http.Request) {
+	steamID, _ := strconv.ParseUint(r.PathValue("steamid"), 10, 64)
+	appID, _ := strconv.ParseUint(r.PathValue("appid"), 10, 32)
+	contextID, _ := strconv.ParseUint(r.PathValue("contextid"), 10, 64)
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count <= 0 {
+		count = 75
+	}
+	startAssetID := r.URL.Query().Get("start_assetid")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.accounts[steamID]
+	if acc == nil {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("null"))
+		return
+	}
+	items := []*Item{}
+	for _, item := range acc.Inventory {
+		if uint64(item.AppID) == appID && item.ContextID == contextID {
+			items = append(items, item)
+		}
+	}
+	start := 0
+	if startAssetID != "" {
+		for i, item := range items {
+			if itoa(item.AssetID) == startAssetID {
+				start = i + 1
+				break
+			}
+		}
+	}
+	end := min(start+count, len(items))
+
+	assets := []map[string]any{}
+	descriptions := []*common.EconItemDesc{}
+	seen := map[string]bool{}
+	for _, item := range items[start:end] {
+		assets = append(assets, map[string]any{
+			"appid":      item.AppID,
+			"contextid":  itoa(item.ContextID),
+			"assetid":    itoa(item.AssetID),
+			"classid":    itoa(item.ClassID),
+			"instanceid": itoa(item.InstanceID),
+			"amount":     itoa(item.Amount),
+		})
+		key := fmt.Sprintf("%d_%d", item.ClassID, item.InstanceID)
+		if !seen[key] {
+			seen[key] = true
+			descriptions = append(descriptions, item.econDesc())
+		}
+	}
+	res := map[string]any{
+		"assets":                assets,
+		"descriptions":          descriptions,
+		"total_inventory_count": len(items),
+		"success":               1,
+		"rwgrsn":                -2,
+	}
+	if end < len(items) {
+		res["more_items"] = 1
+		res["last_assetid"] = itoa(items[end-1].AssetID)
+	}
+	writeJSON(w, res)
+}
+
+func (s *Server) handleMarketPage(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.communityAccount(r)
+	if acc == nil {
+		redirectLogin(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	fmt.Fprintf(w, `<!DOCTYPE html>
+<html class="responsive">
+<head><title>Steam Community Market</title>
+<script type="text/javascript">
+	var g_rgWalletInfo = {"wallet_currency":%d,"wallet_country":"%s","wallet_state":"","wallet_fee":"1","wallet_fee_minimum":"1","wallet_fee_percent":"0.05","wallet_publisher_fee_percent_default":"0.10","wallet_fee_base":"0","wallet_balance":"%d","wallet_delayed_balance":"0","wallet_max_balance":"200000","wallet_trade_max_balance":"180000","success":1,"rwgrsn":-2};
+</script>
+</head>
+<body class="responsive_page"><div id="marketWalletBalance"><span id="marketWalletBalanceAmount">%s</span></div></body>
+</html>`, acc.Currency, acc.Country, acc.Wallet, formatPrice(acc.Wallet, acc.Currency))
+}
@@ -0,0 +1,443 @@
+package steamtest
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/umichan0621/steam/pkg/common"
+)
+
+func (s *Server) registerMarket() {
+	s.mux.HandleFunc("GET /market/listings/{appid}/{hashname}", s.handleListingPage)
+	s.mux.HandleFunc("GET /market/itemordershistogram", s.handleOrderHistogram)
+	s.mux.HandleFunc("GET /market/pricehistory/", s.handlePriceHistory)
+	s.mux.HandleFunc("GET /market/priceoverview/", s.handlePriceOverview)
+	s.mux.HandleFunc("GET /market/myhistory", s.handleMyHistory)
+	s.mux.HandleFunc("GET /market/mylistings", s.handleMyListings)
+	s.mux.HandleFunc("POST /market/createbuyorder/", s.handleCreateBuyOrder)
+	s.mux.HandleFunc("POST /market/cancelbuyorder/", s.handleCancelBuyOrder)
+	s.mux.HandleFunc("POST /market/sellitem/", s.handleSellItem)
+}
+
+func (s *Server) handleListingPage(w http.ResponseWriter, r *http.Request) {
+	appID, _ := strconv.ParseUint(r.PathValue("appid"), 10, 32)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	item := s.items[marketKey(uint32(appID), r.PathValue("hashname"))]
+	if item == nil {
+		w.Write([]byte(`<html><body><div class="market_listing_table_message">There are no listings for this item.</div></body></html>`))
+		return
+	}
+	fmt.Fprintf(w, `<!DOCTYPE html>
+<html class="responsive">
+<head><title>Steam Community Market :: Listings for %s</title></head>
+<body class="responsive_page">
+<script type="text/javascript">
+	$J(function() {
+		ItemActivityTicker.Start( %d );
+		Market_LoadOrderSpread( %d );	// initial load
+	});
+</script>
+</body>
+</html>`, html.EscapeString(item.HashName), item.NameID, item.NameID)
+}
+
+func (s *Server) handleOrderHistogram(w http.ResponseWriter, r *http.Request) {
+	nameID, _ := strconv.ParseUint(r.URL.Query().Get("item_nameid"), 10, 64)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var item *MarketItem
+	for _, tmp := range s.items {
+		if tmp.NameID == nameID {
+			item = tmp
+			break
+		}
+	}
+	if item == nil {
+		writeJSON(w, map[string]any{"success": 16})
+		return
+	}
+	writeJSON(w, map[string]any{
+		"success":          1,
+		"buy_order_graph":  orderGraph(item.BuyOrders, "buy orders at"),
+		"sell_order_graph": orderGraph(item.SellOrders, "sell orders at"),
+		"graph_max_y":      0,
+		"price_prefix":     "$",
+		"price_suffix":     "",
+	})
+}
+
+// Each level of the graph is [price, cumulative quantity, description]
+func orderGraph(levels []OrderLevel, desc string) [][]any {
+	res := [][]any{}
+	total := 0
+	for _, level := range levels {
+		total += level.Quantity
+		res = append(res, []any{
+			float64(level.Price) / 100,
+			total,
+			fmt.Sprintf("%d %s $%.2f or higher", total, desc, float64(level.Price)/100),
+		})
+	}
+	return res
+}
+
+func (s *Server) handlePriceHistory(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	appID, _ := strconv.ParseUint(query.Get("appid"), 10, 32)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Price history is only visible while logged in
+	if s.communityAccount(r) == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("[]"))
+		return
+	}
+	item := s.items[marketKey(uint32(appID), query.Get("market_hash_name"))]
+	if item == nil {
+		writeJSON(w, map[string]any{"success": false})
+		return
+	}
+	prices := [][]any{}
+	for _, point := range item.PriceHistory {
+		prices = append(prices, []any{
+			point.Time.UTC().Format("Jan 02 2006 15: +0"),
+			point.Price,
+			strconv.Itoa(point.Volume),
+		})
+	}
+	writeJSON(w, map[string]any{
+		"success":      true,
+		"price_prefix": "$",
+		"price_suffix": "",
+		"prices":       prices,
+	})
+}
+
+func (s *Server) handlePriceOverview(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	appID, _ := strconv.ParseUint(query.Get("appid"), 10, 32)
+	currency, err := strconv.Atoi(query.Get("currency"))
+	if err != nil || currency == 0 {
+		currency = 1
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item := s.items[marketKey(uint32(appID), query.Get("market_hash_name"))]
+	if item == nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		writeJSON(w, map[string]any{"success": false})
+		return
+	}
+	writeJSON(w, map[string]any{
+		"success":      true,
+		"lowest_price": formatPrice(item.LowestPrice, currency),
+		"volume":       formatVolume(item.Volume),
+		"median_price": formatPrice(item.MedianPrice, currency),
+	})
+}
+
+func (s *Server) handleMyHistory(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	start, _ := strconv.Atoi(query.Get("start"))
+	count, err := strconv.Atoi(query.Get("count"))
+	if err != nil || count <= 0 {
+		count = 10
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.communityAccount(r)
+	if acc == nil {
+		redirectLogin(w, r)
+		return
+	}
+
+	total := len(acc.history)
+	assets := map[string]map[string]map[string]any{}
+	rows := strings.Builder{}
+	hovers := strings.Builder{}
+	for i := start; i < total && i < start+count; i++ {
+		entry := acc.history[total-1-i]
+		item := entry.Item
+		appID, contextID, assetID := itoa(uint64(item.AppID)), itoa(item.ContextID), itoa(item.AssetID)
+		if assets[appID] == nil {
+			assets[appID] = map[string]map[string]any{}
+		}
+		if assets[appID][contextID] == nil {
+			assets[appID][contextID] = map[string]any{}
+		}
+		assets[appID][contextID][assetID] = map[string]any{
+			"currency":         0,
+			"appid":            item.AppID,
+			"contextid":        contextID,
+			"id":               assetID,
+			"classid":          itoa(item.ClassID),
+			"instanceid":       itoa(item.InstanceID),
+			"amount":           "0",
+			"status":           4,
+			"name":             item.Name,
+			"market_name":      item.Name,
+			"market_hash_name": item.MarketHashName,
+			"commodity":        0,
+		}
+
+		rowID := fmt.Sprintf("history_row_%d_%d", item.AssetID, i+1)
+		gainOrLoss := "-"
+		if entry.Purchased {
+			gainOrLoss = "+"
+		}
+		fmt.Fprintf(&rows, `<div class="market_listing_row market_recent_listing_row" id="%s">
+	<div class="market_listing_left_cell market_listing_gainorloss">%s</div>
+	<div class="market_listing_right_cell market_listing_their_price">
+		<span class="market_table_value">
+			<span class="market_listing_price">
+				%s			</span>
+		</span>
+	</div>
+	<div class="market_listing_right_cell market_listing_listed_date can_combine"><div class="market_listing_listed_date_combined">%s</div></div>
+	<div class="market_listing_item_name_block"><span id="%s_name" class="market_listing_item_name">%s</span></div>
+</div>
+`, rowID, gainOrLoss, formatPrice(entry.Price, acc.Currency), entry.Time.Format("2 Jan"), rowID, html.EscapeString(item.Name))
+		fmt.Fprintf(&hovers, "\n\t\tCreateItemHoverFromContainer( g_rgAssets, '%s_name', %d, '%d', '%d', 0 );", rowID, item.AppID, item.ContextID, item.AssetID)
+	}
+	writeJSON(w, map[string]any{
+		"success":      true,
+		"pagesize":     count,
+		"total_count":  total,
+		"start":        start,
+		"assets":       assets,
+		"hovers":       hovers.String(),
+		"results_html": rows.String(),
+	})
+}
+
+// Active listings are paged by start and count, the listings to confirm and the buy orders are always complete
+func (s *Server) handleMyListings(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	start, _ := strconv.Atoi(query.Get("start"))
+	count, err := strconv.Atoi(query.Get("count"))
+	if err != nil || count <= 0 || count > 100 {
+		count = 10
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.communityAccount(r)
+	if acc == nil {
+		redirectLogin(w, r)
+		return
+	}
+	active, toConfirm := []map[string]any{}, []map[string]any{}
+	ids := make([]uint64, 0, len(acc.listings))
+	for id := range acc.listings {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		listing := acc.listings[id]
+		fee := listing.ReceivedPrice * 15 / 100
+		if fee < 1 {
+			fee = 1
+		}
+		tmp := map[string]any{
+			"listingid":    itoa(listing.ID),
+			"price":        listing.ReceivedPrice,
+			"fee":          fee,
+			"status":       2,
+			"time_created": s.now().Unix(),
+			"asset": map[string]any{
+				"appid":            listing.Item.AppID,
+				"contextid":        itoa(listing.Item.ContextID),
+				"id":               itoa(listing.Item.AssetID),
+				"amount":           itoa(listing.Item.Amount),
+				"market_hash_name": listing.Item.MarketHashName,
+			},
+		}
+		if listing.Active {
+			active = append(active, tmp)
+		} else {
+			toConfirm = append(toConfirm, tmp)
+		}
+	}
+	total := len(active)
+	if start > total {
+		start = total
+	}
+	active = active[start:min(start+count, total)]
+
+	buyOrders := []map[string]any{}
+	for _, order := range s.buyOrdersOf(acc) {
+		buyOrders = append(buyOrders, map[string]any{
+			"buy_orderid":        itoa(order.ID),
+			"appid":              order.AppID,
+			"hash_name":          order.HashName,
+			"wallet_currency":    order.Currency,
+			"price":              strconv.FormatInt(order.PriceTotal/int64(order.Quantity), 10),
+			"quantity":           itoa(order.Quantity),
+			"quantity_remaining": itoa(order.Quantity),
+		})
+	}
+	writeJSON(w, map[string]any{
+		"success":             true,
+		"pagesize":            count,
+		"total_count":         total,
+		"start":               start,
+		"num_active_listings": total,
+		"listings":            active,
+		"listings_on_hold":    []any{},
+		"listings_to_confirm": toConfirm,
+		"buy_orders":          buyOrders,
+	})
+}
+
+func (s *Server) handleCreateBuyOrder(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.communityAccount(r)
+	if acc == nil || !s.checkSessionID(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("null"))
+		return
+	}
+	appID, _ := strconv.ParseUint(r.PostFormValue("appid"), 10, 32)
+	hashName := r.PostFormValue("market_hash_name")
+	priceTotal, err1 := strconv.ParseInt(r.PostFormValue("price_total"), 10, 64)
+	quantity, err2 := strconv.ParseUint(r.PostFormValue("quantity"), 10, 64)
+	if err1 != nil || err2 != nil || priceTotal <= 0 || quantity == 0 {
+		writeJSON(w, map[string]any{"success": eresultInvalidParam, "message": "Invalid parameters."})
+		return
+	}
+	item := s.items[marketKey(uint32(appID), hashName)]
+	if item == nil {
+		writeJSON(w, map[string]any{"success": eresultInvalidParam, "message": "The item specified does not exist."})
+		return
+	}
+	for _, order := range acc.buyOrders {
+		if order.AppID == uint32(appID) && order.HashName == hashName {
+			writeJSON(w, map[string]any{"success": 29, "message": "You already have an active buy order for this item. You will need to either cancel that order, or wait for it to be fulfilled before you can place a new order."})
+			return
+		}
+	}
+	if priceTotal > acc.Wallet {
+		writeJSON(w, map[string]any{"success": 107, "message": "You do not have enough funds in your Steam Wallet to place this order."})
+		return
+	}
+
+	order := &BuyOrder{
+		ID:         s.newID(),
+		AppID:      uint32(appID),
+		HashName:   hashName,
+		PriceTotal: priceTotal,
+		Quantity:   quantity,
+		Currency:   acc.Currency,
+	}
+	s.fillBuyOrder(acc, item, order)
+	if order.Quantity > 0 {
+		acc.buyOrders[order.ID] = order
+	}
+	writeJSON(w, map[string]any{"success": eresultOK, "buy_orderid": itoa(order.ID)})
+}
+
+// Buy from the sell orders priced at or below the unit price, s.mu must be held
+func (s *Server) fillBuyOrder(acc *Account, item *MarketItem, order *BuyOrder) {
+	unitPrice := order.PriceTotal / int64(order.Quantity)
+	for i := range item.SellOrders {
+		level := &item.SellOrders[i]
+		for level.Price <= unitPrice && level.Quantity > 0 && order.Quantity > 0 && acc.Wallet >= level.Price {
+			level.Quantity--
+			order.Quantity--
+			order.PriceTotal -= unitPrice
+			acc.Wallet -= level.Price
+			bought := s.copyItem(&Item{
+				AppID:          item.AppID,
+				ContextID:      2,
+				MarketHashName: item.HashName,
+				Tradable:       true,
+				Marketable:     true,
+			})
+			acc.Inventory = append(acc.Inventory, bought)
+			acc.history = append(acc.history, &HistoryEntry{Item: bought, Price: level.Price, Time: s.now(), Purchased: true})
+		}
+	}
+}
+
+func (s *Server) handleCancelBuyOrder(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.communityAccount(r)
+	if acc == nil || !s.checkSessionID(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("null"))
+		return
+	}
+	orderID, _ := strconv.ParseUint(r.PostFormValue("buy_orderid"), 10, 64)
+	if _, ok := acc.buyOrders[orderID]; !ok {
+		writeJSON(w, map[string]any{"success": eresultInvalidParam})
+		return
+	}
+	delete(acc.buyOrders, orderID)
+	writeJSON(w, map[string]any{"success": eresultOK})
+}
+
+func (s *Server) handleSellItem(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.communityAccount(r)
+	if acc == nil || !s.checkSessionID(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("null"))
+		return
+	}
+	assetID, _ := strconv.ParseUint(r.PostFormValue("assetid"), 10, 64)
+	price, err := strconv.ParseInt(r.PostFormValue("price"), 10, 64)
+	if err != nil || price <= 0 {
+		w.WriteHeader(http.StatusBadGateway)
+		writeJSON(w, map[string]any{"success": false, "message": "The price entered is invalid."})
+		return
+	}
+	index := -1
+	for i, item := range acc.Inventory {
+		if item.AssetID == assetID && item.Marketable {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		w.WriteHeader(http.StatusBadGateway)
+		writeJSON(w, map[string]any{"success": false, "message": "The item specified is no longer in your inventory or is not allowed to be traded on the Community Market."})
+		return
+	}
+	item := acc.Inventory[index]
+	acc.Inventory = append(acc.Inventory[:index], acc.Inventory[index+1:]...)
+	listing := &Listing{ID: s.newID(), Item: item, ReceivedPrice: price}
+	acc.listings[listing.ID] = listing
+	s.addConfirmation(acc, ConfirmationTypeListing, listing.ID, "Sell - "+item.Name,
+		[]string{formatPrice(price, acc.Currency)})
+	writeJSON(w, map[string]any{
+		"success":                   true,
+		"requires_confirmation":     1,
+		"needs_mobile_confirmation": true,
+		"needs_email_confirmation":  false,
+		"email_domain":              "",
+	})
+}
+
+// Price string as the community pages render it
+func formatPrice(cents int64, currency int) string {
+	return common.MoneyFromCents(cents, common.LookupCurrency(strconv.Itoa(currency))).String()
+}
+
+func formatVolume(volume int) string {
+	str := strconv.Itoa(volume)
+	for i := len(str) - 3; i > 0; i -= 3 {
+		str = str[:i] + "," + str[i:]
+	}
+	return str
+}
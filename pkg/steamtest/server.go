@@ -0,0 +1,191 @@
+// Package steamtest provides an in-process fake of the steam endpoints used by this library,
+// so bots can be tested without touching real accounts.
+package steamtest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+// Server serves every steam host (api, community, login) from a single httptest.Server,
+// Endpoints returns the configuration pointing auth.Core at it
+type Server struct {
+	server *httptest.Server
+	mux    *http.ServeMux
+
+	mu              sync.Mutex
+	rsaKey          *rsa.PrivateKey
+	rsaTimestamp    uint64
+	accounts        map[uint64]*Account // steam ID -> account
+	authSessions    map[uint64]*authSession
+	transfers       map[string]*transfer // finalizelogin nonce -> settoken params
+	refreshTokens   map[string]uint64    // refresh token -> steam ID
+	accessTokens    map[string]uint64    // access token -> steam ID
+	items           map[string]*MarketItem
+	failures        []*failureRule
+	accessLifetime  time.Duration
+	refreshLifetime time.Duration
+	nextID          uint64
+	clock           utils.Clock
+}
+
+func NewServer() *Server {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		panic(fmt.Sprintf("steamtest: fail to generate rsa key: %s", err.Error()))
+	}
+	s := &Server{
+		mux:             http.NewServeMux(),
+		rsaKey:          rsaKey,
+		rsaTimestamp:    uint64(time.Now().Unix()),
+		clock:           utils.SystemClock{},
+		accounts:        map[uint64]*Account{},
+		authSessions:    map[uint64]*authSession{},
+		transfers:       map[string]*transfer{},
+		refreshTokens:   map[string]uint64{},
+		accessTokens:    map[string]uint64{},
+		items:           map[string]*MarketItem{},
+		accessLifetime:  24 * time.Hour,
+		refreshLifetime: 200 * 24 * time.Hour,
+		nextID:          1000,
+	}
+	s.registerAuth()
+	s.registerInventory()
+	s.registerMarket()
+	s.registerTrade()
+	s.registerConfirm()
+	s.registerAPIKey()
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *Server) Close()      { s.server.Close() }
+func (s *Server) URL() string { return s.server.URL }
+
+func (s *Server) Endpoints() common.Endpoints {
+	return common.Endpoints{
+		API:       s.server.URL,
+		Community: s.server.URL,
+		Login:     s.server.URL,
+		Store:     s.server.URL,
+		Help:      s.server.URL,
+	}
+}
+
+// A Core ready to log in to the fake server
+func (s *Server) NewCore(info auth.LoginInfo) *auth.Core {
+	core := &auth.Core{}
+	core.Init(info)
+	core.SetEndpoints(s.Endpoints())
+	core.SetClock(s.Clock())
+	return core
+}
+
+func (s *Server) Clock() utils.Clock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.clock
+}
+
+// Used for 2FA verification, token expiry and timestamps, share it with the Core under test
+func (s *Server) SetClock(clock utils.Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clock = clock
+}
+
+// s.mu must be held
+func (s *Server) now() time.Time { return s.clock.Now() }
+
+// Lifetime of the tokens issued after this call
+func (s *Server) SetTokenLifetime(access, refresh time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accessLifetime = access
+	s.refreshLifetime = refresh
+}
+
+// Invalidate every token of the account, like a password change on steam
+func (s *Server) RevokeTokens(steamID uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for token, id := range s.refreshTokens {
+		if id == steamID {
+			delete(s.refreshTokens, token)
+		}
+	}
+	for token, id := range s.accessTokens {
+		if id == steamID {
+			delete(s.accessTokens, token)
+		}
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.injectFailure(w, r) {
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// Unique ID for orders, offers, assets and confirmations, s.mu must be held
+func (s *Server) newID() uint64 {
+	s.nextID++
+	return s.nextID
+}
+
+// The account of the logged in community session, nil if not logged in, s.mu must be held
+func (s *Server) communityAccount(r *http.Request) *Account {
+	cookie, err := r.Cookie("steamLoginSecure")
+	if err != nil {
+		return nil
+	}
+	parts := strings.Split(cookie.Value, "%7C%7C")
+	if len(parts) != 2 {
+		return nil
+	}
+	steamID, ok := s.accessTokens[parts[1]]
+	if !ok || strconv.FormatUint(steamID, 10) != parts[0] {
+		return nil
+	}
+	return s.accounts[steamID]
+}
+
+// Check the sessionid form value against the cookie, s.mu must be held
+func (s *Server) checkSessionID(r *http.Request) bool {
+	cookie, err := r.Cookie("sessionid")
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+	if r.MultipartForm == nil && r.PostForm == nil {
+		r.ParseMultipartForm(1 << 20)
+	}
+	return r.FormValue("sessionid") == cookie.Value
+}
+
+// Steam redirects community pages to the login page while the session is invalid
+func redirectLogin(w http.ResponseWriter, r *http.Request) {
+	http.Redirect(w, r, "/login/home/?goto="+strings.TrimPrefix(r.URL.Path, "/"), http.StatusFound)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeEResult(w http.ResponseWriter, eresult int) {
+	w.Header().Set("X-Eresult", strconv.Itoa(eresult))
+	w.WriteHeader(http.StatusOK)
+}
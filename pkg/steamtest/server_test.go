@@ -0,0 +1,161 @@
+package steamtest_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/confirm"
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/inventory"
+	"github.com/umichan0621/steam/pkg/market"
+	"github.com/umichan0621/steam/pkg/steamid"
+	"github.com/umichan0621/steam/pkg/steamtest"
+	"github.com/umichan0621/steam/pkg/trade"
+)
+
+var usd, _ = common.CurrencyByCode("USD")
+
+// A logged in account holding a case, and the market of the case
+func newBot(t *testing.T) (*steamtest.Server, *auth.Core, uint64) {
+	t.Helper()
+	server, core, steamID := steamtest.NewLoggedIn(t, steamtest.Account{Wallet: 10000,
+		Inventory: []*steamtest.Item{{AppID: 730, ContextID: 2, ClassID: 1, Amount: 1, Name: "Case", MarketHashName: "Case", Tradable: true, Marketable: true}},
+	})
+	server.AddMarketItem(steamtest.MarketItem{AppID: 730, HashName: "Case", LowestPrice: 100, MedianPrice: 95, Volume: 10,
+		BuyOrders: []steamtest.OrderLevel{{Price: 90, Quantity: 3}}, SellOrders: []steamtest.OrderLevel{{Price: 100, Quantity: 2}}})
+	return server, core, steamID
+}
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServerFlow(t *testing.T) {
+	server, core, steamID := newBot(t)
+	if core.SteamID().Uint64() != steamID {
+		t.Fatalf("steam id = %d, want %d", core.SteamID(), steamID)
+	}
+
+	wallet, err := inventory.WalletBalance(core)
+	must(t, err)
+	if wallet.WalletBalance != common.MoneyFromCents(10000, usd) {
+		t.Fatalf("wallet = %s", wallet.WalletBalance)
+	}
+
+	items := []inventory.InventoryItem{}
+	_, _, err = inventory.AllItems(core, "730", "2", "", 100, &items)
+	must(t, err)
+	if len(items) != 1 || items[0].Desc == nil || items[0].Desc.MarketHashName != "Case" {
+		t.Fatalf("inventory = %+v", items)
+	}
+
+	order, err := market.CreateBuyOrder(core, "730", common.MoneyFromCents(180, usd), 2, "Case")
+	must(t, err)
+	orders := server.BuyOrders(steamID)
+	if len(orders) != 1 || orders[0].ID != order.OrderID || orders[0].PriceTotal != 180 {
+		t.Fatalf("buy orders = %+v", orders)
+	}
+	listings, err := market.MyListings(core, 0, 10)
+	must(t, err)
+	if len(listings.BuyOrders) != 1 || listings.BuyOrders[0].OrderID != order.OrderID {
+		t.Fatalf("my buy orders = %+v", listings.BuyOrders)
+	}
+	must(t, market.CancelBuyOrder(core, order.OrderID))
+	if len(server.BuyOrders(steamID)) != 0 {
+		t.Fatal("buy order not cancelled")
+	}
+
+	_, err = market.CreateSellOrder(core, "730", "2", items[0].AssetID, 1, common.MoneyFromCents(80, usd))
+	must(t, err)
+	confirmations, err := confirm.GetConfirmations(core)
+	must(t, err)
+	if len(confirmations) != 1 {
+		t.Fatalf("confirmations = %d, want 1", len(confirmations))
+	}
+	must(t, confirm.AnswerConfirmation(core, confirmations[0], "allow"))
+	sold := server.Listings(steamID)
+	if len(sold) != 1 || !sold[0].Active || sold[0].ReceivedPrice != 80 {
+		t.Fatalf("listings = %+v", sold)
+	}
+
+	server.AddHistory(steamID, steamtest.HistoryEntry{Item: &steamtest.Item{AppID: 730, ContextID: 2, ClassID: 1, Name: "Case", MarketHashName: "Case"},
+		Price: 123, Purchased: true})
+	history, err := market.HistoryOrder(core, "730", "2", 0, 10)
+	must(t, err)
+	if len(history) == 0 || history[0].MarketHashName != "Case" || history[0].Price != common.MoneyFromCents(123, usd) {
+		t.Fatalf("history = %+v", history)
+	}
+
+	partner := steamid.FromAccountID(5)
+	offerID := server.AddTradeOffer(steamID, steamtest.Offer{PartnerAccount: partner.AccountID()})
+	offers, err := trade.GetTradeOffers(core, core.Clock().Now().Add(-time.Hour))
+	must(t, err)
+	if len(offers.ReceivedOffers) != 1 || offers.ReceivedOffers[0].ID != strconv.FormatUint(offerID, 10) {
+		t.Fatalf("offers = %+v", offers.ReceivedOffers)
+	}
+	must(t, trade.AcceptTradeOffer(core, strconv.FormatUint(offerID, 10), partner))
+	if offer, _ := server.Offer(steamID, offerID); offer.State != steamtest.OfferStateAccepted {
+		t.Fatalf("offer state = %d", offer.State)
+	}
+	declined := server.AddTradeOffer(steamID, steamtest.Offer{PartnerAccount: partner.AccountID()})
+	must(t, trade.DeclineTradeOffer(core, strconv.FormatUint(declined, 10)))
+	if offer, _ := server.Offer(steamID, declined); offer.State != steamtest.OfferStateDeclined {
+		t.Fatalf("offer state = %d", offer.State)
+	}
+}
+
+func TestServerFail(t *testing.T) {
+	server, core, steamID := newBot(t)
+
+	// A single 503 is retried by the reads
+	server.Fail("/market/mylistings", steamtest.Failure{Status: 503, Times: 1})
+	_, err := market.MyListings(core, 0, 10)
+	must(t, err)
+
+	tests := []struct {
+		name    string
+		path    string
+		failure steamtest.Failure
+		want    error
+	}{
+		{"expired session", "/market/", steamtest.Failure{LoginPage: true}, errcode.ErrNotLoggedOn},
+		{"rate limited", "/market/", steamtest.Failure{Status: 429}, errcode.ErrRateLimitExceeded},
+		{"x-eresult", "/market/", steamtest.Failure{EResult: int(errcode.EResultServiceUnavailable)}, errcode.ErrServiceUnavailable},
+		{"truncated json", "/market/mylistings", steamtest.Failure{MalformedRaw: true}, errcode.EResultBadResponse},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.Fail(tt.path, tt.failure)
+			defer server.ClearFailures()
+			var err error
+			if tt.path == "/market/mylistings" {
+				_, err = market.MyListings(core, 0, 10)
+			} else {
+				_, err = inventory.WalletBalance(core)
+			}
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	// The insufficient funds of steam carry their EResult and the endpoint
+	server.SetWallet(steamID, 10)
+	_, err = market.CreateBuyOrder(core, "730", common.MoneyFromCents(500, usd), 1, "Case")
+	var steamErr *errcode.Error
+	if !errors.Is(err, errcode.ErrInsufficientFunds) || !errors.As(err, &steamErr) || steamErr.Endpoint != "/market/createbuyorder/" {
+		t.Fatalf("err = %v", err)
+	}
+
+	server.RevokeTokens(steamID)
+	if _, err := inventory.WalletBalance(core); !errors.Is(err, errcode.ErrNotLoggedOn) {
+		t.Fatalf("err = %v after the tokens are revoked", err)
+	}
+}
@@ -0,0 +1,357 @@
+package steamtest
+
+import (
+	"sort"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/common"
+)
+
+type Account struct {
+	UserName       string
+	Password       string
+	SharedSecret   string // enable the mobile authenticator while not empty
+	IdentitySecret string
+	SteamID        uint64 // generated while 0
+	Currency       int    // wallet currency ID, USD while 0
+	Country        string
+	Wallet         int64 // in cents
+	Inventory      []*Item
+	APIKey         string // registered web api key, none while empty
+
+	buyOrders     map[uint64]*BuyOrder
+	listings      map[uint64]*Listing
+	offers        map[uint64]*Offer
+	confirmations map[uint64]*Confirmation
+	history       []*HistoryEntry
+	apiKeyRequest uint64 // request ID of the last web api key registration
+}
+
+// Item is both an inventory asset and the econ description of it
+type Item struct {
+	AppID          uint32
+	ContextID      uint64
+	AssetID        uint64 // generated while 0
+	ClassID        uint64
+	InstanceID     uint64
+	Amount         uint64
+	Name           string
+	MarketHashName string
+	Tradable       bool
+	Marketable     bool
+}
+
+type BuyOrder struct {
+	ID         uint64
+	AppID      uint32
+	HashName   string
+	PriceTotal int64 // in cents, for all the quantity
+	Quantity   uint64
+	Currency   int
+}
+
+type Listing struct {
+	ID            uint64
+	Item          *Item
+	ReceivedPrice int64 // in cents
+	Active        bool  // false until the mobile confirmation is accepted
+}
+
+type Offer struct {
+	ID             uint64 // generated while 0
+	PartnerAccount uint32
+	Message        string
+	ItemsToGive    []*Item
+	ItemsToReceive []*Item
+	State          uint8 // 2 active while 0
+	IsOurOffer     bool
+	Created        time.Time
+}
+
+const (
+	OfferStateActive            = 2
+	OfferStateAccepted          = 3
+	OfferStateDeclined          = 7
+	OfferStateNeedsConfirmation = 9
+	OfferStateCanceled          = 6
+)
+
+const (
+	ConfirmationTypeTrade   = 2
+	ConfirmationTypeListing = 3
+	ConfirmationTypeAPIKey  = 9
+)
+
+type Confirmation struct {
+	ID        uint64
+	Nonce     uint64
+	Type      uint8
+	CreatorID uint64 // offer ID or listing ID
+	Headline  string
+	Summary   []string
+	Created   time.Time
+}
+
+type HistoryEntry struct {
+	Item      *Item
+	Price     int64 // in cents
+	Time      time.Time
+	Purchased bool // bought from the market, sold otherwise
+}
+
+// Public market data of an item, used by the histogram, price overview and price history endpoints
+type MarketItem struct {
+	AppID        uint32
+	HashName     string
+	NameID       uint64
+	LowestPrice  int64 // in cents
+	MedianPrice  int64 // in cents
+	Volume       int
+	BuyOrders    []OrderLevel // sorted by price desc
+	SellOrders   []OrderLevel // sorted by price asc
+	PriceHistory []PricePoint
+}
+
+type OrderLevel struct {
+	Price    int64 // in cents
+	Quantity int
+}
+
+type PricePoint struct {
+	Time   time.Time
+	Price  float64
+	Volume int
+}
+
+// Register an account and return its steam ID
+func (s *Server) AddAccount(account Account) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := account
+	if acc.SteamID == 0 {
+		acc.SteamID = 76561197960265728 + s.newID()
+	}
+	if acc.Currency == 0 {
+		acc.Currency = 1
+	}
+	if acc.Country == "" {
+		acc.Country = "US"
+	}
+	acc.Inventory = nil
+	for _, item := range account.Inventory {
+		acc.Inventory = append(acc.Inventory, s.copyItem(item))
+	}
+	acc.buyOrders = map[uint64]*BuyOrder{}
+	acc.listings = map[uint64]*Listing{}
+	acc.offers = map[uint64]*Offer{}
+	acc.confirmations = map[uint64]*Confirmation{}
+	s.accounts[acc.SteamID] = &acc
+	return acc.SteamID
+}
+
+func (s *Server) AddMarketItem(item MarketItem) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tmp := item
+	if tmp.NameID == 0 {
+		tmp.NameID = s.newID()
+	}
+	s.items[marketKey(tmp.AppID, tmp.HashName)] = &tmp
+}
+
+// Add an offer received by the account and return its ID
+func (s *Server) AddTradeOffer(steamID uint64, offer Offer) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.accounts[steamID]
+	if acc == nil {
+		return 0
+	}
+	tmp := offer
+	if tmp.ID == 0 {
+		tmp.ID = s.newID()
+	}
+	if tmp.State == 0 {
+		tmp.State = OfferStateActive
+	}
+	if tmp.Created.IsZero() {
+		tmp.Created = s.now()
+	}
+	tmp.ItemsToGive, tmp.ItemsToReceive = nil, nil
+	for _, item := range offer.ItemsToGive {
+		tmp.ItemsToGive = append(tmp.ItemsToGive, s.copyItem(item))
+	}
+	for _, item := range offer.ItemsToReceive {
+		tmp.ItemsToReceive = append(tmp.ItemsToReceive, s.copyItem(item))
+	}
+	acc.offers[tmp.ID] = &tmp
+	return tmp.ID
+}
+
+// Add a pending mobile confirmation and return its ID
+func (s *Server) AddConfirmation(steamID uint64, confirmation Confirmation) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.accounts[steamID]
+	if acc == nil {
+		return 0
+	}
+	return s.addConfirmation(acc, confirmation.Type, confirmation.CreatorID, confirmation.Headline, confirmation.Summary)
+}
+
+// Append a market history entry, newest entries are returned first
+func (s *Server) AddHistory(steamID uint64, entry HistoryEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.accounts[steamID]
+	if acc == nil {
+		return
+	}
+	tmp := entry
+	tmp.Item = s.copyItem(entry.Item)
+	if tmp.Time.IsZero() {
+		tmp.Time = s.now()
+	}
+	acc.history = append(acc.history, &tmp)
+}
+
+func (s *Server) Wallet(steamID uint64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if acc := s.accounts[steamID]; acc != nil {
+		return acc.Wallet
+	}
+	return 0
+}
+
+func (s *Server) SetWallet(steamID uint64, cents int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if acc := s.accounts[steamID]; acc != nil {
+		acc.Wallet = cents
+	}
+}
+
+func (s *Server) Inventory(steamID uint64) []Item {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := []Item{}
+	if acc := s.accounts[steamID]; acc != nil {
+		for _, item := range acc.Inventory {
+			res = append(res, *item)
+		}
+	}
+	return res
+}
+
+func (s *Server) BuyOrders(steamID uint64) []BuyOrder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := []BuyOrder{}
+	if acc := s.accounts[steamID]; acc != nil {
+		for _, order := range s.buyOrdersOf(acc) {
+			res = append(res, *order)
+		}
+	}
+	return res
+}
+
+func (s *Server) Listings(steamID uint64) []Listing {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := []Listing{}
+	if acc := s.accounts[steamID]; acc != nil {
+		for _, listing := range acc.listings {
+			res = append(res, *listing)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+func (s *Server) Offer(steamID, offerID uint64) (Offer, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if acc := s.accounts[steamID]; acc != nil {
+		if offer, ok := acc.offers[offerID]; ok {
+			return *offer, true
+		}
+	}
+	return Offer{}, false
+}
+
+func (s *Server) Confirmations(steamID uint64) []Confirmation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := []Confirmation{}
+	if acc := s.accounts[steamID]; acc != nil {
+		for _, confirmation := range acc.confirmations {
+			res = append(res, *confirmation)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+// Sorted by ID, s.mu must be held
+func (s *Server) buyOrdersOf(acc *Account) []*BuyOrder {
+	res := []*BuyOrder{}
+	for _, order := range acc.buyOrders {
+		res = append(res, order)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+// s.mu must be held
+func (s *Server) copyItem(item *Item) *Item {
+	tmp := *item
+	if tmp.AssetID == 0 {
+		tmp.AssetID = s.newID()
+	}
+	if tmp.Amount == 0 {
+		tmp.Amount = 1
+	}
+	if tmp.ClassID == 0 {
+		tmp.ClassID = uint64(len(tmp.MarketHashName)) + 100
+	}
+	if tmp.Name == "" {
+		tmp.Name = tmp.MarketHashName
+	}
+	return &tmp
+}
+
+// s.mu must be held
+func (s *Server) addConfirmation(acc *Account, confirmationType uint8, creatorID uint64, headline string, summary []string) uint64 {
+	confirmation := &Confirmation{
+		ID:        s.newID(),
+		Nonce:     s.newID() * 7919,
+		Type:      confirmationType,
+		CreatorID: creatorID,
+		Headline:  headline,
+		Summary:   summary,
+		Created:   s.now(),
+	}
+	acc.confirmations[confirmation.ID] = confirmation
+	return confirmation.ID
+}
+
+func (item *Item) econDesc() *common.EconItemDesc {
+	tradable := 0
+	if item.Tradable {
+		tradable = 1
+	}
+	return &common.EconItemDesc{
+		ClassID:        item.ClassID,
+		InstanceID:     item.InstanceID,
+		Tradable:       tradable,
+		Name:           item.Name,
+		MarketName:     item.Name,
+		MarketHashName: item.MarketHashName,
+		MarketFeeApp:   item.AppID,
+	}
+}
+
+func marketKey(appID uint32, hashName string) string {
+	return itoa(uint64(appID)) + "/" + hashName
+}
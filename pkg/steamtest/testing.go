@@ -0,0 +1,45 @@
+package steamtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+// Shared and identity secret of the test accounts, base64 of "secretsecretsecret"
+const TestSecret = "c2VjcmV0c2VjcmV0c2VjcmV0"
+
+// Start time of the manual clock of NewLoggedIn
+var TestTime = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// A fake server holding account and a Core logged in to it, both on a manual clock so the
+// retry delays are skipped. The user name, password and secrets of account default to
+// "bot", "pass" and TestSecret; the server is closed with the test
+func NewLoggedIn(t testing.TB, account Account) (*Server, *auth.Core, uint64) {
+	t.Helper()
+	if account.UserName == "" {
+		account.UserName = "bot"
+	}
+	if account.Password == "" {
+		account.Password = "pass"
+	}
+	if account.SharedSecret == "" {
+		account.SharedSecret = TestSecret
+	}
+	if account.IdentitySecret == "" {
+		account.IdentitySecret = TestSecret
+	}
+	server := NewServer()
+	t.Cleanup(server.Close)
+	server.SetClock(utils.NewManualClock(TestTime))
+	steamID := server.AddAccount(account)
+	core := server.NewCore(auth.LoginInfo{UserName: account.UserName, Password: account.Password,
+		SharedSecret: account.SharedSecret, IdentitySecret: account.IdentitySecret})
+	core.SetLogger(nil)
+	if err := core.Login(); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	return server, core, steamID
+}
@@ -0,0 +1,238 @@
+package steamtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/umichan0621/steam/pkg/common"
+)
+
+func (s *Server) registerTrade() {
+	s.mux.HandleFunc("GET /IEconService/GetTradeOffers/v1/", s.handleGetTradeOffers)
+	s.mux.HandleFunc("GET /IEconService/GetTradeOffer/v1/", s.handleGetTradeOffer)
+	s.mux.HandleFunc("POST /tradeoffer/{id}/accept", s.handleAcceptTradeOffer)
+	s.mux.HandleFunc("POST /tradeoffer/{id}/cancel", s.handleCancelTradeOffer)
+	s.mux.HandleFunc("POST /tradeoffer/{id}/decline", s.handleDeclineTradeOffer)
+}
+
+// The account of the api access token or key, write 401 while both are invalid, s.mu must be held
+func (s *Server) apiAccount(w http.ResponseWriter, r *http.Request) *Account {
+	steamID, ok := s.accessTokens[r.URL.Query().Get("access_token")]
+	if key := r.URL.Query().Get("key"); !ok && key != "" {
+		for _, acc := range s.accounts {
+			if acc.APIKey == key {
+				steamID, ok = acc.SteamID, true
+				break
+			}
+		}
+	}
+	if !ok {
+		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("<html><head><title>Unauthorized</title></head><body><h1>Unauthorized</h1>Access is denied. Retrying will not help. Please verify your <pre>key=</pre> parameter.</body></html>"))
+		return nil
+	}
+	return s.accounts[steamID]
+}
+
+func (s *Server) handleGetTradeOffers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.apiAccount(w, r)
+	if acc == nil {
+		return
+	}
+	activeOnly := query.Get("active_only") == "1"
+	cutoff, _ := strconv.ParseInt(query.Get("time_historical_cutoff"), 10, 64)
+	sent, received := []map[string]any{}, []map[string]any{}
+	descriptions := []*common.EconItemDesc{}
+	for _, offer := range sortedOffers(acc) {
+		active := offer.State == OfferStateActive || offer.State == OfferStateNeedsConfirmation
+		if activeOnly && !active && offer.Created.Unix() < cutoff {
+			continue
+		}
+		if offer.IsOurOffer && query.Get("get_sent_offers") == "1" {
+			sent = append(sent, offerJSON(offer))
+		} else if !offer.IsOurOffer && query.Get("get_received_offers") == "1" {
+			received = append(received, offerJSON(offer))
+		} else {
+			continue
+		}
+		if query.Get("get_descriptions") == "1" {
+			for _, item := range append(offer.ItemsToGive, offer.ItemsToReceive...) {
+				descriptions = append(descriptions, item.econDesc())
+			}
+		}
+	}
+	res := map[string]any{"next_cursor": 0}
+	if len(sent) > 0 {
+		res["trade_offers_sent"] = sent
+	}
+	if len(received) > 0 {
+		res["trade_offers_received"] = received
+	}
+	if len(descriptions) > 0 {
+		res["descriptions"] = descriptions
+	}
+	writeJSON(w, map[string]any{"response": res})
+}
+
+func (s *Server) handleGetTradeOffer(w http.ResponseWriter, r *http.Request) {
+	offerID, _ := strconv.ParseUint(r.URL.Query().Get("tradeofferid"), 10, 64)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.apiAccount(w, r)
+	if acc == nil {
+		return
+	}
+	offer, ok := acc.offers[offerID]
+	if !ok {
+		writeJSON(w, map[string]any{"response": map[string]any{}})
+		return
+	}
+	res := map[string]any{"offer": offerJSON(offer)}
+	if r.URL.Query().Get("get_descriptions") == "1" {
+		descriptions := []*common.EconItemDesc{}
+		for _, item := range append(offer.ItemsToGive, offer.ItemsToReceive...) {
+			descriptions = append(descriptions, item.econDesc())
+		}
+		res["descriptions"] = descriptions
+	}
+	writeJSON(w, map[string]any{"response": res})
+}
+
+func (s *Server) handleAcceptTradeOffer(w http.ResponseWriter, r *http.Request) {
+	r.ParseMultipartForm(1 << 20)
+	offerID, _ := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.communityAccount(r)
+	if acc == nil {
+		redirectLogin(w, r)
+		return
+	}
+	offer, ok := acc.offers[offerID]
+	if !s.checkSessionID(r) || !ok || offer.IsOurOffer || offer.State != OfferStateActive {
+		w.WriteHeader(http.StatusInternalServerError)
+		writeJSON(w, map[string]any{"strError": "There was an error accepting this trade offer.  Please try again later. (11)"})
+		return
+	}
+	if len(offer.ItemsToGive) > 0 {
+		offer.State = OfferStateNeedsConfirmation
+		s.addConfirmation(acc, ConfirmationTypeTrade, offer.ID, "Trade Offer",
+			[]string{fmt.Sprintf("You will give up %d item(s)", len(offer.ItemsToGive))})
+		writeJSON(w, map[string]any{
+			"needs_mobile_confirmation": true,
+			"needs_email_confirmation":  false,
+			"email_domain":              "",
+		})
+		return
+	}
+	s.completeOffer(acc, offer)
+	writeJSON(w, map[string]any{"tradeid": itoa(s.newID())})
+}
+
+func (s *Server) handleCancelTradeOffer(w http.ResponseWriter, r *http.Request) {
+	s.closeTradeOffer(w, r, true)
+}
+
+func (s *Server) handleDeclineTradeOffer(w http.ResponseWriter, r *http.Request) {
+	s.closeTradeOffer(w, r, false)
+}
+
+// Cancel a sent offer or decline a received one
+func (s *Server) closeTradeOffer(w http.ResponseWriter, r *http.Request, ourOffer bool) {
+	r.ParseForm()
+	offerID, _ := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	acc := s.communityAccount(r)
+	if acc == nil {
+		redirectLogin(w, r)
+		return
+	}
+	offer, ok := acc.offers[offerID]
+	if !s.checkSessionID(r) || !ok || offer.IsOurOffer != ourOffer || offer.State != OfferStateActive {
+		w.WriteHeader(http.StatusInternalServerError)
+		writeJSON(w, map[string]any{"success": false})
+		return
+	}
+	if ourOffer {
+		offer.State = OfferStateCanceled
+	} else {
+		offer.State = OfferStateDeclined
+	}
+	writeJSON(w, map[string]any{"tradeofferid": itoa(offer.ID)})
+}
+
+// Move the items and mark the offer accepted, s.mu must be held
+func (s *Server) completeOffer(acc *Account, offer *Offer) {
+	for _, given := range offer.ItemsToGive {
+		for i, item := range acc.Inventory {
+			if item.AssetID == given.AssetID {
+				acc.Inventory = append(acc.Inventory[:i], acc.Inventory[i+1:]...)
+				break
+			}
+		}
+	}
+	for _, received := range offer.ItemsToReceive {
+		item := *received
+		item.AssetID = s.newID()
+		acc.Inventory = append(acc.Inventory, &item)
+	}
+	offer.State = OfferStateAccepted
+}
+
+func sortedOffers(acc *Account) []*Offer {
+	res := []*Offer{}
+	for _, offer := range acc.offers {
+		res = append(res, offer)
+	}
+	for i := 1; i < len(res); i++ {
+		for j := i; j > 0 && res[j].ID < res[j-1].ID; j-- {
+			res[j], res[j-1] = res[j-1], res[j]
+		}
+	}
+	return res
+}
+
+func offerJSON(offer *Offer) map[string]any {
+	items := func(list []*Item) []map[string]any {
+		res := []map[string]any{}
+		for _, item := range list {
+			res = append(res, map[string]any{
+				"appid":      item.AppID,
+				"contextid":  itoa(item.ContextID),
+				"assetid":    itoa(item.AssetID),
+				"classid":    itoa(item.ClassID),
+				"instanceid": itoa(item.InstanceID),
+				"amount":     itoa(item.Amount),
+				"missing":    false,
+				"est_usd":    "0",
+			})
+		}
+		return res
+	}
+	res := map[string]any{
+		"tradeofferid":         itoa(offer.ID),
+		"accountid_other":      offer.PartnerAccount,
+		"message":              offer.Message,
+		"expiration_time":      offer.Created.Unix() + 14*24*3600,
+		"trade_offer_state":    offer.State,
+		"is_our_offer":         offer.IsOurOffer,
+		"time_created":         offer.Created.Unix(),
+		"time_updated":         offer.Created.Unix(),
+		"from_real_time_trade": false,
+		"escrow_end_date":      0,
+		"confirmation_method":  0,
+	}
+	if len(offer.ItemsToGive) > 0 {
+		res["items_to_give"] = items(offer.ItemsToGive)
+	}
+	if len(offer.ItemsToReceive) > 0 {
+		res["items_to_receive"] = items(offer.ItemsToReceive)
+	}
+	return res
+}
@@ -0,0 +1,42 @@
+package trade
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/dryrun"
+	"github.com/umichan0621/steam/pkg/logging"
+)
+
+// Move the items of the offer in the virtual inventory, the offer itself is read from steam
+func simulatedAccept(ctx context.Context, auth *auth.Core, sim *dryrun.Simulator, offerID string) error {
+	offer, names, err := describedOffer(ctx, auth, offerID)
+	if err != nil {
+		err = fmt.Errorf("fail to get the items of trade offer %s, %w", offerID, err)
+		auth.Logger().Warn("fail to accept simulated trade offer", logging.OfferID(offerID), logging.Err(err))
+		return err
+	}
+	items := func(econItems []*EconItem) []dryrun.Item {
+		res := []dryrun.Item{}
+		for _, item := range econItems {
+			res = append(res, dryrun.Item{
+				AppID:     item.AppID,
+				ContextID: item.ContextID,
+				AssetID:   item.AssetID,
+				HashName:  names[itemKey(item)],
+				Amount:    uint64(item.Amount),
+			})
+		}
+		return res
+	}
+	sim.AcceptTrade(offerID, items(offer.SendItems), items(offer.RecvItems))
+	auth.Logger().Info("simulated trade offer accepted", logging.OfferID(offerID))
+	return nil
+}
+
+func simulatedAction(auth *auth.Core, sim *dryrun.Simulator, offerID string, action dryrun.TradeAction) error {
+	sim.RecordTrade(offerID, action)
+	auth.Logger().Info("simulated trade offer "+string(action), logging.OfferID(offerID))
+	return nil
+}
@@ -1,12 +1,8 @@
 package trade
 
 import (
-	"bytes"
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"io"
-	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -14,6 +10,12 @@ import (
 
 	"github.com/umichan0621/steam/pkg/auth"
 	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/dryrun"
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/logging"
+	"github.com/umichan0621/steam/pkg/retry"
+	"github.com/umichan0621/steam/pkg/steamid"
 )
 
 type EconItem struct {
@@ -24,7 +26,12 @@ type EconItem struct {
 	ContextID  uint64 `json:"contextid,string"`
 	Amount     uint32 `json:"amount,string"`
 	Missing    bool   `json:"missing,omitempty"`
-	EstUSD     uint32 `json:"est_usd,string"`
+	EstUSD     uint32 `json:"est_usd,string"` // in cents, see EstimatedValue
+}
+
+// Value steam estimates for the item, in USD
+func (item *EconItem) EstimatedValue() common.Money {
+	return common.MoneyFromCents(int64(item.EstUSD), common.LookupCurrency(common.CurrencyUSD))
 }
 
 type TradeOffer struct {
@@ -43,6 +50,25 @@ type TradeOffer struct {
 	RealTime           bool        `json:"from_real_time_trade"`
 	IsOurOffer         bool        `json:"is_our_offer"`
 }
+
+// Steam ID of the other account of the offer
+func (offer *TradeOffer) PartnerID() steamid.SteamID { return steamid.FromAccountID(offer.Partner) }
+
+// Values of TradeOffer.State
+const (
+	OfferStateInvalid                = 1
+	OfferStateActive                 = 2
+	OfferStateAccepted               = 3
+	OfferStateCountered              = 4
+	OfferStateExpired                = 5
+	OfferStateCanceled               = 6
+	OfferStateDeclined               = 7
+	OfferStateInvalidItems           = 8
+	OfferStateNeedsConfirmation      = 9
+	OfferStateCanceledBySecondFactor = 10
+	OfferStateInEscrow               = 11
+)
+
 type APIResponse struct {
 	Inner *TradeOfferResponse `json:"response"`
 }
@@ -54,115 +80,231 @@ type TradeOfferResponse struct {
 }
 
 func GetTradeOffers(auth *auth.Core, timeCutOff time.Time) (*TradeOfferResponse, error) {
-	params := url.Values{
-		"access_token":           {auth.AccessToken()},
+	return GetTradeOffersContext(context.Background(), auth, timeCutOff)
+}
+
+func GetTradeOffersContext(ctx context.Context, auth *auth.Core, timeCutOff time.Time) (*TradeOfferResponse, error) {
+	return retry.Read(ctx, auth, retry.OpGetTradeOffers, func(ctx context.Context) (*TradeOfferResponse, error) {
+		return getTradeOffers(ctx, auth, timeCutOff)
+	})
+}
+
+func getTradeOffers(ctx context.Context, auth *auth.Core, timeCutOff time.Time) (*TradeOfferResponse, error) {
+	query := url.Values{
 		"get_sent_offers":        {"1"},
 		"get_received_offers":    {"1"},
 		"active_only":            {"1"},
 		"get_descriptions":       {"1"},
-		"language":               {"english"},
+		"language":               {auth.Language().String()},
 		"historical_only":        {"0"},
 		"time_historical_cutoff": {strconv.FormatInt(timeCutOff.Unix(), 10)},
 	}
-	reqUrl := fmt.Sprintf("%s/IEconService/GetTradeOffers/v1/?%s", common.URI_STEAM_API, params.Encode())
-	httpRes, err := auth.HttpClient().Get(reqUrl)
-	if err != nil {
+	if err := auth.AuthorizeAPI(ctx, query); err != nil {
 		return nil, err
 	}
-	defer httpRes.Body.Close()
-
 	res := APIResponse{}
-	err = json.NewDecoder(httpRes.Body).Decode(&res)
+	httpRes, err := web.JSON(ctx, auth, &web.Request{
+		Path:  auth.Endpoints().API + "/IEconService/GetTradeOffers/v1/",
+		Query: query,
+	}, &res)
 	if err != nil {
 		return nil, err
 	}
+	if res.Inner == nil {
+		return nil, errcode.New(httpRes.Endpoint, httpRes.Status, errcode.EResultBadResponse, "no response in the body")
+	}
 	return res.Inner, nil
 }
 
 func GetTradeOffer(auth *auth.Core, offerID string) (*TradeOffer, error) {
-	params := url.Values{
-		"access_token": {auth.AccessToken()},
-		"tradeofferid": {offerID},
-	}
-	reqUrl := fmt.Sprintf("%s/IEconService/GetTradeOffer/v1/?%s", common.URI_STEAM_API, params.Encode())
-	httpRes, err := auth.HttpClient().Get(reqUrl)
-	if err != nil {
+	return GetTradeOfferContext(context.Background(), auth, offerID)
+}
+
+func GetTradeOfferContext(ctx context.Context, auth *auth.Core, offerID string) (*TradeOffer, error) {
+	return retry.Read(ctx, auth, retry.OpGetTradeOffer, func(ctx context.Context) (*TradeOffer, error) {
+		return getTradeOffer(ctx, auth, offerID)
+	})
+}
+
+func getTradeOffer(ctx context.Context, auth *auth.Core, offerID string) (*TradeOffer, error) {
+	query := url.Values{"tradeofferid": {offerID}}
+	if err := auth.AuthorizeAPI(ctx, query); err != nil {
 		return nil, err
 	}
-	defer httpRes.Body.Close()
-
 	res := APIResponse{}
-	err = json.NewDecoder(httpRes.Body).Decode(&res)
+	httpRes, err := web.JSON(ctx, auth, &web.Request{
+		Path:  auth.Endpoints().API + "/IEconService/GetTradeOffer/v1/",
+		Query: query,
+	}, &res)
 	if err != nil {
 		return nil, err
 	}
+	if res.Inner == nil {
+		return nil, errcode.New(httpRes.Endpoint, httpRes.Status, errcode.EResultBadResponse, "no response in the body")
+	}
 	return res.Inner.Offer, nil
 }
 
-func AcceptTradeOffer(auth *auth.Core, offerID, partner string) error {
-	reqBody := new(bytes.Buffer)
-	multipartWriter := multipart.NewWriter(reqBody)
-	multipartWriter.WriteField("sessionid", auth.SessionID())
-	multipartWriter.WriteField("serverid", "1")
-	multipartWriter.WriteField("tradeofferid", offerID)
-	multipartWriter.WriteField("partner", partner)
-	multipartWriter.WriteField("captcha", "")
-	multipartWriter.Close()
+func AcceptTradeOffer(auth *auth.Core, offerID string, partner steamid.SteamID) error {
+	return AcceptTradeOfferContext(context.Background(), auth, offerID, partner)
+}
 
-	reqUrl := fmt.Sprintf("%s/tradeoffer/%s/accept", common.URI_STEAM_COMMUNITY, offerID)
-	req, err := http.NewRequest("POST", reqUrl, reqBody)
-	if err != nil {
+// Refused with a *policy.Violation by the policy of auth, if any
+func AcceptTradeOfferContext(ctx context.Context, auth *auth.Core, offerID string, partner steamid.SteamID) error {
+	if err := checkAcceptPolicy(ctx, auth, offerID); err != nil {
+		auth.Audit(ctx, retry.OpAcceptTradeOffer.Name, map[string]any{"offer_id": offerID, "partner": partner}, nil, err)
+		auth.Logger().Warn("trade offer acceptance refused", logging.OfferID(offerID), logging.Err(err))
 		return err
 	}
-	req.Header.Set("Content-Type", multipartWriter.FormDataContentType())
-	req.Header.Set("Referer", fmt.Sprintf("%s/tradeoffer/%s", common.URI_STEAM_COMMUNITY, offerID))
-
-	httpRes, err := auth.HttpClient().Do(req)
-	if err != nil {
+	if sim := auth.DryRun(); sim != nil {
+		err := simulatedAccept(ctx, auth, sim, offerID)
+		auth.Audit(ctx, retry.OpAcceptTradeOffer.Name, map[string]any{"offer_id": offerID, "partner": partner}, nil, err)
 		return err
 	}
-	defer httpRes.Body.Close()
-
-	if httpRes.StatusCode != http.StatusOK {
-		return fmt.Errorf("http error: %d", httpRes.StatusCode)
-	}
-	xx, _ := io.ReadAll(httpRes.Body)
-	fmt.Println(string(xx))
-	type Response struct {
-		ErrorMessage string `json:"strError"`
-	}
-
-	var response Response
-	if err = json.NewDecoder(httpRes.Body).Decode(&response); err != nil {
+	response, err := retry.Write(ctx, auth, retry.OpAcceptTradeOffer, func(ctx context.Context) (*AcceptResponse, error) {
+		return acceptTradeOffer(ctx, auth, offerID, partner)
+	}, reconcileAccept(auth, offerID))
+	auth.Audit(ctx, retry.OpAcceptTradeOffer.Name, map[string]any{"offer_id": offerID, "partner": partner}, response, err)
+	if err != nil {
+		auth.Logger().Warn("fail to accept trade offer", logging.OfferID(offerID), logging.Err(err))
 		return err
 	}
+	auth.Logger().Info("trade offer accepted", logging.OfferID(offerID))
+	return nil
+}
+
+// Answer of steam to an accepted offer, TradeID is set once the items moved
+type AcceptResponse struct {
+	TradeID                    string `json:"tradeid"`
+	MobileConfirmationRequired bool   `json:"needs_mobile_confirmation"`
+	EmailConfirmationRequired  bool   `json:"needs_email_confirmation"`
+	EmailDomain                string `json:"email_domain"`
+}
 
-	if len(response.ErrorMessage) != 0 {
-		return errors.New(response.ErrorMessage)
+func acceptTradeOffer(ctx context.Context, auth *auth.Core, offerID string, partner steamid.SteamID) (*AcceptResponse, error) {
+	response := &AcceptResponse{}
+	_, err := web.JSON(ctx, auth, &web.Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/tradeoffer/%s/accept", offerID),
+		Multipart: url.Values{
+			"serverid":     {"1"},
+			"tradeofferid": {offerID},
+			"partner":      {partner.String()},
+			"captcha":      {""},
+		},
+		Referer: fmt.Sprintf("/tradeoffer/%s", offerID),
+		Login:   true,
+	}, response)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return response, nil
 }
 
 func CancelTradeOffer(auth *auth.Core, offerID string) error {
-	postUrl := fmt.Sprintf("%s/tradeoffer/%s/cancel", common.URI_STEAM_COMMUNITY, offerID)
-	res, err := auth.HttpClient().PostForm(postUrl, url.Values{
-		"sessionid": {auth.SessionID()},
-	})
+	return CancelTradeOfferContext(context.Background(), auth, offerID)
+}
+
+func CancelTradeOfferContext(ctx context.Context, auth *auth.Core, offerID string) error {
+	if sim := auth.DryRun(); sim != nil {
+		err := simulatedAction(auth, sim, offerID, dryrun.TradeCancel)
+		auth.Audit(ctx, retry.OpCancelTradeOffer.Name, map[string]any{"offer_id": offerID}, nil, err)
+		return err
+	}
+	_, err := retry.Write(ctx, auth, retry.OpCancelTradeOffer, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, cancelTradeOffer(ctx, auth, offerID)
+	}, reconcileOffer(auth, offerID, OfferStateCanceled))
+	auth.Audit(ctx, retry.OpCancelTradeOffer.Name, map[string]any{"offer_id": offerID}, nil, err)
 	if err != nil {
+		auth.Logger().Warn("fail to cancel trade offer", logging.OfferID(offerID), logging.Err(err))
 		return err
 	}
-	defer res.Body.Close()
+	auth.Logger().Info("trade offer canceled", logging.OfferID(offerID))
 	return nil
 }
 
+func cancelTradeOffer(ctx context.Context, auth *auth.Core, offerID string) error {
+	_, err := web.JSON(ctx, auth, &web.Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/tradeoffer/%s/cancel", offerID),
+		Form:   url.Values{},
+		Login:  true,
+	}, nil)
+	return err
+}
+
 func DeclineTradeOffer(auth *auth.Core, offerID string) error {
-	postUrl := fmt.Sprintf("%s/tradeoffer/%s/decline", common.URI_STEAM_COMMUNITY, offerID)
-	res, err := auth.HttpClient().PostForm(postUrl, url.Values{
-		"sessionid": {auth.SessionID()},
-	})
+	return DeclineTradeOfferContext(context.Background(), auth, offerID)
+}
+
+func DeclineTradeOfferContext(ctx context.Context, auth *auth.Core, offerID string) error {
+	if sim := auth.DryRun(); sim != nil {
+		err := simulatedAction(auth, sim, offerID, dryrun.TradeDecline)
+		auth.Audit(ctx, retry.OpDeclineTradeOffer.Name, map[string]any{"offer_id": offerID}, nil, err)
+		return err
+	}
+	_, err := retry.Write(ctx, auth, retry.OpDeclineTradeOffer, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, declineTradeOffer(ctx, auth, offerID)
+	}, reconcileOffer(auth, offerID, OfferStateDeclined))
+	auth.Audit(ctx, retry.OpDeclineTradeOffer.Name, map[string]any{"offer_id": offerID}, nil, err)
 	if err != nil {
+		auth.Logger().Warn("fail to decline trade offer", logging.OfferID(offerID), logging.Err(err))
 		return err
 	}
-	defer res.Body.Close()
+	auth.Logger().Info("trade offer declined", logging.OfferID(offerID))
 	return nil
 }
+
+func declineTradeOffer(ctx context.Context, auth *auth.Core, offerID string) error {
+	_, err := web.JSON(ctx, auth, &web.Request{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/tradeoffer/%s/decline", offerID),
+		Form:   url.Values{},
+		Login:  true,
+	}, nil)
+	return err
+}
+
+// The offer still active means the failed attempt had no effect, one of the done states that it took effect
+func reconcileOffer(auth *auth.Core, offerID string, done ...uint8) retry.Reconcile[struct{}] {
+	return func(ctx context.Context) (struct{}, retry.Outcome, error) {
+		_, outcome, err := offerOutcome(ctx, auth, offerID, done...)
+		return struct{}{}, outcome, err
+	}
+}
+
+// The trade ID of an accepted offer is read back from it, an offer waiting for the
+// mobile confirmation has none yet
+func reconcileAccept(auth *auth.Core, offerID string) retry.Reconcile[*AcceptResponse] {
+	return func(ctx context.Context) (*AcceptResponse, retry.Outcome, error) {
+		offer, outcome, err := offerOutcome(ctx, auth, offerID, OfferStateAccepted, OfferStateNeedsConfirmation, OfferStateInEscrow)
+		if outcome != retry.Applied {
+			return nil, outcome, err
+		}
+		response := &AcceptResponse{MobileConfirmationRequired: offer.State == OfferStateNeedsConfirmation}
+		if offer.ReceiptID != 0 {
+			response.TradeID = strconv.FormatUint(offer.ReceiptID, 10)
+		}
+		return response, outcome, nil
+	}
+}
+
+// Applied once the offer reached one of the done states
+func offerOutcome(ctx context.Context, auth *auth.Core, offerID string, done ...uint8) (*TradeOffer, retry.Outcome, error) {
+	offer, err := GetTradeOfferContext(ctx, auth, offerID)
+	if err != nil {
+		return nil, retry.Unknown, err
+	}
+	if offer == nil {
+		return nil, retry.Unknown, nil
+	}
+	if offer.State == OfferStateActive {
+		return offer, retry.NotApplied, nil
+	}
+	for _, state := range done {
+		if offer.State == state {
+			return offer, retry.Applied, nil
+		}
+	}
+	return offer, retry.Unknown, nil
+}
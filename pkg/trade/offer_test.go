@@ -0,0 +1,62 @@
+package trade_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/umichan0621/steam/pkg/audit"
+	errcode "github.com/umichan0621/steam/pkg/err"
+	"github.com/umichan0621/steam/pkg/steamid"
+	"github.com/umichan0621/steam/pkg/steamtest"
+	"github.com/umichan0621/steam/pkg/trade"
+)
+
+func TestGetTradeOfferNoResponse(t *testing.T) {
+	server, core, steamID := steamtest.NewLoggedIn(t, steamtest.Account{})
+	partner := steamid.FromAccountID(5)
+	offerID := strconv.FormatUint(server.AddTradeOffer(steamID, steamtest.Offer{PartnerAccount: partner.AccountID()}), 10)
+
+	server.Fail("/IEconService/GetTradeOffer", steamtest.Failure{Status: 200, Body: "{}"})
+	if _, err := trade.GetTradeOffer(core, offerID); !errors.Is(err, errcode.EResultBadResponse) {
+		t.Fatalf("err = %v, want a bad response", err)
+	}
+	if _, err := trade.GetTradeOffers(core, core.Clock().Now()); !errors.Is(err, errcode.EResultBadResponse) {
+		t.Fatalf("err = %v, want a bad response", err)
+	}
+
+	// The reconcile of a failed accept reads the offer, its outcome stays unknown
+	server.Fail("/tradeoffer/", steamtest.Failure{Status: 503, Times: 1})
+	if err := trade.AcceptTradeOffer(core, offerID, partner); err == nil {
+		t.Fatal("accept succeeded without knowing the offer state")
+	}
+}
+
+type records []*audit.Record
+
+func (r *records) Append(record *audit.Record) error {
+	*r = append(*r, record)
+	return nil
+}
+
+func TestAcceptAuditResponse(t *testing.T) {
+	server, core, steamID := steamtest.NewLoggedIn(t, steamtest.Account{})
+	sink := &records{}
+	log, _ := audit.New(sink)
+	core.SetAuditLog(log)
+	partner := steamid.FromAccountID(5)
+	offerID := server.AddTradeOffer(steamID, steamtest.Offer{PartnerAccount: partner.AccountID(),
+		ItemsToReceive: []*steamtest.Item{{AppID: 730, ContextID: 2, ClassID: 9, MarketHashName: "Key"}}})
+
+	if err := trade.AcceptTradeOffer(core, strconv.FormatUint(offerID, 10), partner); err != nil {
+		t.Fatal(err)
+	}
+	if len(*sink) != 1 {
+		t.Fatalf("%d audit records", len(*sink))
+	}
+	response := trade.AcceptResponse{}
+	if err := json.Unmarshal((*sink)[0].Response, &response); err != nil || response.TradeID == "" {
+		t.Fatalf("response = %s, %v, want the trade ID", (*sink)[0].Response, err)
+	}
+}
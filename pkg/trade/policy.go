@@ -0,0 +1,75 @@
+package trade
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/umichan0621/steam/pkg/auth"
+	"github.com/umichan0621/steam/pkg/internal/web"
+	"github.com/umichan0621/steam/pkg/logging"
+)
+
+// Check the items given away by the offer against the policy of the Core
+func checkAcceptPolicy(ctx context.Context, auth *auth.Core, offerID string) error {
+	engine := auth.Policy()
+	if engine == nil || !engine.NeedsItemNames() {
+		return nil
+	}
+	if engine.Overridden(ctx) {
+		auth.Logger().Warn("policy overridden for a trade offer", logging.OfferID(offerID))
+		return nil
+	}
+	given, err := givenItemNames(ctx, auth, offerID)
+	if err != nil {
+		return err
+	}
+	return engine.CheckTrade(ctx, auth.UserName(), given)
+}
+
+// Market hash names of the items given away by the offer
+func givenItemNames(ctx context.Context, auth *auth.Core, offerID string) ([]string, error) {
+	offer, names, err := describedOffer(ctx, auth, offerID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get the items of trade offer %s for the policy, %w", offerID, err)
+	}
+	given := []string{}
+	for _, item := range offer.SendItems {
+		name, ok := names[itemKey(item)]
+		if !ok {
+			return nil, fmt.Errorf("fail to get the items of trade offer %s for the policy, no description of asset %s", offerID, item.AssetID)
+		}
+		given = append(given, name)
+	}
+	return given, nil
+}
+
+// The offer with the market hash names of its items by itemKey
+func describedOffer(ctx context.Context, auth *auth.Core, offerID string) (*TradeOffer, map[string]string, error) {
+	query := url.Values{
+		"tradeofferid":     {offerID},
+		"get_descriptions": {"1"},
+		"language":         {auth.Language().String()},
+	}
+	if err := auth.AuthorizeAPI(ctx, query); err != nil {
+		return nil, nil, err
+	}
+	res := APIResponse{}
+	_, err := web.JSON(ctx, auth, &web.Request{
+		Path:  auth.Endpoints().API + "/IEconService/GetTradeOffer/v1/",
+		Query: query,
+	}, &res)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.Inner == nil || res.Inner.Offer == nil {
+		return nil, nil, fmt.Errorf("no such offer")
+	}
+	names := map[string]string{}
+	for _, desc := range res.Inner.Descriptions {
+		names[fmt.Sprintf("%d_%d", desc.ClassID, desc.InstanceID)] = desc.MarketHashName
+	}
+	return res.Inner.Offer, names, nil
+}
+
+func itemKey(item *EconItem) string { return fmt.Sprintf("%d_%d", item.ClassID, item.InstanceID) }
@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Source of time for 2FA codes, confirmation keys, login sleeps and price windows
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time                         { return time.Now() }
+func (SystemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// ManualClock only moves by Set and Advance.
+// After fires at once and advances the clock by d, so sleeps are skipped but time still flows;
+// with SetBlocking it waits for Set or Advance to reach the deadline instead
+type ManualClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	block   bool
+	waiters []waiter
+}
+
+type waiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+func (clock *ManualClock) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return clock.now
+}
+
+func (clock *ManualClock) Set(now time.Time) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.now = now
+	clock.wake()
+}
+
+func (clock *ManualClock) Advance(d time.Duration) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.now = clock.now.Add(d)
+	clock.wake()
+}
+
+// A blocking After only fires once the test moves the clock, to step a loop paced by it;
+// the pending ones fire when blocking is turned off
+func (clock *ManualClock) SetBlocking(block bool) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.block = block
+	if !block {
+		for _, w := range clock.waiters {
+			w.ch <- clock.now
+		}
+		clock.waiters = nil
+	}
+}
+
+// Number of blocking After waiting for the clock to move
+func (clock *ManualClock) Waiters() int {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return len(clock.waiters)
+}
+
+func (clock *ManualClock) After(d time.Duration) <-chan time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if clock.block && d > 0 {
+		clock.waiters = append(clock.waiters, waiter{at: clock.now.Add(d), ch: ch})
+		return ch
+	}
+	if d > 0 {
+		clock.now = clock.now.Add(d)
+	}
+	ch <- clock.now
+	return ch
+}
+
+// Fire the waiters reached by now, clock.mu must be held
+func (clock *ManualClock) wake() {
+	pending := clock.waiters[:0]
+	for _, w := range clock.waiters {
+		if w.at.After(clock.now) {
+			pending = append(pending, w)
+			continue
+		}
+		w.ch <- clock.now
+	}
+	clock.waiters = pending
+}
+
+func Sleep(clock Clock, d time.Duration) {
+	<-clock.After(d)
+}
+
+// Return ctx.Err() while ctx is done before d elapses
+func SleepContext(ctx context.Context, clock Clock, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-clock.After(d):
+		return nil
+	}
+}
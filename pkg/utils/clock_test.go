@@ -0,0 +1,54 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+func TestManualClockBlocking(t *testing.T) {
+	start := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	clock := utils.NewManualClock(start)
+	clock.SetBlocking(true)
+	fired := func(ch <-chan time.Time) bool {
+		select {
+		case <-ch:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// Zero never waits, the others wait for the clock to reach their deadline
+	if !fired(clock.After(0)) {
+		t.Fatal("After(0) is blocked")
+	}
+	short, long := clock.After(time.Minute), clock.After(time.Hour)
+	if fired(short) || clock.Waiters() != 2 || !clock.Now().Equal(start) {
+		t.Fatalf("After moved the clock to %s, waiters = %d", clock.Now(), clock.Waiters())
+	}
+	clock.Advance(30 * time.Second)
+	if fired(short) {
+		t.Fatal("After fired before its deadline")
+	}
+	clock.Advance(30 * time.Second)
+	if !fired(short) || fired(long) || clock.Waiters() != 1 {
+		t.Fatalf("waiters = %d after reaching the first deadline", clock.Waiters())
+	}
+	clock.Set(start.Add(2 * time.Hour))
+	if !fired(long) || clock.Waiters() != 0 {
+		t.Fatal("Set did not fire the last waiter")
+	}
+
+	// Turning blocking off releases the pending ones, After advances the clock again
+	pending := clock.After(time.Hour)
+	clock.SetBlocking(false)
+	if !fired(pending) {
+		t.Fatal("pending After not released")
+	}
+	clock.After(time.Minute)
+	if got := clock.Now(); !got.Equal(start.Add(2*time.Hour + time.Minute)) {
+		t.Fatalf("now = %s", got)
+	}
+}
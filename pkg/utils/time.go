@@ -2,40 +2,81 @@ package utils
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
-)
+	"unicode"
 
-var monthMap = map[string]string{
-	"Jan": "01",
-	"Feb": "02",
-	"Mar": "03",
-	"Apr": "04",
-	"May": "05",
-	"Jun": "06",
-	"Jul": "07",
-	"Aug": "08",
-	"Sep": "09",
-	"Oct": "10",
-	"Nov": "11",
-	"Dec": "12",
-}
+	"github.com/umichan0621/steam/pkg/common"
+)
 
-// Steam timestamp format: Jan 02 2006 15: +0
-func ParseSteamTimestamp(timestamp string) (time.Time, error) {
+// Steam timestamp format: Jan 02 2006 15: +0, the month is named in language, the one of the session;
+// the zero time on error
+func ParseSteamTimestamp(timestamp string, language common.Language) (time.Time, error) {
 	res := strings.Split(strings.Replace(timestamp, ": +0", "", 1), " ")
 	if len(res) != 4 {
-		return time.Now(), fmt.Errorf("fail to parse steam timestamp: %s", timestamp)
+		return time.Time{}, fmt.Errorf("fail to parse steam timestamp: %s", timestamp)
 	}
-	month, ok := monthMap[res[0]]
+	month, ok := common.ParseMonth(res[0], language)
 	if !ok {
-		return time.Now(), fmt.Errorf("fail to parse steam timestamp: %s", timestamp)
+		return time.Time{}, fmt.Errorf("fail to parse steam timestamp: %s", timestamp)
+	}
+	day, err1 := strconv.Atoi(res[1])
+	year, err2 := strconv.Atoi(res[2])
+	hour, err3 := strconv.Atoi(res[3])
+	if err1 != nil || err2 != nil || err3 != nil {
+		return time.Time{}, fmt.Errorf("fail to parse steam timestamp: %s", timestamp)
+	}
+	return time.Date(year, month, day, hour, 0, 0, 0, time.UTC), nil
+}
+
+// Day of a date of the community pages in language, e.g. "2 Jan", "Jan 2, 2024", "2. Jan.",
+// "2 janv." or "1月2日". Without year it is the last such day not after now
+func ParseSteamDate(text string, language common.Language, now time.Time) (time.Time, error) {
+	numbers := []int{}
+	month := time.Month(0)
+	if strings.ContainsAny(text, "年月日월일") {
+		// 2024年1月2日, 1월 2일: the numbers are the year, the month and the day in this order
+		for _, field := range strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsDigit(r) }) {
+			value, _ := strconv.Atoi(field)
+			numbers = append(numbers, value)
+		}
+		if len(numbers) < 2 {
+			return time.Time{}, fmt.Errorf("fail to parse steam date: %s", text)
+		}
+		month = time.Month(numbers[len(numbers)-2])
+		numbers = append(numbers[:len(numbers)-2], numbers[len(numbers)-1])
+	} else {
+		for _, field := range strings.FieldsFunc(text, func(r rune) bool { return unicode.IsSpace(r) || r == ',' }) {
+			field = strings.Trim(field, ".")
+			if value, err := strconv.Atoi(field); err == nil {
+				numbers = append(numbers, value)
+			} else if tmp, ok := common.ParseMonth(field, language); ok && month == 0 {
+				month = tmp
+			}
+		}
+	}
+	day, year := 0, 0
+	for _, value := range numbers {
+		switch {
+		case value >= 1000:
+			year = value
+		case day == 0:
+			day = value
+		}
+	}
+	if month < time.January || month > time.December || day < 1 || day > 31 {
+		return time.Time{}, fmt.Errorf("fail to parse steam date: %s", text)
+	}
+	if year != 0 {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), nil
+	}
+	res := time.Date(now.Year(), month, day, 0, 0, 0, 0, time.UTC)
+	// A day ahead of now is tolerated, the pages render the dates in the time zone of the account
+	if res.After(now.Add(24 * time.Hour)) {
+		res = res.AddDate(-1, 0, 0)
 	}
-	day := res[1]
-	year := res[2]
-	hour := res[3]
-	timestamp = year + "-" + month + "-" + day + "T" + hour + ":00:00Z"
-	return time.Parse("2006-01-02T15:04:05Z", timestamp)
+	return res, nil
 }
 
 // Calculate delta day between two timestamp, t2-t1
@@ -0,0 +1,58 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/umichan0621/steam/pkg/common"
+	"github.com/umichan0621/steam/pkg/utils"
+)
+
+func TestParseSteamTimestamp(t *testing.T) {
+	want := time.Date(2024, time.March, 1, 13, 0, 0, 0, time.UTC)
+	tests := []struct {
+		timestamp string
+		language  common.Language
+	}{
+		{"Mar 01 2024 13: +0", common.LanguageEnglish},
+		{"Mär 01 2024 13: +0", common.LanguageGerman},
+		{"mars 01 2024 13: +0", common.LanguageFrench},
+		// A month of another language is still read
+		{"Mar 01 2024 13: +0", common.LanguageGerman},
+	}
+	for _, tt := range tests {
+		got, err := utils.ParseSteamTimestamp(tt.timestamp, tt.language)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ParseSteamTimestamp(%q, %s) = %s, %v, want %s", tt.timestamp, tt.language, got, err, want)
+		}
+	}
+	for _, timestamp := range []string{"", "Mar 01 2024", "Foo 01 2024 13: +0", "Mar xx 2024 13: +0"} {
+		if got, err := utils.ParseSteamTimestamp(timestamp, common.LanguageEnglish); err == nil || !got.IsZero() {
+			t.Errorf("ParseSteamTimestamp(%q) = %s, %v, want the zero time and an error", timestamp, got, err)
+		}
+	}
+}
+
+func TestParseSteamDate(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		text     string
+		language common.Language
+		want     time.Time
+	}{
+		{"2 Jan", common.LanguageEnglish, time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{"Jan 2, 2023", common.LanguageEnglish, time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{"2. Jan.", common.LanguageGerman, time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{"2 janv.", common.LanguageFrench, time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{"2024年1月2日", common.LanguageJapanese, time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		// A day ahead of now is the last year's
+		{"15 Dec", common.LanguageEnglish, time.Date(2023, time.December, 15, 0, 0, 0, 0, time.UTC)},
+		{"2 Mar", common.LanguageEnglish, time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := utils.ParseSteamDate(tt.text, tt.language, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("ParseSteamDate(%q, %s) = %s, %v, want %s", tt.text, tt.language, got, err, tt.want)
+		}
+	}
+}
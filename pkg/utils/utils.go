@@ -1,11 +1,55 @@
 package utils
 
 import (
+	crand "crypto/rand"
+	"encoding/binary"
 	"math/rand"
-	"time"
+	"sync"
 )
 
+// Source of randomness for login jitter and session IDs, replace it to reproduce a run
+type Rand interface {
+	Int63n(n int64) int64
+	Read(p []byte) (int, error)
+}
+
+// Backed by crypto/rand, used while no other source is set
+type CryptoRand struct{}
+
+func (CryptoRand) Int63n(n int64) int64 {
+	buf := make([]byte, 8)
+	crand.Read(buf)
+	return int64(binary.BigEndian.Uint64(buf)>>1) % n
+}
+
+func (CryptoRand) Read(p []byte) (int, error) { return crand.Read(p) }
+
+type lockedRand struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+// Deterministic source, safe for concurrent use
+func NewRand(seed int64) Rand {
+	return &lockedRand{r: rand.New(rand.NewSource(seed))}
+}
+
+func (l *lockedRand) Int63n(n int64) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Int63n(n)
+}
+
+func (l *lockedRand) Read(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Read(p)
+}
+
 func RandRange(floor, ceil int64) int64 {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	return RandRangeWith(CryptoRand{}, floor, ceil)
+}
+
+func RandRangeWith(r Rand, floor, ceil int64) int64 {
 	return floor + r.Int63n(ceil-floor)
 }